@@ -0,0 +1,33 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/rancher/go-rancher/api"
+	"github.com/rancher/go-rancher/client"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// AlertList returns the pending and firing alerts of all alert rules. The alerts can be filtered by the state via
+// the query parameter, e.g. `/v1/alerts?state=firing`.
+func (s *Server) AlertList(rw http.ResponseWriter, req *http.Request) error {
+	apiContext := api.GetApiContext(req)
+
+	alertRules, err := s.m.ListAlertRulesSorted()
+	if err != nil {
+		return errors.Wrap(err, "failed to list alert rules")
+	}
+	apiContext.Write(toAlertCollection(alertRules, longhorn.AlertState(req.URL.Query().Get("state"))))
+	return nil
+}
+
+func (s *Server) alertList(apiContext *api.ApiContext) (*client.GenericCollection, error) {
+	alertRules, err := s.m.ListAlertRulesSorted()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list alert rules")
+	}
+	return toAlertCollection(alertRules, ""), nil
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/rancher/go-rancher/api"
 	"github.com/rancher/go-rancher/client"
 
+	bsutil "github.com/longhorn/backupstore/util"
+
 	"github.com/longhorn/longhorn-manager/util"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
@@ -82,7 +84,8 @@ func newBackupTarget(input BackupTarget) (*longhorn.BackupTargetSpec, error) {
 	return &longhorn.BackupTargetSpec{
 		BackupTargetURL:  input.BackupTargetURL,
 		CredentialSecret: input.CredentialSecret,
-		PollInterval:     metav1.Duration{Duration: time.Duration(pollInterval) * time.Second}}, nil
+		PollInterval:     metav1.Duration{Duration: time.Duration(pollInterval) * time.Second},
+		BandwidthLimit:   input.BandwidthLimit}, nil
 }
 
 func (s *Server) BackupTargetUpdate(rw http.ResponseWriter, req *http.Request) error {
@@ -178,6 +181,33 @@ func (s *Server) BackupTargetSync(w http.ResponseWriter, req *http.Request) erro
 	return nil
 }
 
+func (s *Server) BackupTargetGarbageCollect(w http.ResponseWriter, req *http.Request) error {
+	var input BackupTargetGarbageCollectInput
+
+	apiContext := api.GetApiContext(req)
+	if err := apiContext.Read(&input); err != nil {
+		return err
+	}
+
+	backupTargetName := mux.Vars(req)["backupTargetName"]
+	if backupTargetName == "" {
+		return fmt.Errorf("backup target name is required")
+	}
+
+	bt, err := s.m.GetBackupTarget(backupTargetName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get backup target %v", backupTargetName)
+	}
+
+	bt, err = s.m.GarbageCollectBackupTarget(bt, input.DryRun)
+	if err != nil {
+		return errors.Wrapf(err, "failed to request garbage collection of backup target %v", backupTargetName)
+	}
+
+	apiContext.Write(toBackupTargetResource(bt, apiContext))
+	return nil
+}
+
 func (s *Server) BackupVolumeList(w http.ResponseWriter, req *http.Request) error {
 	apiContext := api.GetApiContext(req)
 
@@ -377,3 +407,28 @@ func (s *Server) BackupDelete(w http.ResponseWriter, req *http.Request) error {
 	apiContext.Write(toBackupVolumeResource(bv, apiContext))
 	return nil
 }
+
+func (s *Server) BackupCopy(w http.ResponseWriter, req *http.Request) error {
+	var input BackupCopyInput
+
+	apiContext := api.GetApiContext(req)
+
+	if err := apiContext.Read(&input); err != nil {
+		return err
+	}
+	if input.Name == "" {
+		return errors.New("empty source backup name is not allowed")
+	}
+	if input.BackupTargetName == "" {
+		return errors.New("empty backup target name is not allowed")
+	}
+
+	backupVolumeName := mux.Vars(req)["backupVolumeName"]
+
+	backup, err := s.m.CopyBackup(bsutil.GenerateName("backup"), backupVolumeName, input.Name, input.BackupTargetName, nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to copy backup '%v' of volume '%v' to backup target '%v'", input.Name, backupVolumeName, input.BackupTargetName)
+	}
+	apiContext.Write(toBackupResource(backup))
+	return nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/longhorn/longhorn-manager/engineapi"
 	"github.com/longhorn/longhorn-manager/manager"
 	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util/tracing"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
@@ -146,6 +147,8 @@ func (f *Fwd) HandleProxyRequestByNodeID(parameters map[string]string, req *http
 	if h.Get("X-Forwarded-Host") != "" {
 		h.Del("X-Forwarded-Host")
 	}
+	// Continue the trace of the request on the target node
+	tracing.InjectIntoHTTPHeader(req.Context(), h)
 	req.Header = h
 	logrus.Infof("Forwarding request to %v", targetAddress)
 
@@ -10,6 +10,8 @@ import (
 	"github.com/rancher/go-rancher/api"
 	"github.com/rancher/go-rancher/client"
 
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/longhorn/longhorn-manager/controller"
@@ -20,6 +22,7 @@ import (
 	"github.com/longhorn/longhorn-manager/util"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	upgradeutil "github.com/longhorn/longhorn-manager/upgrade/util"
 )
 
 type Empty struct {
@@ -40,6 +43,7 @@ type Volume struct {
 	StaleReplicaTimeout         int                                    `json:"staleReplicaTimeout"`
 	State                       longhorn.VolumeState                   `json:"state"`
 	Robustness                  longhorn.VolumeRobustness              `json:"robustness"`
+	HealthScore                 int                                    `json:"healthScore"`
 	Image                       string                                 `json:"image"`
 	CurrentImage                string                                 `json:"currentImage"`
 	BackingImage                string                                 `json:"backingImage"`
@@ -62,6 +66,7 @@ type Volume struct {
 	SnapshotMaxSize             string                                 `json:"snapshotMaxSize"`
 	FreezeFilesystemForSnapshot longhorn.FreezeFilesystemForSnapshot   `json:"freezeFilesystemForSnapshot"`
 	BackupTargetName            string                                 `json:"backupTargetName"`
+	IOQoS                       longhorn.VolumeIOQoS                   `json:"ioQoS"`
 
 	DiskSelector         []string                      `json:"diskSelector"`
 	NodeSelector         []string                      `json:"nodeSelector"`
@@ -99,6 +104,22 @@ type Snapshot struct {
 	Checksum string `json:"checksum"`
 }
 
+// VolumeIOStats struct is used for the ioStats action
+type VolumeIOStats struct {
+	client.Resource
+	// SampleInterval is in seconds
+	SampleInterval int64                         `json:"sampleInterval"`
+	Samples        []manager.VolumeIOStatsSample `json:"samples"`
+}
+
+// SnapshotChangedBlocks struct is used for the snapshotChangedBlocks action
+type SnapshotChangedBlocks struct {
+	client.Resource
+	FromSnapshot string                     `json:"fromSnapshot"`
+	ToSnapshot   string                     `json:"toSnapshot"`
+	Extents      []engineapi.SnapshotExtent `json:"extents"`
+}
+
 // SnapshotCR struct is used for the snapshotCR* actions
 type SnapshotCR struct {
 	client.Resource
@@ -144,6 +165,10 @@ type BackupVolume struct {
 	VolumeName           string            `json:"volumeName"`
 }
 
+type BackupTargetGarbageCollectInput struct {
+	DryRun bool `json:"dryRun"`
+}
+
 // SyncBackupResource is used for the Backup*Sync* actions
 type SyncBackupResource struct {
 	SyncAllBackupTargets bool `json:"syncAllBackupTargets"`
@@ -175,6 +200,7 @@ type Backup struct {
 	NewlyUploadedDataSize  string               `json:"newlyUploadDataSize"`
 	ReUploadedDataSize     string               `json:"reUploadedDataSize"`
 	BackupTargetName       string               `json:"backupTargetName"`
+	SourceBackupName       string               `json:"sourceBackupName"`
 }
 
 type BackupBackingImage struct {
@@ -203,6 +229,38 @@ type Setting struct {
 	Definition types.SettingDefinition `json:"definition"`
 }
 
+type SettingHistoryEntry struct {
+	client.Resource
+	Revision  int64  `json:"revision"`
+	OldValue  string `json:"oldValue"`
+	NewValue  string `json:"newValue"`
+	Actor     string `json:"actor"`
+	Timestamp string `json:"timestamp"`
+}
+
+type SettingRollbackInput struct {
+	Revision int64 `json:"revision"`
+}
+
+type SettingsInput struct {
+	Settings map[string]string `json:"settings"`
+}
+
+type UpgradeReadinessIssue struct {
+	Kind    string `json:"kind"`
+	Name    string `json:"name"`
+	Message string `json:"message"`
+}
+
+type UpgradeReadinessReport struct {
+	client.Resource
+	CurrentVersion string                  `json:"currentVersion"`
+	TargetVersion  string                  `json:"targetVersion"`
+	Ready          bool                    `json:"ready"`
+	Blockers       []UpgradeReadinessIssue `json:"blockers"`
+	Warnings       []UpgradeReadinessIssue `json:"warnings"`
+}
+
 type Instance struct {
 	Name                string `json:"name"`
 	NodeID              string `json:"hostId"`
@@ -318,6 +376,11 @@ type SnapshotInput struct {
 	BackupMode string            `json:"backupMode"`
 }
 
+type SnapshotChangedBlocksInput struct {
+	FromSnapshot string `json:"fromSnapshot"`
+	ToSnapshot   string `json:"toSnapshot"`
+}
+
 type SnapshotCRInput struct {
 	Name   string            `json:"name"`
 	Labels map[string]string `json:"labels"`
@@ -327,6 +390,11 @@ type BackupInput struct {
 	Name string `json:"name"`
 }
 
+type BackupCopyInput struct {
+	Name             string `json:"name"`
+	BackupTargetName string `json:"backupTargetName"`
+}
+
 type ReplicaRemoveInput struct {
 	Name string `json:"name"`
 }
@@ -395,6 +463,14 @@ type UpdateSnapshotMaxSize struct {
 	SnapshotMaxSize string `json:"snapshotMaxSize"`
 }
 
+type UpdateIOQoSInput struct {
+	MaxReadIOPS       int64 `json:"maxReadIOPS"`
+	MaxWriteIOPS      int64 `json:"maxWriteIOPS"`
+	MaxReadBandwidth  int64 `json:"maxReadBandwidth"`
+	MaxWriteBandwidth int64 `json:"maxWriteBandwidth"`
+	BurstSeconds      int64 `json:"burstSeconds"`
+}
+
 type UpdateFreezeFilesystemForSnapshotInput struct {
 	FreezeFilesystemForSnapshot string `json:"freezeFilesystemForSnapshot"`
 }
@@ -439,6 +515,7 @@ type Node struct {
 	Zone                      string                        `json:"zone"`
 	InstanceManagerCPURequest int                           `json:"instanceManagerCPURequest"`
 	AutoEvicting              bool                          `json:"autoEvicting"`
+	SettingOverrides          map[string]string             `json:"settingOverrides"`
 }
 
 type DiskStatus struct {
@@ -487,6 +564,8 @@ type SystemBackup struct {
 
 	Name               string                                        `json:"name"`
 	VolumeBackupPolicy longhorn.SystemBackupCreateVolumeBackupPolicy `json:"volumeBackupPolicy"`
+	Scope              longhorn.SystemBackupScope                    `json:"scope"`
+	BaseSystemBackup   string                                        `json:"baseSystemBackup"`
 
 	Version      string                     `json:"version,omitempty"`
 	ManagerImage string                     `json:"managerImage,omitempty"`
@@ -498,20 +577,75 @@ type SystemBackup struct {
 type SystemBackupInput struct {
 	Name               string                                        `json:"name"`
 	VolumeBackupPolicy longhorn.SystemBackupCreateVolumeBackupPolicy `json:"volumeBackupPolicy"`
+	Scope              longhorn.SystemBackupScope                    `json:"scope"`
+	BaseSystemBackup   string                                        `json:"baseSystemBackup"`
+}
+
+type SystemBackupResource struct {
+	client.Resource
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	YAML      string `json:"yaml,omitempty"`
+}
+
+type SystemBackupResourceInput struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+type SystemBackupResourceListInput struct {
+	Kind string `json:"kind"`
+}
+
+type SystemBackupVolumeBackup struct {
+	client.Resource
+	VolumeName  string               `json:"volumeName"`
+	BackupName  string               `json:"backupName"`
+	BackupState longhorn.BackupState `json:"backupState"`
+}
+
+type Alert struct {
+	client.Resource
+	Rule       string                 `json:"rule"`
+	RuleType   longhorn.AlertRuleType `json:"ruleType"`
+	Severity   longhorn.AlertSeverity `json:"severity"`
+	ObjectKind string                 `json:"objectKind"`
+	ObjectName string                 `json:"objectName"`
+	State      longhorn.AlertState    `json:"state"`
+	Message    string                 `json:"message"`
+	StartsAt   string                 `json:"startsAt"`
+	FiredAt    string                 `json:"firedAt,omitempty"`
 }
 
 type SystemRestore struct {
 	client.Resource
+	Name         string                                 `json:"name"`
+	SystemBackup string                                 `json:"systemBackup"`
+	Include      SystemRestoreResourceFilter            `json:"include"`
+	Exclude      SystemRestoreResourceFilter            `json:"exclude"`
+	Remap        longhorn.SystemRestoreRemap            `json:"remap"`
+	DryRun       bool                                   `json:"dryRun"`
+	State        longhorn.SystemRestoreState            `json:"state,omitempty"`
+	CreatedAt    string                                 `json:"createdAt,omitempty"`
+	Error        string                                 `json:"error,omitempty"`
+	DryRunReport []longhorn.SystemRestoreResourceChange `json:"dryRunReport"`
+}
+
+type SystemRestoreInput struct {
 	Name         string                      `json:"name"`
 	SystemBackup string                      `json:"systemBackup"`
-	State        longhorn.SystemRestoreState `json:"state,omitempty"`
-	CreatedAt    string                      `json:"createdAt,omitempty"`
-	Error        string                      `json:"error,omitempty"`
+	Include      SystemRestoreResourceFilter `json:"include"`
+	Exclude      SystemRestoreResourceFilter `json:"exclude"`
+	Remap        longhorn.SystemRestoreRemap `json:"remap"`
+	DryRun       bool                        `json:"dryRun"`
 }
 
-type SystemRestoreInput struct {
-	Name         string `json:"name"`
-	SystemBackup string `json:"systemBackup"`
+type SystemRestoreResourceFilter struct {
+	Kinds          []string          `json:"kinds"`
+	Namespaces     []string          `json:"namespaces"`
+	VolumeSelector map[string]string `json:"volumeSelector"`
 }
 
 type Tag struct {
@@ -624,6 +758,16 @@ type SnapshotCRListOutput struct {
 	Type string       `json:"type"`
 }
 
+type SystemBackupResourceListOutput struct {
+	Data []SystemBackupResource `json:"data"`
+	Type string                 `json:"type"`
+}
+
+type SystemBackupVolumeBackupListOutput struct {
+	Data []SystemBackupVolumeBackup `json:"data"`
+	Type string                     `json:"type"`
+}
+
 func NewSchema() *client.Schemas {
 	schemas := &client.Schemas{}
 
@@ -633,11 +777,19 @@ func NewSchema() *client.Schemas {
 	schemas.AddType("attachInput", AttachInput{})
 	schemas.AddType("detachInput", DetachInput{})
 	schemas.AddType("snapshotInput", SnapshotInput{})
+	schemas.AddType("snapshotChangedBlocksInput", SnapshotChangedBlocksInput{})
+	schemas.AddType("snapshotExtent", engineapi.SnapshotExtent{})
+	snapshotChangedBlocksSchema(schemas.AddType("snapshotChangedBlocks", SnapshotChangedBlocks{}))
+	schemas.AddType("volumeIOStatsSample", manager.VolumeIOStatsSample{})
+	volumeIOStatsSchema(schemas.AddType("volumeIOStats", VolumeIOStats{}))
 	schemas.AddType("snapshotCRInput", SnapshotCRInput{})
 	schemas.AddType("backup", Backup{})
 	schemas.AddType("backupInput", BackupInput{})
+	schemas.AddType("backupCopyInput", BackupCopyInput{})
 	schemas.AddType("backupStatus", BackupStatus{})
 	schemas.AddType("syncBackupResource", SyncBackupResource{})
+	schemas.AddType("backupTargetGarbageCollectInput", BackupTargetGarbageCollectInput{})
+	schemas.AddType("backupTargetGarbageCollectionStatus", longhorn.BackupTargetGarbageCollectionStatus{})
 	schemas.AddType("orphan", Orphan{})
 	schemas.AddType("restoreStatus", RestoreStatus{})
 	schemas.AddType("purgeStatus", PurgeStatus{})
@@ -657,6 +809,7 @@ func NewSchema() *client.Schemas {
 	schemas.AddType("UpdateSnapshotDataIntegrityInput", UpdateSnapshotDataIntegrityInput{})
 	schemas.AddType("UpdateSnapshotMaxCountInput", UpdateSnapshotMaxCountInput{})
 	schemas.AddType("UpdateSnapshotMaxSizeInput", UpdateSnapshotMaxSizeInput{})
+	schemas.AddType("UpdateIOQoSInput", UpdateIOQoSInput{})
 	schemas.AddType("UpdateBackupCompressionInput", UpdateBackupCompressionMethodInput{})
 	schemas.AddType("UpdateUnmapMarkSnapChainRemovedInput", UpdateUnmapMarkSnapChainRemovedInput{})
 	schemas.AddType("UpdateReplicaSoftAntiAffinityInput", UpdateReplicaSoftAntiAffinityInput{})
@@ -666,6 +819,7 @@ func NewSchema() *client.Schemas {
 	schemas.AddType("UpdateBackupTargetInput", UpdateBackupTargetInput{})
 	schemas.AddType("workloadStatus", longhorn.WorkloadStatus{})
 	schemas.AddType("cloneStatus", longhorn.VolumeCloneStatus{})
+	schemas.AddType("volumeIOQoS", longhorn.VolumeIOQoS{})
 	schemas.AddType("empty", Empty{})
 
 	schemas.AddType("volumeRecurringJob", VolumeRecurringJob{})
@@ -682,6 +836,7 @@ func NewSchema() *client.Schemas {
 	schemas.AddType("longhornCondition", longhorn.Condition{})
 
 	schemas.AddType("event", Event{})
+	schemas.AddType("alert", Alert{})
 	schemas.AddType("supportBundle", SupportBundle{})
 	schemas.AddType("supportBundleInitateInput", SupportBundleInitateInput{})
 
@@ -691,6 +846,9 @@ func NewSchema() *client.Schemas {
 	schemas.AddType("instanceProcess", longhorn.InstanceProcess{})
 
 	schemas.AddType("backingImageDiskFileStatus", longhorn.BackingImageDiskFileStatus{})
+	schemas.AddType("bandwidthLimit", longhorn.BandwidthLimit{})
+	schemas.AddType("bandwidthLimitWindow", longhorn.BandwidthLimitWindow{})
+	schemas.AddType("backupTargetVolumeStorage", longhorn.BackupTargetVolumeStorage{})
 	schemas.AddType("backingImageCleanupInput", BackingImageCleanupInput{})
 	schemas.AddType("updateMinNumberOfCopiesInput", UpdateMinNumberOfCopiesInput{})
 	schemas.AddType("backingImageRestoreInput", BackingImageRestoreInput{})
@@ -704,6 +862,11 @@ func NewSchema() *client.Schemas {
 	backupVolumeSchema(schemas.AddType("backupVolume", BackupVolume{}))
 	backupBackingImageSchema(schemas.AddType("backupBackingImage", BackupBackingImage{}))
 	settingSchema(schemas.AddType("setting", Setting{}))
+	schemas.AddType("settingHistoryEntry", SettingHistoryEntry{})
+	schemas.AddType("settingRollbackInput", SettingRollbackInput{})
+	schemas.AddType("settingsInput", SettingsInput{})
+	schemas.AddType("upgradeReadinessIssue", UpgradeReadinessIssue{})
+	schemas.AddType("upgradeReadinessReport", UpgradeReadinessReport{})
 	recurringJobSchema(schemas.AddType("recurringJob", RecurringJob{}))
 	engineImageSchema(schemas.AddType("engineImage", EngineImage{}))
 	backingImageSchema(schemas.AddType("backingImage", BackingImage{}))
@@ -716,7 +879,18 @@ func NewSchema() *client.Schemas {
 	backupListOutputSchema(schemas.AddType("backupListOutput", BackupListOutput{}))
 	snapshotListOutputSchema(schemas.AddType("snapshotListOutput", SnapshotListOutput{}))
 	systemBackupSchema(schemas.AddType("systemBackup", SystemBackup{}))
+	schemas.AddType("systemBackupScope", longhorn.SystemBackupScope{})
+	schemas.AddType("systemBackupResource", SystemBackupResource{})
+	schemas.AddType("systemBackupResourceInput", SystemBackupResourceInput{})
+	schemas.AddType("systemBackupResourceListInput", SystemBackupResourceListInput{})
+	systemBackupResourceListOutputSchema(schemas.AddType("systemBackupResourceListOutput", SystemBackupResourceListOutput{}))
+	schemas.AddType("systemBackupVolumeBackup", SystemBackupVolumeBackup{})
+	systemBackupVolumeBackupListOutputSchema(schemas.AddType("systemBackupVolumeBackupListOutput", SystemBackupVolumeBackupListOutput{}))
 	systemRestoreSchema(schemas.AddType("systemRestore", SystemRestore{}))
+	schemas.AddType("systemRestoreResourceFilter", SystemRestoreResourceFilter{})
+	schemas.AddType("systemRestoreResourceChange", longhorn.SystemRestoreResourceChange{})
+	schemas.AddType("systemRestoreBackupTargetOverride", longhorn.SystemRestoreBackupTargetOverride{})
+	systemRestoreRemapSchema(schemas.AddType("systemRestoreRemap", longhorn.SystemRestoreRemap{}))
 	snapshotCRListOutputSchema(schemas.AddType("snapshotCRListOutput", SnapshotCRListOutput{}))
 
 	return schemas
@@ -755,6 +929,11 @@ func nodeSchema(node *client.Schema) {
 	tags := node.ResourceFields["tags"]
 	tags.Create = true
 	node.ResourceFields["tags"] = tags
+
+	settingOverrides := node.ResourceFields["settingOverrides"]
+	settingOverrides.Update = true
+	settingOverrides.Nullable = true
+	node.ResourceFields["settingOverrides"] = settingOverrides
 }
 
 func diskSchema(diskUpdateInput *client.Schema) {
@@ -909,6 +1088,10 @@ func backupTargetSchema(backupTarget *client.Schema) {
 	backupTargetPollInterval.Default = "300"
 	backupTarget.ResourceFields["pollInterval"] = backupTargetPollInterval
 
+	bandwidthLimit := backupTarget.ResourceFields["bandwidthLimit"]
+	bandwidthLimit.Create = true
+	backupTarget.ResourceFields["bandwidthLimit"] = bandwidthLimit
+
 	backupTarget.ResourceActions = map[string]client.Action{
 		"backupTargetSync": {
 			Input:  "syncBackupResource",
@@ -918,6 +1101,10 @@ func backupTargetSchema(backupTarget *client.Schema) {
 			Input:  "BackupTarget",
 			Output: "backupTargetListOutput",
 		},
+		"backupTargetGarbageCollect": {
+			Input:  "backupTargetGarbageCollectInput",
+			Output: "backupTargetListOutput",
+		},
 	}
 }
 
@@ -940,6 +1127,10 @@ func backupVolumeSchema(backupVolume *client.Schema) {
 			Input:  "backupInput",
 			Output: "backupVolume",
 		},
+		"backupCopy": {
+			Input:  "backupCopyInput",
+			Output: "backup",
+		},
 		"backupVolumeSync": {
 			Input:  "syncBackupResource",
 			Output: "backupVolumeListOutput",
@@ -948,7 +1139,7 @@ func backupVolumeSchema(backupVolume *client.Schema) {
 }
 
 func settingSchema(setting *client.Schema) {
-	setting.CollectionMethods = []string{"GET"}
+	setting.CollectionMethods = []string{"GET", "PUT"}
 	setting.ResourceMethods = []string{"GET", "PUT"}
 
 	settingName := setting.ResourceFields["name"]
@@ -965,6 +1156,13 @@ func settingSchema(setting *client.Schema) {
 		Type:     "settingDefinition",
 		Nullable: false,
 	}
+
+	setting.ResourceActions = map[string]client.Action{
+		"settingRollback": {
+			Input:  "settingRollbackInput",
+			Output: "setting",
+		},
+	}
 }
 
 func volumeSchema(volume *client.Schema) {
@@ -1012,6 +1210,13 @@ func volumeSchema(volume *client.Schema) {
 		"snapshotList": {
 			Output: "snapshotListOutput",
 		},
+		"snapshotChangedBlocks": {
+			Input:  "snapshotChangedBlocksInput",
+			Output: "snapshotChangedBlocks",
+		},
+		"ioStats": {
+			Output: "volumeIOStats",
+		},
 		"snapshotDelete": {
 			Input:  "snapshotInput",
 			Output: "volume",
@@ -1084,6 +1289,10 @@ func volumeSchema(volume *client.Schema) {
 			Input: "UpdateSnapshotMaxSizeInput",
 		},
 
+		"updateIOQoS": {
+			Input: "UpdateIOQoSInput",
+		},
+
 		"updateBackupCompressionMethod": {
 			Input: "UpdateBackupCompressionMethodInput",
 		},
@@ -1267,6 +1476,11 @@ func volumeSchema(volume *client.Schema) {
 	cloneStatus.Type = "cloneStatus"
 	volume.ResourceFields["cloneStatus"] = cloneStatus
 
+	ioQoS := volume.ResourceFields["ioQoS"]
+	ioQoS.Type = "volumeIOQoS"
+	ioQoS.Create = true
+	volume.ResourceFields["ioQoS"] = ioQoS
+
 	backupStatus := volume.ResourceFields["backupStatus"]
 	backupStatus.Type = "array[backupStatus]"
 	volume.ResourceFields["backupStatus"] = backupStatus
@@ -1333,6 +1547,41 @@ func systemBackupSchema(systemBackup *client.Schema) {
 	name.Unique = true
 	name.Create = true
 	systemBackup.ResourceFields["name"] = name
+
+	scope := systemBackup.ResourceFields["scope"]
+	scope.Type = "systemBackupScope"
+	scope.Create = true
+	systemBackup.ResourceFields["scope"] = scope
+
+	baseSystemBackup := systemBackup.ResourceFields["baseSystemBackup"]
+	baseSystemBackup.Create = true
+	systemBackup.ResourceFields["baseSystemBackup"] = baseSystemBackup
+
+	systemBackup.ResourceActions = map[string]client.Action{
+		"systemBackupResourceList": {
+			Input:  "systemBackupResourceListInput",
+			Output: "systemBackupResourceListOutput",
+		},
+		"systemBackupResourceGet": {
+			Input:  "systemBackupResourceInput",
+			Output: "systemBackupResource",
+		},
+		"systemBackupVolumeBackupList": {
+			Output: "systemBackupVolumeBackupListOutput",
+		},
+	}
+}
+
+func systemBackupResourceListOutputSchema(systemBackupResourceList *client.Schema) {
+	data := systemBackupResourceList.ResourceFields["data"]
+	data.Type = "array[systemBackupResource]"
+	systemBackupResourceList.ResourceFields["data"] = data
+}
+
+func systemBackupVolumeBackupListOutputSchema(systemBackupVolumeBackupList *client.Schema) {
+	data := systemBackupVolumeBackupList.ResourceFields["data"]
+	data.Type = "array[systemBackupVolumeBackup]"
+	systemBackupVolumeBackupList.ResourceFields["data"] = data
 }
 
 func systemRestoreSchema(systemRestore *client.Schema) {
@@ -1349,6 +1598,53 @@ func systemRestoreSchema(systemRestore *client.Schema) {
 	systemBackup.Required = true
 	systemBackup.Unique = true
 	systemRestore.ResourceFields["systemBackup"] = systemBackup
+
+	include := systemRestore.ResourceFields["include"]
+	include.Type = "systemRestoreResourceFilter"
+	include.Create = true
+	systemRestore.ResourceFields["include"] = include
+
+	exclude := systemRestore.ResourceFields["exclude"]
+	exclude.Type = "systemRestoreResourceFilter"
+	exclude.Create = true
+	systemRestore.ResourceFields["exclude"] = exclude
+
+	remap := systemRestore.ResourceFields["remap"]
+	remap.Type = "systemRestoreRemap"
+	remap.Create = true
+	systemRestore.ResourceFields["remap"] = remap
+
+	dryRun := systemRestore.ResourceFields["dryRun"]
+	dryRun.Create = true
+	systemRestore.ResourceFields["dryRun"] = dryRun
+
+	dryRunReport := systemRestore.ResourceFields["dryRunReport"]
+	dryRunReport.Type = "array[systemRestoreResourceChange]"
+	systemRestore.ResourceFields["dryRunReport"] = dryRunReport
+}
+
+func systemRestoreRemapSchema(remap *client.Schema) {
+	for _, field := range []string{"nodeNames", "storageClassNames", "namespaces"} {
+		nameMap := remap.ResourceFields[field]
+		nameMap.Type = "map[string]"
+		remap.ResourceFields[field] = nameMap
+	}
+
+	backupTargets := remap.ResourceFields["backupTargets"]
+	backupTargets.Type = "map[systemRestoreBackupTargetOverride]"
+	remap.ResourceFields["backupTargets"] = backupTargets
+}
+
+func volumeIOStatsSchema(volumeIOStats *client.Schema) {
+	samples := volumeIOStats.ResourceFields["samples"]
+	samples.Type = "array[volumeIOStatsSample]"
+	volumeIOStats.ResourceFields["samples"] = samples
+}
+
+func snapshotChangedBlocksSchema(snapshotChangedBlocks *client.Schema) {
+	extents := snapshotChangedBlocks.ResourceFields["extents"]
+	extents.Type = "array[snapshotExtent]"
+	snapshotChangedBlocks.ResourceFields["extents"] = extents
 }
 
 func snapshotCRListOutputSchema(snapshotList *client.Schema) {
@@ -1377,10 +1673,10 @@ func toEmptyResource() *Empty {
 	}
 }
 
-func toSettingResource(setting *longhorn.Setting) *Setting {
+func toSettingResource(setting *longhorn.Setting, apiContext *api.ApiContext) *Setting {
 	definition, _ := types.GetSettingDefinition(types.SettingName(setting.Name))
 
-	return &Setting{
+	res := &Setting{
 		Resource: client.Resource{
 			Id:    setting.Name,
 			Type:  "setting",
@@ -1392,16 +1688,65 @@ func toSettingResource(setting *longhorn.Setting) *Setting {
 
 		Definition: definition,
 	}
+	res.Links["history"] = apiContext.UrlBuilder.Link(res.Resource, "history")
+	res.Actions = map[string]string{
+		"settingRollback": apiContext.UrlBuilder.ActionLink(res.Resource, "settingRollback"),
+	}
+	return res
 }
 
-func toSettingCollection(settings []*longhorn.Setting) *client.GenericCollection {
+func toSettingCollection(settings []*longhorn.Setting, apiContext *api.ApiContext) *client.GenericCollection {
 	data := []interface{}{}
 	for _, setting := range settings {
-		data = append(data, toSettingResource(setting))
+		data = append(data, toSettingResource(setting, apiContext))
 	}
 	return &client.GenericCollection{Data: data, Collection: client.Collection{ResourceType: "setting"}}
 }
 
+func toSettingHistoryCollection(setting *longhorn.Setting) *client.GenericCollection {
+	data := []interface{}{}
+	for _, entry := range setting.Status.History {
+		data = append(data, &SettingHistoryEntry{
+			Resource: client.Resource{
+				Id:   strconv.FormatInt(entry.Revision, 10),
+				Type: "settingHistoryEntry",
+			},
+			Revision:  entry.Revision,
+			OldValue:  entry.OldValue,
+			NewValue:  entry.NewValue,
+			Actor:     entry.Actor,
+			Timestamp: entry.Timestamp.String(),
+		})
+	}
+	return &client.GenericCollection{Data: data, Collection: client.Collection{ResourceType: "settingHistoryEntry"}}
+}
+
+func toUpgradeReadinessReportResource(report *upgradeutil.UpgradeReadinessReport) *UpgradeReadinessReport {
+	toIssues := func(issues []upgradeutil.UpgradeReadinessIssue) []UpgradeReadinessIssue {
+		result := []UpgradeReadinessIssue{}
+		for _, issue := range issues {
+			result = append(result, UpgradeReadinessIssue{
+				Kind:    issue.Kind,
+				Name:    issue.Name,
+				Message: issue.Message,
+			})
+		}
+		return result
+	}
+
+	return &UpgradeReadinessReport{
+		Resource: client.Resource{
+			Id:   report.TargetVersion,
+			Type: "upgradeReadinessReport",
+		},
+		CurrentVersion: report.CurrentVersion,
+		TargetVersion:  report.TargetVersion,
+		Ready:          report.Ready,
+		Blockers:       toIssues(report.Blockers),
+		Warnings:       toIssues(report.Warnings),
+	}
+}
+
 func toVolumeResource(v *longhorn.Volume, ves []*longhorn.Engine, vrs []*longhorn.Replica, backups []*longhorn.Backup, lhVolumeAttachment *longhorn.VolumeAttachment, apiContext *api.ApiContext) *Volume {
 	var ve *longhorn.Engine
 	controllers := []Controller{}
@@ -1618,9 +1963,11 @@ func toVolumeResource(v *longhorn.Volume, ves []*longhorn.Engine, vrs []*longhor
 		RestoreVolumeRecurringJob:   v.Spec.RestoreVolumeRecurringJob,
 		FreezeFilesystemForSnapshot: v.Spec.FreezeFilesystemForSnapshot,
 		BackupTargetName:            v.Spec.BackupTargetName,
+		IOQoS:                       v.Spec.IOQoS,
 
 		State:                       v.Status.State,
 		Robustness:                  v.Status.Robustness,
+		HealthScore:                 v.Status.HealthScore,
 		CurrentImage:                v.Status.CurrentImage,
 		LastBackup:                  v.Status.LastBackup,
 		LastBackupAt:                v.Status.LastBackupAt,
@@ -1657,9 +2004,11 @@ func toVolumeResource(v *longhorn.Volume, ves []*longhorn.Engine, vrs []*longhor
 
 	// api attach & detach calls are always allowed
 	// the volume manager is responsible for handling them appropriately
+	// the IO statistics history is kept after the volume is detached
 	actions := map[string]struct{}{
-		"attach": {},
-		"detach": {},
+		"attach":  {},
+		"detach":  {},
+		"ioStats": {},
 	}
 
 	if v.Status.Robustness == longhorn.VolumeRobustnessFaulted {
@@ -1688,6 +2037,7 @@ func toVolumeResource(v *longhorn.Volume, ves []*longhorn.Engine, vrs []*longhor
 			actions["updateSnapshotDataIntegrity"] = struct{}{}
 			actions["updateSnapshotMaxCount"] = struct{}{}
 			actions["updateSnapshotMaxSize"] = struct{}{}
+			actions["updateIOQoS"] = struct{}{}
 			actions["updateBackupCompressionMethod"] = struct{}{}
 			actions["updateReplicaSoftAntiAffinity"] = struct{}{}
 			actions["updateReplicaZoneSoftAntiAffinity"] = struct{}{}
@@ -1709,6 +2059,7 @@ func toVolumeResource(v *longhorn.Volume, ves []*longhorn.Engine, vrs []*longhor
 			actions["snapshotCreate"] = struct{}{}
 			actions["snapshotList"] = struct{}{}
 			actions["snapshotGet"] = struct{}{}
+			actions["snapshotChangedBlocks"] = struct{}{}
 			actions["snapshotDelete"] = struct{}{}
 			actions["snapshotRevert"] = struct{}{}
 			actions["replicaRemove"] = struct{}{}
@@ -1720,6 +2071,7 @@ func toVolumeResource(v *longhorn.Volume, ves []*longhorn.Engine, vrs []*longhor
 			actions["updateSnapshotDataIntegrity"] = struct{}{}
 			actions["updateSnapshotMaxCount"] = struct{}{}
 			actions["updateSnapshotMaxSize"] = struct{}{}
+			actions["updateIOQoS"] = struct{}{}
 			actions["updateBackupCompressionMethod"] = struct{}{}
 			actions["updateReplicaSoftAntiAffinity"] = struct{}{}
 			actions["updateReplicaZoneSoftAntiAffinity"] = struct{}{}
@@ -1802,6 +2154,39 @@ func toSnapshotResource(s *longhorn.SnapshotInfo, checksum string) *Snapshot {
 	}
 }
 
+func toVolumeIOStatsResource(volumeName string, samples []manager.VolumeIOStatsSample) *VolumeIOStats {
+	return &VolumeIOStats{
+		Resource: client.Resource{
+			Id:   volumeName,
+			Type: "volumeIOStats",
+		},
+		SampleInterval: int64(manager.VolumeIOStatsSampleInterval.Seconds()),
+		Samples:        samples,
+	}
+}
+
+func toVolumeIOStatsCollection(volumeName string, samples []manager.VolumeIOStatsSample) *client.GenericCollection {
+	return &client.GenericCollection{
+		Data:       []interface{}{toVolumeIOStatsResource(volumeName, samples)},
+		Collection: client.Collection{ResourceType: "volumeIOStats"},
+	}
+}
+
+func toSnapshotChangedBlocksResource(volumeName, fromSnapshot, toSnapshot string, extents []engineapi.SnapshotExtent) *SnapshotChangedBlocks {
+	if extents == nil {
+		extents = []engineapi.SnapshotExtent{}
+	}
+	return &SnapshotChangedBlocks{
+		Resource: client.Resource{
+			Id:   volumeName,
+			Type: "snapshotChangedBlocks",
+		},
+		FromSnapshot: fromSnapshot,
+		ToSnapshot:   toSnapshot,
+		Extents:      extents,
+	}
+}
+
 func toSnapshotCollection(ssList map[string]*longhorn.SnapshotInfo, ssListRO map[string]*longhorn.Snapshot) *client.GenericCollection {
 	data := []interface{}{}
 
@@ -1854,13 +2239,23 @@ func toBackupTargetResource(bt *longhorn.BackupTarget, apiContext *api.ApiContex
 			BackupTargetURL:  bt.Spec.BackupTargetURL,
 			CredentialSecret: bt.Spec.CredentialSecret,
 			PollInterval:     bt.Spec.PollInterval.Duration.String(),
+			BandwidthLimit:   bt.Spec.BandwidthLimit,
 			Available:        bt.Status.Available,
 			Message:          types.GetCondition(bt.Status.Conditions, longhorn.BackupTargetConditionTypeUnavailable).Message,
+
+			LastSyncedAt:      bt.Status.LastSyncedAt.Format(time.RFC3339),
+			LastListLatency:   bt.Status.LastListLatency.Duration.String(),
+			BackupVolumeCount: bt.Status.BackupVolumeCount,
+			TotalStoredBytes:  bt.Status.TotalStoredBytes,
+			TotalLogicalBytes: bt.Status.TotalLogicalBytes,
+			VolumeStorage:     bt.Status.VolumeStorage,
+			GarbageCollection: bt.Status.GarbageCollection,
 		},
 	}
 	res.Actions = map[string]string{
-		"backupTargetSync":   apiContext.UrlBuilder.ActionLink(res.Resource, "backupTargetSync"),
-		"backupTargetUpdate": apiContext.UrlBuilder.ActionLink(res.Resource, "backupTargetUpdate"),
+		"backupTargetSync":           apiContext.UrlBuilder.ActionLink(res.Resource, "backupTargetSync"),
+		"backupTargetUpdate":         apiContext.UrlBuilder.ActionLink(res.Resource, "backupTargetUpdate"),
+		"backupTargetGarbageCollect": apiContext.UrlBuilder.ActionLink(res.Resource, "backupTargetGarbageCollect"),
 	}
 
 	return res
@@ -1895,6 +2290,7 @@ func toBackupVolumeResource(bv *longhorn.BackupVolume, apiContext *api.ApiContex
 		"backupListByVolume": apiContext.UrlBuilder.ActionLink(b.Resource, "backupListByVolume"),
 		"backupGet":          apiContext.UrlBuilder.ActionLink(b.Resource, "backupGet"),
 		"backupDelete":       apiContext.UrlBuilder.ActionLink(b.Resource, "backupDelete"),
+		"backupCopy":         apiContext.UrlBuilder.ActionLink(b.Resource, "backupCopy"),
 		"backupVolumeSync":   apiContext.UrlBuilder.ActionLink(b.Resource, "backupVolumeSync"),
 	}
 	return b
@@ -2010,6 +2406,7 @@ func toBackupResource(b *longhorn.Backup) *Backup {
 		NewlyUploadedDataSize:  b.Status.NewlyUploadedDataSize,
 		ReUploadedDataSize:     b.Status.ReUploadedDataSize,
 		BackupTargetName:       backupTargetName,
+		SourceBackupName:       b.Spec.SourceBackupName,
 	}
 	// Set the volume name from backup CR's label if it's empty.
 	// This field is empty probably because the backup state is not Ready
@@ -2150,6 +2547,7 @@ func toNodeResource(node *longhorn.Node, address string, apiContext *api.ApiCont
 		Zone:                      node.Status.Zone,
 		InstanceManagerCPURequest: node.Spec.InstanceManagerCPURequest,
 		AutoEvicting:              node.Status.AutoEvicting,
+		SettingOverrides:          node.Spec.SettingOverrides,
 	}
 
 	disks := map[string]DiskInfo{}
@@ -2238,15 +2636,15 @@ func toSupportBundleResource(nodeID string, supportBundle *manager.SupportBundle
 	}
 }
 
-func toSystemBackupCollection(systemBackups []*longhorn.SystemBackup) *client.GenericCollection {
+func toSystemBackupCollection(systemBackups []*longhorn.SystemBackup, apiContext *api.ApiContext) *client.GenericCollection {
 	data := []interface{}{}
 	for _, systemBackup := range systemBackups {
-		data = append(data, toSystemBackupResource(systemBackup))
+		data = append(data, toSystemBackupResource(systemBackup, apiContext))
 	}
 	return &client.GenericCollection{Data: data, Collection: client.Collection{ResourceType: "systemBackup"}}
 }
 
-func toSystemBackupResource(systemBackup *longhorn.SystemBackup) *SystemBackup {
+func toSystemBackupResource(systemBackup *longhorn.SystemBackup, apiContext *api.ApiContext) *SystemBackup {
 	err := ""
 	if systemBackup.Status.State == longhorn.SystemBackupStateError {
 		errCondition := types.GetCondition(systemBackup.Status.Conditions, longhorn.SystemBackupConditionTypeError)
@@ -2254,13 +2652,15 @@ func toSystemBackupResource(systemBackup *longhorn.SystemBackup) *SystemBackup {
 			err = fmt.Sprintf("%v: %v", errCondition.Reason, errCondition.Message)
 		}
 	}
-	return &SystemBackup{
+	res := &SystemBackup{
 		Resource: client.Resource{
 			Id:   systemBackup.Name,
 			Type: "systemBackup",
 		},
 		Name:               systemBackup.Name,
 		VolumeBackupPolicy: systemBackup.Spec.VolumeBackupPolicy,
+		Scope:              systemBackup.Spec.Scope,
+		BaseSystemBackup:   systemBackup.Spec.BaseSystemBackup,
 
 		Version:      systemBackup.Status.Version,
 		ManagerImage: systemBackup.Status.ManagerImage,
@@ -2268,6 +2668,49 @@ func toSystemBackupResource(systemBackup *longhorn.SystemBackup) *SystemBackup {
 		CreatedAt:    systemBackup.Status.CreatedAt.String(),
 		Error:        err,
 	}
+	res.Actions = map[string]string{
+		"systemBackupResourceList":     apiContext.UrlBuilder.ActionLink(res.Resource, "systemBackupResourceList"),
+		"systemBackupResourceGet":      apiContext.UrlBuilder.ActionLink(res.Resource, "systemBackupResourceGet"),
+		"systemBackupVolumeBackupList": apiContext.UrlBuilder.ActionLink(res.Resource, "systemBackupVolumeBackupList"),
+	}
+	return res
+}
+
+func toSystemBackupContentResource(obj *unstructured.Unstructured, yaml string) *SystemBackupResource {
+	return &SystemBackupResource{
+		Resource: client.Resource{
+			Id:   fmt.Sprintf("%v/%v/%v", obj.GetKind(), obj.GetNamespace(), obj.GetName()),
+			Type: "systemBackupResource",
+		},
+		Kind:      obj.GetKind(),
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+		YAML:      yaml,
+	}
+}
+
+func toSystemBackupContentCollection(objs []*unstructured.Unstructured) *client.GenericCollection {
+	data := []interface{}{}
+	for _, obj := range objs {
+		data = append(data, toSystemBackupContentResource(obj, ""))
+	}
+	return &client.GenericCollection{Data: data, Collection: client.Collection{ResourceType: "systemBackupResource"}}
+}
+
+func toSystemBackupVolumeBackupCollection(volumeBackups []*manager.SystemBackupVolumeBackup) *client.GenericCollection {
+	data := []interface{}{}
+	for _, volumeBackup := range volumeBackups {
+		data = append(data, &SystemBackupVolumeBackup{
+			Resource: client.Resource{
+				Id:   volumeBackup.VolumeName,
+				Type: "systemBackupVolumeBackup",
+			},
+			VolumeName:  volumeBackup.VolumeName,
+			BackupName:  volumeBackup.BackupName,
+			BackupState: volumeBackup.BackupState,
+		})
+	}
+	return &client.GenericCollection{Data: data, Collection: client.Collection{ResourceType: "systemBackupVolumeBackup"}}
 }
 
 func toSystemRestoreCollection(systemRestores []*longhorn.SystemRestore) *client.GenericCollection {
@@ -2293,9 +2736,60 @@ func toSystemRestoreResource(systemRestore *longhorn.SystemRestore) *SystemResto
 		},
 		Name:         systemRestore.Name,
 		SystemBackup: systemRestore.Spec.SystemBackup,
+		Include:      toSystemRestoreResourceFilter(systemRestore.Spec.Include),
+		Exclude:      toSystemRestoreResourceFilter(systemRestore.Spec.Exclude),
+		Remap:        systemRestore.Spec.Remap,
+		DryRun:       systemRestore.Spec.DryRun,
 		State:        systemRestore.Status.State,
 		CreatedAt:    systemRestore.CreationTimestamp.String(),
 		Error:        err,
+		DryRunReport: systemRestore.Status.DryRunReport,
+	}
+}
+
+func toSystemRestoreResourceFilter(filter longhorn.SystemRestoreResourceFilter) SystemRestoreResourceFilter {
+	f := SystemRestoreResourceFilter{
+		Kinds:      filter.Kinds,
+		Namespaces: filter.Namespaces,
+	}
+	if filter.VolumeSelector != nil {
+		f.VolumeSelector = filter.VolumeSelector.MatchLabels
+	}
+	return f
+}
+
+func toAlertCollection(alertRules []*longhorn.AlertRule, state longhorn.AlertState) *client.GenericCollection {
+	data := []interface{}{}
+	for _, alertRule := range alertRules {
+		for _, alert := range alertRule.Status.Alerts {
+			if state != "" && alert.State != state {
+				continue
+			}
+			data = append(data, toAlertResource(alertRule, alert))
+		}
+	}
+	return &client.GenericCollection{Data: data, Collection: client.Collection{ResourceType: "alert"}}
+}
+
+func toAlertResource(alertRule *longhorn.AlertRule, alert longhorn.Alert) *Alert {
+	firedAt := ""
+	if !alert.FiredAt.IsZero() {
+		firedAt = alert.FiredAt.UTC().Format(time.RFC3339)
+	}
+	return &Alert{
+		Resource: client.Resource{
+			Id:   alertRule.Name + "/" + alert.ObjectKind + "/" + alert.ObjectName,
+			Type: "alert",
+		},
+		Rule:       alertRule.Name,
+		RuleType:   alertRule.Spec.Type,
+		Severity:   alertRule.Spec.Severity,
+		ObjectKind: alert.ObjectKind,
+		ObjectName: alert.ObjectName,
+		State:      alert.State,
+		Message:    alert.Message,
+		StartsAt:   alert.StartsAt.UTC().Format(time.RFC3339),
+		FiredAt:    firedAt,
 	}
 }
 
@@ -61,8 +61,11 @@ func NewRouter(s *Server) *mux.Router {
 	r.Methods("GET").Path("/v1/schemas/{id}").Handler(api.SchemaHandler(schemas))
 
 	r.Methods("GET").Path("/v1/settings").Handler(f(schemas, s.SettingList))
+	r.Methods("PUT").Path("/v1/settings").Handler(f(schemas, s.SettingsSet))
 	r.Methods("GET").Path("/v1/settings/{name}").Handler(f(schemas, s.SettingGet))
 	r.Methods("PUT").Path("/v1/settings/{name}").Handler(f(schemas, s.SettingSet))
+	r.Methods("GET").Path("/v1/settings/{name}/history").Handler(f(schemas, s.SettingHistoryGet))
+	r.Methods("POST").Path("/v1/settings/{name}").Queries("action", "settingRollback").Handler(f(schemas, s.SettingRollback))
 
 	r.Methods("GET").Path("/v1/volumes").Handler(f(schemas, s.VolumeList))
 	r.Methods("GET").Path("/v1/volumes/{name}").Handler(f(schemas, s.VolumeGet))
@@ -77,6 +80,7 @@ func NewRouter(s *Server) *mux.Router {
 		"updateUnmapMarkSnapChainRemoved":   s.VolumeUpdateUnmapMarkSnapChainRemoved,
 		"updateSnapshotMaxCount":            s.VolumeUpdateSnapshotMaxCount,
 		"updateSnapshotMaxSize":             s.VolumeUpdateSnapshotMaxSize,
+		"updateIOQoS":                       s.VolumeUpdateIOQoS,
 		"updateReplicaSoftAntiAffinity":     s.VolumeUpdateReplicaSoftAntiAffinity,
 		"updateReplicaZoneSoftAntiAffinity": s.VolumeUpdateReplicaZoneSoftAntiAffinity,
 		"updateReplicaDiskSoftAntiAffinity": s.VolumeUpdateReplicaDiskSoftAntiAffinity,
@@ -96,13 +100,15 @@ func NewRouter(s *Server) *mux.Router {
 
 		"trimFilesystem": s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromVolume(s.m)), s.VolumeFilesystemTrim),
 
-		"snapshotPurge":  s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromVolume(s.m)), s.SnapshotPurge),
-		"snapshotCreate": s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromVolume(s.m)), s.SnapshotCreate),
-		"snapshotList":   s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromVolume(s.m)), s.SnapshotList),
-		"snapshotGet":    s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromVolume(s.m)), s.SnapshotGet),
-		"snapshotDelete": s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromVolume(s.m)), s.SnapshotDelete),
-		"snapshotRevert": s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromVolume(s.m)), s.SnapshotRevert),
-		"snapshotBackup": s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromVolume(s.m)), s.SnapshotBackup),
+		"snapshotPurge":         s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromVolume(s.m)), s.SnapshotPurge),
+		"snapshotCreate":        s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromVolume(s.m)), s.SnapshotCreate),
+		"snapshotList":          s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromVolume(s.m)), s.SnapshotList),
+		"snapshotGet":           s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromVolume(s.m)), s.SnapshotGet),
+		"snapshotChangedBlocks": s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromVolume(s.m)), s.SnapshotChangedBlocks),
+		"ioStats":               s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromVolume(s.m)), s.VolumeIOStats),
+		"snapshotDelete":        s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromVolume(s.m)), s.SnapshotDelete),
+		"snapshotRevert":        s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromVolume(s.m)), s.SnapshotRevert),
+		"snapshotBackup":        s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromVolume(s.m)), s.SnapshotBackup),
 
 		"snapshotCRCreate": s.SnapshotCRCreate,
 		"snapshotCRList":   s.SnapshotCRList,
@@ -126,8 +132,9 @@ func NewRouter(s *Server) *mux.Router {
 	r.Methods("PUT").Path("/v1/backuptargets").Handler(f(schemas, s.BackupTargetSyncAll))
 	r.Methods("DELETE").Path("/v1/backuptargets/{backupTargetName}").Handler(f(schemas, s.BackupTargetDelete))
 	backupTargetActions := map[string]func(http.ResponseWriter, *http.Request) error{
-		"backupTargetSync":   s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromBackupTarget(s.m)), s.BackupTargetSync),
-		"backupTargetUpdate": s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromBackupTarget(s.m)), s.BackupTargetUpdate),
+		"backupTargetSync":           s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromBackupTarget(s.m)), s.BackupTargetSync),
+		"backupTargetUpdate":         s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromBackupTarget(s.m)), s.BackupTargetUpdate),
+		"backupTargetGarbageCollect": s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromBackupTarget(s.m)), s.BackupTargetGarbageCollect),
 	}
 	for name, action := range backupTargetActions {
 		r.Methods("POST").Path("/v1/backuptargets/{backupTargetName}").Queries("action", name).Handler(f(schemas, action))
@@ -142,6 +149,7 @@ func NewRouter(s *Server) *mux.Router {
 		"backupListByVolume": s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(NodeHasDefaultEngineImage(s.m)), s.BackupListByVolume),
 		"backupGet":          s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(NodeHasDefaultEngineImage(s.m)), s.BackupGet),
 		"backupDelete":       s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(NodeHasDefaultEngineImage(s.m)), s.BackupDelete),
+		"backupCopy":         s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(NodeHasDefaultEngineImage(s.m)), s.BackupCopy),
 		"backupVolumeSync":   s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(NodeHasDefaultEngineImage(s.m)), s.SyncBackupVolume),
 	}
 	for name, action := range backupActions {
@@ -166,6 +174,10 @@ func NewRouter(s *Server) *mux.Router {
 
 	r.Methods("Get").Path("/v1/events").Handler(f(schemas, s.EventList))
 
+	r.Methods("GET").Path("/v1/alerts").Handler(f(schemas, s.AlertList))
+
+	r.Methods("GET").Path("/v1/upgradereadiness").Handler(f(schemas, s.UpgradeReadinessGet))
+
 	r.Methods("GET").Path("/v1/disktags").Handler(f(schemas, s.DiskTagList))
 	r.Methods("GET").Path("/v1/nodetags").Handler(f(schemas, s.NodeTagList))
 
@@ -220,6 +232,14 @@ func NewRouter(s *Server) *mux.Router {
 	r.Methods("GET").Path("/v1/systembackups").Handler(f(schemas, s.SystemBackupList))
 	r.Methods("GET").Path("/v1/systembackups/{name}").Handler(f(schemas, s.SystemBackupGet))
 	r.Methods("DELETE").Path("/v1/systembackups/{name}").Handler(f(schemas, s.SystemBackupDelete))
+	systemBackupActions := map[string]func(http.ResponseWriter, *http.Request) error{
+		"systemBackupResourceList":     s.SystemBackupResourceList,
+		"systemBackupResourceGet":      s.SystemBackupResourceGet,
+		"systemBackupVolumeBackupList": s.SystemBackupVolumeBackupList,
+	}
+	for name, action := range systemBackupActions {
+		r.Methods("POST").Path("/v1/systembackups/{name}").Queries("action", name).Handler(f(schemas, action))
+	}
 
 	r.Methods("POST").Path("/v1/systemrestores").Handler(f(schemas, s.SystemRestoreCreate))
 	r.Methods("GET").Path("/v1/systemrestores").Handler(f(schemas, s.SystemRestoreList))
@@ -234,6 +254,12 @@ func NewRouter(s *Server) *mux.Router {
 	r.Path("/v1/ws/volumes").Handler(f(schemas, volumeListStream))
 	r.Path("/v1/ws/{period}/volumes").Handler(f(schemas, volumeListStream))
 
+	// The IO statistics history is only kept by the volume owner node
+	volumeIOStatsStream := s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromVolume(s.m)),
+		s.newVolumeIOStatsStreamHandlerFunc(s.wsc.NewWatcher("volume")))
+	r.Path("/v1/ws/volumes/{name}/iostats").Handler(f(schemas, volumeIOStatsStream))
+	r.Path("/v1/ws/{period}/volumes/{name}/iostats").Handler(f(schemas, volumeIOStatsStream))
+
 	recurringJobListStream := NewStreamHandlerFunc("recurringjobs", s.wsc.NewWatcher("recurringJob"), s.recurringJobList)
 	r.Path("/v1/ws/recurringjobs").Handler(f(schemas, recurringJobListStream))
 	r.Path("/v1/ws/{period}/recurringjobs").Handler(f(schemas, recurringJobListStream))
@@ -286,6 +312,10 @@ func NewRouter(s *Server) *mux.Router {
 	r.Path("/v1/ws/systemrestores").Handler(f(schemas, systemRestoreStream))
 	r.Path("/v1/ws/{period}/systemrestores").Handler(f(schemas, systemRestoreStream))
 
+	alertListStream := NewStreamHandlerFunc("alerts", s.wsc.NewWatcher("alertRule"), s.alertList)
+	r.Path("/v1/ws/alerts").Handler(f(schemas, alertListStream))
+	r.Path("/v1/ws/{period}/alerts").Handler(f(schemas, alertListStream))
+
 	eventListStream := NewStreamHandlerFunc("events", s.wsc.NewWatcher("event"), s.eventList)
 	r.Path("/v1/ws/events").Handler(f(schemas, eventListStream))
 	r.Path("/v1/ws/{period}/events").Handler(f(schemas, eventListStream))
@@ -29,7 +29,7 @@ func (s *Server) settingList(apiContext *api.ApiContext) (*client.GenericCollect
 	if err != nil || sList == nil {
 		return nil, errors.Wrap(err, "failed to list settings")
 	}
-	return toSettingCollection(sList), nil
+	return toSettingCollection(sList, apiContext), nil
 }
 
 func (s *Server) SettingGet(w http.ResponseWriter, req *http.Request) error {
@@ -40,7 +40,7 @@ func (s *Server) SettingGet(w http.ResponseWriter, req *http.Request) error {
 	if err != nil {
 		return errors.Wrapf(err, "failed to get setting %v", name)
 	}
-	apiContext.Write(toSettingResource(si))
+	apiContext.Write(toSettingResource(si, apiContext))
 	return nil
 }
 
@@ -65,6 +65,56 @@ func (s *Server) SettingSet(w http.ResponseWriter, req *http.Request) error {
 		return err
 	}
 
-	apiContext.Write(toSettingResource(si))
+	apiContext.Write(toSettingResource(si, apiContext))
+	return nil
+}
+
+func (s *Server) SettingsSet(w http.ResponseWriter, req *http.Request) error {
+	var input SettingsInput
+
+	apiContext := api.GetApiContext(req)
+	if err := apiContext.Read(&input); err != nil {
+		return err
+	}
+
+	values := map[types.SettingName]string{}
+	for name, value := range input.Settings {
+		values[types.SettingName(name)] = strings.TrimSpace(value)
+	}
+
+	sList, err := s.m.UpdateSettings(values)
+	if err != nil {
+		return errors.Wrap(err, "failed to update settings")
+	}
+	apiContext.Write(toSettingCollection(sList, apiContext))
+	return nil
+}
+
+func (s *Server) SettingHistoryGet(w http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+
+	apiContext := api.GetApiContext(req)
+	si, err := s.m.GetSetting(types.SettingName(name))
+	if err != nil {
+		return errors.Wrapf(err, "failed to get setting %v", name)
+	}
+	apiContext.Write(toSettingHistoryCollection(si))
+	return nil
+}
+
+func (s *Server) SettingRollback(w http.ResponseWriter, req *http.Request) error {
+	var input SettingRollbackInput
+
+	apiContext := api.GetApiContext(req)
+	if err := apiContext.Read(&input); err != nil {
+		return err
+	}
+
+	name := mux.Vars(req)["name"]
+	si, err := s.m.RollbackSetting(types.SettingName(name), input.Revision)
+	if err != nil {
+		return errors.Wrapf(err, "failed to roll back setting %v", name)
+	}
+	apiContext.Write(toSettingResource(si, apiContext))
 	return nil
 }
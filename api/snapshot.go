@@ -96,6 +96,28 @@ func (s *Server) SnapshotGet(w http.ResponseWriter, req *http.Request) (err erro
 	return nil
 }
 
+func (s *Server) SnapshotChangedBlocks(w http.ResponseWriter, req *http.Request) (err error) {
+	defer func() {
+		err = errors.Wrap(err, "failed to get snapshot changed blocks")
+	}()
+
+	var input SnapshotChangedBlocksInput
+
+	apiContext := api.GetApiContext(req)
+	if err := apiContext.Read(&input); err != nil {
+		return err
+	}
+	volName := mux.Vars(req)["name"]
+
+	extents, err := s.m.GetSnapshotChangedBlocks(volName, input.FromSnapshot, input.ToSnapshot)
+	if err != nil {
+		return err
+	}
+
+	apiContext.Write(toSnapshotChangedBlocksResource(volName, input.FromSnapshot, input.ToSnapshot, extents))
+	return nil
+}
+
 func (s *Server) SnapshotDelete(w http.ResponseWriter, req *http.Request) (err error) {
 	defer func() {
 		err = errors.Wrap(err, "failed to delete snapshot")
@@ -82,9 +82,9 @@ func NewStreamHandlerFunc(streamType string, watcher *controller.Watcher, listFu
 				resp, err = writeList(conn, resp, listFunc, apiContext)
 			case <-keepAliveTicker.C:
 				err = conn.WriteControl(websocket.PingMessage, []byte{}, time.Now().Add(writeWait))
-				// WebsocketController doesn't include eventInformer so it only
-				// gets triggered here.
-				if streamType == "events" {
+				// WebsocketController doesn't include eventInformer and the IO
+				// statistics aren't resources so they only get triggered here.
+				if streamType == "events" || streamType == "volumeiostats" {
 					resp, err = writeList(conn, resp, listFunc, apiContext)
 				}
 			}
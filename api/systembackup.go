@@ -9,6 +9,8 @@ import (
 	"github.com/rancher/go-rancher/api"
 	"github.com/rancher/go-rancher/client"
 
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
@@ -29,6 +31,8 @@ func (s *Server) SystemBackupCreate(w http.ResponseWriter, req *http.Request) er
 		},
 		Spec: longhorn.SystemBackupSpec{
 			VolumeBackupPolicy: input.VolumeBackupPolicy,
+			Scope:              input.Scope,
+			BaseSystemBackup:   input.BaseSystemBackup,
 		},
 	}
 	systemBackup, err := s.m.CreateSystemBackup(obj)
@@ -36,7 +40,7 @@ func (s *Server) SystemBackupCreate(w http.ResponseWriter, req *http.Request) er
 		return errors.Wrapf(err, "failed to create SystemBackup")
 	}
 
-	apiContext.Write(toSystemBackupResource(systemBackup))
+	apiContext.Write(toSystemBackupResource(systemBackup, apiContext))
 	return nil
 }
 
@@ -59,7 +63,7 @@ func (s *Server) SystemBackupGet(rw http.ResponseWriter, req *http.Request) erro
 	if err != nil {
 		return errors.Wrapf(err, "failed to get SystemBackup '%s'", name)
 	}
-	apiContext.Write(toSystemBackupResource(systemBackup))
+	apiContext.Write(toSystemBackupResource(systemBackup, apiContext))
 	return nil
 }
 
@@ -70,7 +74,7 @@ func (s *Server) SystemBackupList(w http.ResponseWriter, req *http.Request) erro
 	}
 
 	apiContext := api.GetApiContext(req)
-	apiContext.Write(toSystemBackupCollection(systemBackups))
+	apiContext.Write(toSystemBackupCollection(systemBackups, apiContext))
 	return nil
 }
 
@@ -79,5 +83,69 @@ func (s *Server) systemBackupList(apiContext *api.ApiContext) (*client.GenericCo
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to list SystemBackups")
 	}
-	return toSystemBackupCollection(systemBackups), nil
+	return toSystemBackupCollection(systemBackups, apiContext), nil
+}
+
+func (s *Server) SystemBackupResourceList(w http.ResponseWriter, req *http.Request) error {
+	var input SystemBackupResourceListInput
+
+	apiContext := api.GetApiContext(req)
+	if err := apiContext.Read(&input); err != nil {
+		return err
+	}
+
+	name := mux.Vars(req)["name"]
+
+	content, err := s.m.GetSystemBackupContent(name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get content of SystemBackup '%s'", name)
+	}
+
+	resources := []*unstructured.Unstructured{}
+	for _, obj := range content.Resources {
+		if input.Kind != "" && obj.GetKind() != input.Kind {
+			continue
+		}
+		resources = append(resources, obj)
+	}
+
+	apiContext.Write(toSystemBackupContentCollection(resources))
+	return nil
+}
+
+func (s *Server) SystemBackupResourceGet(w http.ResponseWriter, req *http.Request) error {
+	var input SystemBackupResourceInput
+
+	apiContext := api.GetApiContext(req)
+	if err := apiContext.Read(&input); err != nil {
+		return err
+	}
+
+	name := mux.Vars(req)["name"]
+
+	yaml, err := s.m.GetSystemBackupResourceYAML(name, input.Kind, input.Namespace, input.Name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get resource of SystemBackup '%s'", name)
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetKind(input.Kind)
+	obj.SetNamespace(input.Namespace)
+	obj.SetName(input.Name)
+	apiContext.Write(toSystemBackupContentResource(obj, yaml))
+	return nil
+}
+
+func (s *Server) SystemBackupVolumeBackupList(w http.ResponseWriter, req *http.Request) error {
+	apiContext := api.GetApiContext(req)
+
+	name := mux.Vars(req)["name"]
+
+	volumeBackups, err := s.m.ListSystemBackupVolumeBackups(name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to list volume backups of SystemBackup '%s'", name)
+	}
+
+	apiContext.Write(toSystemBackupVolumeBackupCollection(volumeBackups))
+	return nil
 }
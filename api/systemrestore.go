@@ -8,6 +8,10 @@ import (
 
 	"github.com/rancher/go-rancher/api"
 	"github.com/rancher/go-rancher/client"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
 
 func (s *Server) SystemRestoreCreate(w http.ResponseWriter, req *http.Request) error {
@@ -19,7 +23,8 @@ func (s *Server) SystemRestoreCreate(w http.ResponseWriter, req *http.Request) e
 		return err
 	}
 
-	systemRestore, err := s.m.CreateSystemRestore(input.Name, input.SystemBackup)
+	systemRestore, err := s.m.CreateSystemRestore(input.Name, input.SystemBackup,
+		fromSystemRestoreResourceFilter(input.Include), fromSystemRestoreResourceFilter(input.Exclude), input.Remap, input.DryRun)
 	if err != nil {
 		return errors.Wrapf(err, "failed to create SystemRestore %v", input.Name)
 	}
@@ -68,3 +73,16 @@ func (s *Server) systemRestoreList(apiContext *api.ApiContext) (*client.GenericC
 	}
 	return toSystemRestoreCollection(systemRestores), nil
 }
+
+func fromSystemRestoreResourceFilter(filter SystemRestoreResourceFilter) longhorn.SystemRestoreResourceFilter {
+	f := longhorn.SystemRestoreResourceFilter{
+		Kinds:      filter.Kinds,
+		Namespaces: filter.Namespaces,
+	}
+	if len(filter.VolumeSelector) != 0 {
+		f.VolumeSelector = &metav1.LabelSelector{
+			MatchLabels: filter.VolumeSelector,
+		}
+	}
+	return f
+}
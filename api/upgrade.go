@@ -0,0 +1,22 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/rancher/go-rancher/api"
+)
+
+func (s *Server) UpgradeReadinessGet(rw http.ResponseWriter, req *http.Request) error {
+	apiContext := api.GetApiContext(req)
+
+	targetVersion := req.URL.Query().Get("targetVersion")
+	report, err := s.m.GetUpgradeReadiness(targetVersion)
+	if err != nil {
+		return errors.Wrap(err, "failed to check upgrade readiness")
+	}
+
+	apiContext.Write(toUpgradeReadinessReportResource(report))
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"sort"
+	"strconv"
 
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
@@ -13,6 +14,7 @@ import (
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 
+	"github.com/longhorn/longhorn-manager/controller"
 	"github.com/longhorn/longhorn-manager/datastore"
 	"github.com/longhorn/longhorn-manager/util"
 
@@ -26,7 +28,16 @@ func (s *Server) VolumeList(rw http.ResponseWriter, req *http.Request) (err erro
 
 	apiContext := api.GetApiContext(req)
 
-	resp, err := s.volumeList(apiContext)
+	// healthScoreBelow lists only the volumes with a health score below the threshold
+	healthScoreBelow := -1
+	if value := req.URL.Query().Get("healthScoreBelow"); value != "" {
+		healthScoreBelow, err = strconv.Atoi(value)
+		if err != nil || healthScoreBelow < 0 {
+			return errors.Errorf("invalid healthScoreBelow %v", value)
+		}
+	}
+
+	resp, err := s.listVolumes(apiContext, healthScoreBelow)
 	if err != nil {
 		return err
 	}
@@ -37,6 +48,11 @@ func (s *Server) VolumeList(rw http.ResponseWriter, req *http.Request) (err erro
 }
 
 func (s *Server) volumeList(apiContext *api.ApiContext) (*client.GenericCollection, error) {
+	return s.listVolumes(apiContext, -1)
+}
+
+// listVolumes lists the volumes with a health score below healthScoreBelow, or all volumes if it's negative.
+func (s *Server) listVolumes(apiContext *api.ApiContext, healthScoreBelow int) (*client.GenericCollection, error) {
 	resp := &client.GenericCollection{}
 
 	volumes, err := s.m.ListSorted()
@@ -45,6 +61,9 @@ func (s *Server) volumeList(apiContext *api.ApiContext) (*client.GenericCollecti
 	}
 
 	for _, v := range volumes {
+		if healthScoreBelow >= 0 && v.Status.HealthScore >= healthScoreBelow {
+			continue
+		}
 		controllers, err := s.m.GetEnginesSorted(v.Name)
 		if err != nil {
 			return nil, err
@@ -201,6 +220,7 @@ func (s *Server) VolumeCreate(rw http.ResponseWriter, req *http.Request) error {
 		DataEngine:                  volume.DataEngine,
 		FreezeFilesystemForSnapshot: volume.FreezeFilesystemForSnapshot,
 		BackupTargetName:            volume.BackupTargetName,
+		IOQoS:                       volume.IOQoS,
 	}, volume.RecurringJobSelector)
 	if err != nil {
 		return errors.Wrap(err, "failed to create volume")
@@ -228,7 +248,7 @@ func (s *Server) VolumeAttach(rw http.ResponseWriter, req *http.Request) error {
 	id := mux.Vars(req)["name"]
 
 	obj, err := util.RetryOnConflictCause(func() (interface{}, error) {
-		return s.m.Attach(id, input.HostID, input.DisableFrontend, input.AttachedBy, input.AttacherType, input.AttachmentID)
+		return s.m.Attach(req.Context(), id, input.HostID, input.DisableFrontend, input.AttachedBy, input.AttacherType, input.AttachmentID)
 	})
 	if err != nil {
 		return err
@@ -253,7 +273,7 @@ func (s *Server) VolumeDetach(rw http.ResponseWriter, req *http.Request) error {
 	id := mux.Vars(req)["name"]
 
 	obj, err := util.RetryOnConflictCause(func() (interface{}, error) {
-		return s.m.Detach(id, input.AttachmentID, input.HostID, input.ForceDetach)
+		return s.m.Detach(req.Context(), id, input.AttachmentID, input.HostID, input.ForceDetach)
 	})
 	if err != nil {
 		return err
@@ -814,6 +834,64 @@ func (s *Server) VolumeUpdateSnapshotMaxSize(rw http.ResponseWriter, req *http.R
 	return s.responseWithVolume(rw, req, "", v)
 }
 
+func (s *Server) VolumeIOStats(rw http.ResponseWriter, req *http.Request) error {
+	id := mux.Vars(req)["name"]
+
+	samples, err := s.m.GetVolumeIOStats(id)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get IO statistics of volume %v", id)
+	}
+
+	apiContext := api.GetApiContext(req)
+	apiContext.Write(toVolumeIOStatsResource(id, samples))
+	return nil
+}
+
+// newVolumeIOStatsStreamHandlerFunc streams the IO statistics history of the volume in the request path.
+func (s *Server) newVolumeIOStatsStreamHandlerFunc(watcher *controller.Watcher) func(w http.ResponseWriter, req *http.Request) error {
+	return func(w http.ResponseWriter, req *http.Request) error {
+		id := mux.Vars(req)["name"]
+		listFunc := func(apiContext *api.ApiContext) (*client.GenericCollection, error) {
+			samples, err := s.m.GetVolumeIOStats(id)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get IO statistics of volume %v", id)
+			}
+			return toVolumeIOStatsCollection(id, samples), nil
+		}
+		return NewStreamHandlerFunc("volumeiostats", watcher, listFunc)(w, req)
+	}
+}
+
+func (s *Server) VolumeUpdateIOQoS(rw http.ResponseWriter, req *http.Request) error {
+	var input UpdateIOQoSInput
+	id := mux.Vars(req)["name"]
+
+	apiContext := api.GetApiContext(req)
+	if err := apiContext.Read(&input); err != nil {
+		return errors.Wrap(err, "failed to read IOQoS input")
+	}
+
+	ioQoS := longhorn.VolumeIOQoS{
+		MaxReadIOPS:       input.MaxReadIOPS,
+		MaxWriteIOPS:      input.MaxWriteIOPS,
+		MaxReadBandwidth:  input.MaxReadBandwidth,
+		MaxWriteBandwidth: input.MaxWriteBandwidth,
+		BurstSeconds:      input.BurstSeconds,
+	}
+
+	obj, err := util.RetryOnConflictCause(func() (interface{}, error) {
+		return s.m.UpdateIOQoS(id, ioQoS)
+	})
+	if err != nil {
+		return err
+	}
+	v, ok := obj.(*longhorn.Volume)
+	if !ok {
+		return fmt.Errorf("failed to convert to volume %v object", id)
+	}
+	return s.responseWithVolume(rw, req, "", v)
+}
+
 func (s *Server) VolumeUpdateFreezeFilesystemForSnapshot(rw http.ResponseWriter, req *http.Request) error {
 	var input UpdateFreezeFilesystemForSnapshotInput
 	id := mux.Vars(req)["name"]
@@ -0,0 +1,205 @@
+package app
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+
+	"github.com/longhorn/backupstore"
+	"github.com/longhorn/backupstore/systembackup"
+
+	_ "github.com/longhorn/backupstore/azblob"
+	_ "github.com/longhorn/backupstore/cifs"
+	_ "github.com/longhorn/backupstore/nfs"
+	_ "github.com/longhorn/backupstore/s3"
+
+	"github.com/longhorn/longhorn-manager/types"
+
+	bsops "github.com/longhorn/longhorn-manager/util/backupstore"
+)
+
+// BackupStoreCmd runs the backupstore operations which are not provided by the engine binary. The manager executes
+// the command with the credential of the backup target as the environment variables, so that the operations on
+// different backup targets can run at the same time. The data transfer is capped by the bandwidth limits passed in
+// the environment variable as well.
+func BackupStoreCmd() cli.Command {
+	return cli.Command{
+		Name:   bsops.CommandName,
+		Hidden: true,
+		Subcommands: []cli.Command{
+			{
+				Name:      bsops.CommandCopyExport,
+				Usage:     "Write the backup as a copy stream to stdout, skipping the blocks listed as a JSON array in stdin",
+				ArgsUsage: "<backup URL>",
+				Action: func(c *cli.Context) {
+					if err := backupStoreCopyExport(c); err != nil {
+						logrus.WithError(err).Fatal("Failed to export backup")
+					}
+				},
+			},
+			{
+				Name:      bsops.CommandCopyImport,
+				Usage:     "Print the existing blocks of the volume as a JSON array, then create the backup from the copy stream in stdin",
+				ArgsUsage: "<backup target URL>",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:     bsops.FlagVolume,
+						Required: true,
+						Usage:    "Specify the volume name of the backup",
+					},
+					cli.StringFlag{
+						Name:     bsops.FlagBackupName,
+						Required: true,
+						Usage:    "Specify the name of the new backup",
+					},
+					cli.StringSliceFlag{
+						Name:  bsops.FlagLabel,
+						Usage: "Specify the labels of the new backup as key=value (optional)",
+					},
+				},
+				Action: func(c *cli.Context) {
+					if err := backupStoreCopyImport(c); err != nil {
+						logrus.WithError(err).Fatal("Failed to import backup")
+					}
+				},
+			},
+		},
+	}
+}
+
+func backupStoreCopyExport(c *cli.Context) error {
+	backupURL := c.Args().First()
+	if backupURL == "" {
+		return fmt.Errorf("backup URL is required")
+	}
+	backupName, volumeName, _, err := backupstore.DecodeBackupURL(backupURL)
+	if err != nil {
+		return err
+	}
+	driver, err := backupstore.GetBackupStoreDriver(backupURL)
+	if err != nil {
+		return err
+	}
+
+	skipBlocks := []string{}
+	if err := json.NewDecoder(os.Stdin).Decode(&skipBlocks); err != nil {
+		return errors.Wrap(err, "failed to decode the blocks to skip")
+	}
+
+	limits, err := bsops.ParseBandwidthLimits(os.Getenv(types.EnvBackupStoreBandwidthLimit))
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(bsops.NewThrottledWriter(os.Stdout, bsops.GetRestoreBandwidthLimitFunc(limits)))
+	if err := bsops.ExportBackup(driver, backupName, volumeName, skipBlocks, w); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+func backupStoreCopyImport(c *cli.Context) error {
+	backupTargetURL := c.Args().First()
+	if backupTargetURL == "" {
+		return fmt.Errorf("backup target URL is required")
+	}
+	labels := map[string]string{}
+	for _, label := range c.StringSlice(bsops.FlagLabel) {
+		kv := strings.SplitN(label, "=", 2)
+		if len(kv) != 2 {
+			return fmt.Errorf("invalid label %v", label)
+		}
+		labels[kv[0]] = kv[1]
+	}
+
+	driver, err := backupstore.GetBackupStoreDriver(backupTargetURL)
+	if err != nil {
+		return err
+	}
+	limits, err := bsops.ParseBandwidthLimits(os.Getenv(types.EnvBackupStoreBandwidthLimit))
+	if err != nil {
+		return err
+	}
+
+	importer := bsops.NewBackupImporter(driver, c.String(bsops.FlagVolume))
+	defer importer.Close()
+
+	existingBlocks, err := importer.Prepare()
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(os.Stdout)
+	if err := encoder.Encode(existingBlocks); err != nil {
+		return err
+	}
+
+	return importer.Import(c.String(bsops.FlagBackupName), labels, bufio.NewReader(bsops.NewThrottledReader(os.Stdin, bsops.GetBackupBandwidthLimitFunc(limits))), func(progress int) {
+		if err := encoder.Encode(bsops.CopyProgress{Progress: progress}); err != nil {
+			logrus.WithError(err).Warn("Failed to report copy progress")
+		}
+	})
+}
+
+func backupStoreSystemBackupUpload(c *cli.Context) error {
+	if c.NArg() != 2 {
+		return fmt.Errorf("local file and system backup URL are required")
+	}
+	localFilePath, systemBackupURL := c.Args().Get(0), c.Args().Get(1)
+
+	backupTargetURL, version, name, err := systembackup.ParseSystemBackupURL(systemBackupURL)
+	if err != nil {
+		return err
+	}
+	driver, err := backupstore.GetBackupStoreDriver(backupTargetURL)
+	if err != nil {
+		return err
+	}
+	limits, err := bsops.ParseBandwidthLimits(os.Getenv(types.EnvBackupStoreBandwidthLimit))
+	if err != nil {
+		return err
+	}
+
+	cfg := &systembackup.Config{
+		Name:              name,
+		LonghornVersion:   version,
+		LonghornGitCommit: c.String(bsops.FlagGitCommit),
+		BackupTargetURL:   backupTargetURL,
+		ManagerImage:      c.String(bsops.FlagManagerImage),
+		EngineImage:       c.String(bsops.FlagEngineImage),
+		CreatedAt:         time.Now().UTC(),
+	}
+	return bsops.UploadSystemBackup(driver, localFilePath, cfg, bsops.GetBackupBandwidthLimitFunc(limits))
+}
+
+func backupStoreSystemBackupDownload(c *cli.Context) error {
+	if c.NArg() != 2 {
+		return fmt.Errorf("system backup URL and local file are required")
+	}
+	systemBackupURL, localFilePath := c.Args().Get(0), c.Args().Get(1)
+
+	backupTargetURL, version, name, err := systembackup.ParseSystemBackupURL(systemBackupURL)
+	if err != nil {
+		return err
+	}
+	driver, err := backupstore.GetBackupStoreDriver(backupTargetURL)
+	if err != nil {
+		return err
+	}
+	limits, err := bsops.ParseBandwidthLimits(os.Getenv(types.EnvBackupStoreBandwidthLimit))
+	if err != nil {
+		return err
+	}
+
+	cfg, err := systembackup.LoadConfig(name, version, backupTargetURL)
+	if err != nil {
+		return errors.Wrapf(err, "failed to load system backup config %v", name)
+	}
+	return bsops.DownloadSystemBackup(driver, cfg, localFilePath, bsops.GetRestoreBandwidthLimitFunc(limits))
+}
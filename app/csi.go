@@ -1,11 +1,14 @@
 package app
 
 import (
+	"context"
+
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
 
 	"github.com/longhorn/longhorn-manager/csi"
 	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util/tracing"
 )
 
 func CSICommand() cli.Command {
@@ -31,6 +34,10 @@ func CSICommand() cli.Command {
 				Value: "",
 				Usage: "Longhorn manager API URL",
 			},
+			cli.StringFlag{
+				Name:  FlagTracingEndpoint,
+				Usage: "Specify the OpenTelemetry collector endpoint receiving the traces via OTLP over gRPC, e.g. http://otel-collector:4317 (optional, tracing is disabled if not set)",
+			},
 		},
 		Action: func(c *cli.Context) {
 			if err := runCSI(c); err != nil {
@@ -41,6 +48,18 @@ func CSICommand() cli.Command {
 }
 
 func runCSI(c *cli.Context) error {
+	shutdownTracing, err := tracing.Init(context.Background(), c.String(FlagTracingEndpoint), types.CSIPluginName, c.String("nodeid"))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+		defer cancel()
+		if err := shutdownTracing(shutdownCtx); err != nil {
+			logrus.WithError(err).Warn("Failed to flush the remaining traces")
+		}
+	}()
+
 	manager := csi.GetCSIManager()
 	identityVersion := c.App.Version
 	return manager.Run(c.String("drivername"),
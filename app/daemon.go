@@ -37,6 +37,7 @@ import (
 	"github.com/longhorn/longhorn-manager/upgrade"
 	"github.com/longhorn/longhorn-manager/util"
 	"github.com/longhorn/longhorn-manager/util/client"
+	"github.com/longhorn/longhorn-manager/util/tracing"
 	"github.com/longhorn/longhorn-manager/webhook"
 
 	metricscollector "github.com/longhorn/longhorn-manager/metrics_collector"
@@ -53,10 +54,13 @@ const (
 	FlagServiceAccount            = "service-account"
 	FlagKubeConfig                = "kube-config"
 	FlagUpgradeVersionCheck       = "upgrade-version-check"
+	FlagTracingEndpoint           = "tracing-endpoint"
 )
 
 const (
 	LeaseLockNameWebhook = "longhorn-manager-webhook-lock"
+
+	tracingShutdownTimeout = 5 * time.Second
 )
 
 func DaemonCmd() cli.Command {
@@ -99,6 +103,10 @@ func DaemonCmd() cli.Command {
 				Name:  FlagUpgradeVersionCheck,
 				Usage: "Enforce version checking for upgrades. If disabled, there will be no requirement for the necessary upgrade source version",
 			},
+			cli.StringFlag{
+				Name:  FlagTracingEndpoint,
+				Usage: "Specify the OpenTelemetry collector endpoint receiving the traces via OTLP over gRPC, e.g. http://otel-collector:4317 (optional, tracing is disabled if not set)",
+			},
 		},
 		Action: func(c *cli.Context) {
 			if err := startManager(c); err != nil {
@@ -286,6 +294,18 @@ func startManager(c *cli.Context) error {
 
 	logger := logrus.StandardLogger().WithField("node", currentNodeID)
 
+	shutdownTracing, err := tracing.Init(ctx, c.String(FlagTracingEndpoint), types.LonghornManagerDaemonSetName, currentNodeID)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+		defer cancel()
+		if err := shutdownTracing(shutdownCtx); err != nil {
+			logger.WithError(err).Warn("Failed to flush the remaining traces")
+		}
+	}()
+
 	err = startWebhooksByLeaderElection(ctx, kubeconfigPath, currentNodeID)
 	if err != nil {
 		return err
@@ -318,6 +338,7 @@ func startManager(c *cli.Context) error {
 	}
 
 	m := manager.NewVolumeManager(currentNodeID, clients.Datastore, proxyConnCounter)
+	m.StartVolumeIOStatsRecorder(ctx.Done())
 
 	metricscollector.InitMetricsCollectorSystem(logger, currentNodeID, clients.Datastore, kubeconfigPath, proxyConnCounter)
 
@@ -351,6 +372,7 @@ func startManager(c *cli.Context) error {
 	router := http.Handler(api.NewRouter(server))
 	router = util.FilteredLoggingHandler(os.Stdout, router)
 	router = handlers.ProxyHeaders(router)
+	router = tracing.NewHTTPHandler(router, "longhorn-manager-api")
 
 	listen := types.GetAPIServerAddressFromIP(currentIP)
 	logger.Infof("Listening on %s", listen)
@@ -10,11 +10,13 @@ import (
 	"github.com/urfave/cli"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
 
 	"github.com/longhorn/longhorn-manager/app/recurringjob"
 	"github.com/longhorn/longhorn-manager/types"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	lhclientset "github.com/longhorn/longhorn-manager/k8s/pkg/client/clientset/versioned"
 )
 
 func RecurringJobCmd() cli.Command {
@@ -75,8 +77,32 @@ func recurringJob(c *cli.Context) (err error) {
 
 	switch recurringJob.Spec.Task {
 	case longhorn.RecurringJobTypeSystemBackup:
-		return recurringjob.StartSystemBackupJob(job, recurringJob)
+		err = recurringjob.StartSystemBackupJob(job, recurringJob)
 	default:
-		return recurringjob.StartVolumeJobs(job, recurringJob)
+		err = recurringjob.StartVolumeJobs(job, recurringJob)
 	}
+
+	succeeded := err == nil && !job.HasFailedVolumeJobs()
+	if updateErr := updateRecurringJobResult(lhClient, namespace, jobName, succeeded); updateErr != nil {
+		logger.WithError(updateErr).Warnf("Failed to update the result of recurring job %v", jobName)
+	}
+	return err
+}
+
+// updateRecurringJobResult counts the job result in the recurring job status, which is exported as the recurring job
+// metrics by the longhorn managers.
+func updateRecurringJobResult(lhClient *lhclientset.Clientset, namespace, jobName string, succeeded bool) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		recurringJob, err := lhClient.LonghornV1beta2().RecurringJobs(namespace).Get(context.TODO(), jobName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if succeeded {
+			recurringJob.Status.SucceededCount++
+		} else {
+			recurringJob.Status.FailedCount++
+		}
+		_, err = lhClient.LonghornV1beta2().RecurringJobs(namespace).UpdateStatus(context.TODO(), recurringJob, metav1.UpdateOptions{})
+		return err
+	})
 }
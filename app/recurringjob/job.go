@@ -9,6 +9,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
 
@@ -73,6 +74,11 @@ func NewJob(name string, logger logrus.FieldLogger, managerURL string, recurring
 	}, nil
 }
 
+// HasFailedVolumeJobs returns true if any of the volume jobs has failed.
+func (job *Job) HasFailedVolumeJobs() bool {
+	return job.volumeJobsFailed
+}
+
 func (job *Job) GetVolume(name string) (*longhorn.Volume, error) {
 	return job.lhClient.LonghornV1beta2().Volumes(job.namespace).Get(context.TODO(), name, metav1.GetOptions{})
 }
@@ -120,3 +126,24 @@ func (job *Job) ListSystemBackup() (*longhorn.SystemBackupList, error) {
 		LabelSelector: label,
 	})
 }
+
+func (job *Job) CreateBackup(backup *longhorn.Backup) (*longhorn.Backup, error) {
+	return job.lhClient.LonghornV1beta2().Backups(job.namespace).Create(context.TODO(), backup, metav1.CreateOptions{})
+}
+
+func (job *Job) DeleteBackup(name string) error {
+	return job.lhClient.LonghornV1beta2().Backups(job.namespace).Delete(context.TODO(), name, metav1.DeleteOptions{})
+}
+
+func (job *Job) GetBackup(name string) (*longhorn.Backup, error) {
+	return job.lhClient.LonghornV1beta2().Backups(job.namespace).Get(context.TODO(), name, metav1.GetOptions{})
+}
+
+func (job *Job) ListBackups(backupTargetName, volumeName string) (*longhorn.BackupList, error) {
+	label := labels.SelectorFromSet(types.GetBackupVolumeWithBackupTargetLabels(backupTargetName, volumeName)).String()
+
+	job.logger.Infof("Getting Backups by label %v", label)
+	return job.lhClient.LonghornV1beta2().Backups(job.namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: label,
+	})
+}
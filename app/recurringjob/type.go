@@ -26,6 +26,8 @@ type Job struct {
 	task           longhorn.RecurringJobType // Type of task to be executed.
 	parameters     map[string]string         // Additional parameters for the task.
 	executionCount int                       // Number of times the job has been executed.
+
+	volumeJobsFailed bool // Whether any of the volume jobs has failed.
 }
 
 // VolumeJob is a job for volume tasks.
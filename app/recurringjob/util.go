@@ -166,3 +166,25 @@ func systemBackupsToNameWithTimestamps(systemBackupList *longhorn.SystemBackupLi
 	}
 	return result
 }
+
+// getLastCompletedBackup returns the completed backup with the latest creation time
+// return nil if there is no completed backup
+func getLastCompletedBackup(backups []longhorn.Backup) *longhorn.Backup {
+	var lastBackup *longhorn.Backup
+	var lastBackupCreatedAt time.Time
+	for i := range backups {
+		backup := &backups[i]
+		if backup.Status.State != longhorn.BackupStateCompleted {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, backup.Status.BackupCreatedAt)
+		if err != nil {
+			continue
+		}
+		if lastBackup == nil || t.After(lastBackupCreatedAt) {
+			lastBackup = backup
+			lastBackupCreatedAt = t
+		}
+	}
+	return lastBackup
+}
@@ -15,6 +15,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	bsutil "github.com/longhorn/backupstore/util"
+
 	"github.com/longhorn/longhorn-manager/constant"
 	"github.com/longhorn/longhorn-manager/types"
 	"github.com/longhorn/longhorn-manager/util"
@@ -55,6 +57,7 @@ func StartVolumeJobs(job *Job, recurringJob *longhorn.RecurringJob) error {
 	defer func() {
 		if wgError := ewg.Wait(); wgError != nil {
 			err = wgError
+			job.volumeJobsFailed = true
 		}
 	}()
 	for _, volumeName := range filteredVolumes {
@@ -169,6 +172,10 @@ func (job *VolumeJob) run() (err error) {
 		job.logger.Infof("Running recurring backup for volume %v", volumeName)
 		return job.doRecurringBackup()
 
+	case longhorn.RecurringJobTypeBackupCopy:
+		job.logger.Infof("Running recurring backup copy for volume %v", volumeName)
+		return job.doRecurringBackupCopy()
+
 	default:
 		job.logger.Infof("Running recurring snapshot for volume %v", volumeName)
 		return job.doRecurringSnapshot()
@@ -540,6 +547,114 @@ func (job *VolumeJob) doRecurringBackup() (err error) {
 	return nil
 }
 
+func (job *VolumeJob) doRecurringBackupCopy() (err error) {
+	defer func() {
+		err = errors.Wrapf(err, "failed to complete backup-copy for %v", job.volumeName)
+		if err == nil {
+			job.logger.Info("Finished recurring backup copy")
+		}
+	}()
+
+	backupTargetName := job.parameters[types.RecurringJobParameterBackupTarget]
+	if backupTargetName == "" {
+		return fmt.Errorf("missing parameter %v", types.RecurringJobParameterBackupTarget)
+	}
+
+	volume, err := job.GetVolume(job.volumeName)
+	if err != nil {
+		return errors.Wrapf(err, "could not get volume %v", job.volumeName)
+	}
+	if volume.Spec.BackupTargetName == backupTargetName {
+		return fmt.Errorf("volume %v is already backed up to the backup target %v", job.volumeName, backupTargetName)
+	}
+
+	sourceBackups, err := job.ListBackups(volume.Spec.BackupTargetName, job.volumeName)
+	if err != nil {
+		return err
+	}
+	sourceBackup := getLastCompletedBackup(sourceBackups.Items)
+	if sourceBackup == nil {
+		job.logger.Infof("No completed backup of volume %v in the backup target %v to copy", job.volumeName, volume.Spec.BackupTargetName)
+		return nil
+	}
+
+	backups, err := job.ListBackups(backupTargetName, job.volumeName)
+	if err != nil {
+		return err
+	}
+
+	// The last backup might be copied by the previous execution
+	backupName := ""
+	for _, backup := range backups.Items {
+		if backup.Spec.SourceBackupName == sourceBackup.Name {
+			backupName = backup.Name
+			break
+		}
+	}
+	if backupName == "" {
+		backup, err := job.CreateBackup(&longhorn.Backup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   bsutil.GenerateName("backup"),
+				Labels: types.GetBackupVolumeWithBackupTargetLabels(backupTargetName, job.volumeName),
+			},
+			Spec: longhorn.BackupSpec{
+				SourceBackupName: sourceBackup.Name,
+				Labels:           job.specLabels,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		backupName = backup.Name
+	}
+
+	// Wait for backup copy complete
+	for {
+		backup, err := job.GetBackup(backupName)
+		if err != nil {
+			return err
+		}
+
+		complete := false
+
+		switch backup.Status.State {
+		case longhorn.BackupStateCompleted:
+			complete = true
+			job.logger.Infof("Completed copying backup %v to %v", sourceBackup.Name, backupName)
+		case longhorn.BackupStateNew, longhorn.BackupStatePending, longhorn.BackupStateInProgress:
+			job.logger.Infof("Copying backup %v to %v", sourceBackup.Name, backupName)
+		case longhorn.BackupStateError, longhorn.BackupStateUnknown:
+			return fmt.Errorf("failed to copy backup %v to %v: %v", sourceBackup.Name, backupName, backup.Status.Error)
+		default:
+			return fmt.Errorf("invalid state %v for backup %v", backup.Status.State, backupName)
+		}
+
+		if complete {
+			break
+		}
+		time.Sleep(WaitInterval)
+	}
+
+	defer func() {
+		if err != nil {
+			job.logger.WithError(err).Warnf("Copied backup successfully but errored on cleanup for %v", job.volumeName)
+			err = nil
+		}
+	}()
+
+	backups, err = job.ListBackups(backupTargetName, job.volumeName)
+	if err != nil {
+		return err
+	}
+	for _, backup := range job.listBackupCopiesForCleanup(backups.Items) {
+		if err := job.DeleteBackup(backup); err != nil {
+			return fmt.Errorf("cleaned up backup %v failed for %v: %v", backup, job.volumeName, err)
+		}
+		job.logger.Infof("Cleaned up backup %v for %v", backup, job.volumeName)
+	}
+	return nil
+}
+
 func (job *VolumeJob) getBackupVolume(backupTargetName string) (*longhornclient.BackupVolume, error) {
 	list, err := job.api.BackupVolume.List(&longhornclient.ListOpts{})
 	if err != nil {
@@ -649,3 +764,32 @@ func (job *VolumeJob) listBackupsForCleanup(backups []longhornclient.Backup) []s
 	}
 	return filterExpiredItems(sts, job.retain)
 }
+
+func (job *VolumeJob) listBackupCopiesForCleanup(backups []longhorn.Backup) []string {
+	sts := []NameWithTimestamp{}
+
+	// only remove backup copies that where created by our current job
+	jobLabel, found := job.specLabels[types.RecurringJobLabel]
+	if !found {
+		return []string{}
+	}
+	for _, backup := range backups {
+		if backup.Spec.SourceBackupName == "" || backup.Status.State != longhorn.BackupStateCompleted {
+			continue
+		}
+		backupLabel, found := backup.Spec.Labels[types.RecurringJobLabel]
+		if found && jobLabel == backupLabel {
+			t, err := time.Parse(time.RFC3339, backup.Status.BackupCreatedAt)
+			if err != nil {
+				job.logger.Errorf("Failed to parse datetime %v for backup %v",
+					backup.Status.BackupCreatedAt, backup.Name)
+				continue
+			}
+			sts = append(sts, NameWithTimestamp{
+				Name:      backup.Name,
+				Timestamp: t,
+			})
+		}
+	}
+	return filterExpiredItems(sts, job.retain)
+}
@@ -0,0 +1,99 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+
+	"k8s.io/client-go/tools/clientcmd"
+
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+
+	emeta "github.com/longhorn/longhorn-engine/pkg/meta"
+
+	"github.com/longhorn/longhorn-manager/meta"
+	"github.com/longhorn/longhorn-manager/types"
+
+	lhclientset "github.com/longhorn/longhorn-manager/k8s/pkg/client/clientset/versioned"
+	upgradeutil "github.com/longhorn/longhorn-manager/upgrade/util"
+)
+
+const (
+	FlagTargetVersion = "target-version"
+)
+
+func UpgradeReadinessCmd() cli.Command {
+	return cli.Command{
+		Name:  "upgrade-readiness",
+		Usage: "Check if the existing resources are ready to be upgraded to the target version and print the report",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  FlagKubeConfig,
+				Usage: "Specify path to kube config (optional)",
+			},
+			cli.StringFlag{
+				Name:     FlagNamespace,
+				EnvVar:   types.EnvPodNamespace,
+				Required: true,
+				Usage:    "Specify Longhorn namespace",
+			},
+			cli.StringFlag{
+				Name:  FlagTargetVersion,
+				Usage: "Specify the target Longhorn version. The engine images are checked only if the target is the version of this manager image (optional)",
+				Value: meta.Version,
+			},
+		},
+		Action: func(c *cli.Context) {
+			if err := upgradeReadiness(c); err != nil {
+				logrus.WithError(err).Fatal("Failed to check upgrade readiness")
+			}
+		},
+	}
+}
+
+func upgradeReadiness(c *cli.Context) error {
+	namespace := c.String(FlagNamespace)
+
+	config, err := clientcmd.BuildConfigFromFlags("", c.String(FlagKubeConfig))
+	if err != nil {
+		return errors.Wrap(err, "failed to get client config")
+	}
+
+	lhClient, err := lhclientset.NewForConfig(config)
+	if err != nil {
+		return errors.Wrap(err, "failed to get clientset")
+	}
+
+	extensionsClient, err := apiextensionsclientset.NewForConfig(config)
+	if err != nil {
+		return errors.Wrap(err, "failed to get k8s extension client")
+	}
+
+	target := upgradeutil.UpgradeReadinessTarget{
+		Version: c.String(FlagTargetVersion),
+	}
+	if target.Version == meta.Version {
+		engineVersion := emeta.GetVersion()
+		target.EngineVersion = &engineVersion
+	}
+
+	resources, err := upgradeutil.ListUpgradeReadinessResources(namespace, lhClient, extensionsClient)
+	if err != nil {
+		return err
+	}
+
+	report := upgradeutil.CheckUpgradeReadiness(resources, target)
+	output, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal upgrade readiness report")
+	}
+	fmt.Println(string(output))
+
+	if !report.Ready {
+		return fmt.Errorf("found %v blockers for upgrading from %v to %v", len(report.Blockers), report.CurrentVersion, report.TargetVersion)
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/gorilla/websocket"
@@ -10,6 +11,9 @@ type RancherBaseClientImpl struct {
 	Opts    *ClientOpts
 	Schemas *Schemas
 	Types   map[string]Schema
+
+	// ctx carries the trace context propagated to the server in the request headers
+	ctx context.Context
 }
 
 type RancherBaseClient interface {
@@ -37,3 +41,15 @@ type RancherBaseClient interface {
 	doResourceDelete(string, *Resource) error
 	doAction(string, string, *Resource, interface{}, interface{}) error
 }
+
+// WithContext returns a copy of the client propagating the trace context of ctx to the server, so that the server
+// continues the trace of the caller.
+func (c *RancherClient) WithContext(ctx context.Context) *RancherClient {
+	base, ok := c.RancherBaseClient.(*RancherBaseClientImpl)
+	if !ok {
+		return c
+	}
+	baseCopy := *base
+	baseCopy.ctx = ctx
+	return constructClient(&baseCopy)
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/gorilla/websocket"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/propagation"
 )
 
 const (
@@ -232,6 +233,9 @@ func NewListOpts() *ListOpts {
 
 func (rancherClient *RancherBaseClientImpl) setupRequest(req *http.Request) {
 	req.SetBasicAuth(rancherClient.Opts.AccessKey, rancherClient.Opts.SecretKey)
+	if rancherClient.ctx != nil {
+		propagation.TraceContext{}.Inject(rancherClient.ctx, propagation.HeaderCarrier(req.Header))
+	}
 }
 
 func (rancherClient *RancherBaseClientImpl) newHttpClient() *http.Client {
@@ -40,6 +40,7 @@ type RancherClient struct {
 	UpdateFreezeFSForSnapshotInput         UpdateFreezeFSForSnapshotInputOperations
 	WorkloadStatus                         WorkloadStatusOperations
 	CloneStatus                            CloneStatusOperations
+	VolumeIOQoS                            VolumeIOQoSOperations
 	Empty                                  EmptyOperations
 	VolumeRecurringJob                     VolumeRecurringJobOperations
 	VolumeRecurringJobInput                VolumeRecurringJobInputOperations
@@ -122,6 +123,7 @@ func constructClient(rancherBaseClient *RancherBaseClientImpl) *RancherClient {
 	client.UpdateFreezeFSForSnapshotInput = newUpdateFreezeFSForSnapshotInputClient(client)
 	client.WorkloadStatus = newWorkloadStatusClient(client)
 	client.CloneStatus = newCloneStatusClient(client)
+	client.VolumeIOQoS = newVolumeIOQoSClient(client)
 	client.Empty = newEmptyClient(client)
 	client.VolumeRecurringJob = newVolumeRecurringJobClient(client)
 	client.VolumeRecurringJobInput = newVolumeRecurringJobInputClient(client)
@@ -45,6 +45,10 @@ type Volume struct {
 
 	Frontend string `json:"frontend,omitempty" yaml:"frontend,omitempty"`
 
+	HealthScore int64 `json:"healthScore,omitempty" yaml:"health_score,omitempty"`
+
+	IOQoS VolumeIOQoS `json:"ioQoS,omitempty" yaml:"io_qos,omitempty"`
+
 	Image string `json:"image,omitempty" yaml:"image,omitempty"`
 
 	KubernetesStatus KubernetesStatus `json:"kubernetesStatus,omitempty" yaml:"kubernetes_status,omitempty"`
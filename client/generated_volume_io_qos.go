@@ -0,0 +1,87 @@
+package client
+
+const (
+	VOLUME_IO_QOS_TYPE = "volumeIOQoS"
+)
+
+type VolumeIOQoS struct {
+	Resource `yaml:"-"`
+
+	BurstSeconds int64 `json:"burstSeconds,omitempty" yaml:"burst_seconds,omitempty"`
+
+	MaxReadBandwidth int64 `json:"maxReadBandwidth,omitempty" yaml:"max_read_bandwidth,omitempty"`
+
+	MaxReadIOPS int64 `json:"maxReadIOPS,omitempty" yaml:"max_read_iops,omitempty"`
+
+	MaxWriteBandwidth int64 `json:"maxWriteBandwidth,omitempty" yaml:"max_write_bandwidth,omitempty"`
+
+	MaxWriteIOPS int64 `json:"maxWriteIOPS,omitempty" yaml:"max_write_iops,omitempty"`
+}
+
+type VolumeIOQoSCollection struct {
+	Collection
+	Data   []VolumeIOQoS `json:"data,omitempty"`
+	client *VolumeIOQoSClient
+}
+
+type VolumeIOQoSClient struct {
+	rancherClient *RancherClient
+}
+
+type VolumeIOQoSOperations interface {
+	List(opts *ListOpts) (*VolumeIOQoSCollection, error)
+	Create(opts *VolumeIOQoS) (*VolumeIOQoS, error)
+	Update(existing *VolumeIOQoS, updates interface{}) (*VolumeIOQoS, error)
+	ById(id string) (*VolumeIOQoS, error)
+	Delete(container *VolumeIOQoS) error
+}
+
+func newVolumeIOQoSClient(rancherClient *RancherClient) *VolumeIOQoSClient {
+	return &VolumeIOQoSClient{
+		rancherClient: rancherClient,
+	}
+}
+
+func (c *VolumeIOQoSClient) Create(container *VolumeIOQoS) (*VolumeIOQoS, error) {
+	resp := &VolumeIOQoS{}
+	err := c.rancherClient.doCreate(VOLUME_IO_QOS_TYPE, container, resp)
+	return resp, err
+}
+
+func (c *VolumeIOQoSClient) Update(existing *VolumeIOQoS, updates interface{}) (*VolumeIOQoS, error) {
+	resp := &VolumeIOQoS{}
+	err := c.rancherClient.doUpdate(VOLUME_IO_QOS_TYPE, &existing.Resource, updates, resp)
+	return resp, err
+}
+
+func (c *VolumeIOQoSClient) List(opts *ListOpts) (*VolumeIOQoSCollection, error) {
+	resp := &VolumeIOQoSCollection{}
+	err := c.rancherClient.doList(VOLUME_IO_QOS_TYPE, opts, resp)
+	resp.client = c
+	return resp, err
+}
+
+func (cc *VolumeIOQoSCollection) Next() (*VolumeIOQoSCollection, error) {
+	if cc != nil && cc.Pagination != nil && cc.Pagination.Next != "" {
+		resp := &VolumeIOQoSCollection{}
+		err := cc.client.rancherClient.doNext(cc.Pagination.Next, resp)
+		resp.client = cc.client
+		return resp, err
+	}
+	return nil, nil
+}
+
+func (c *VolumeIOQoSClient) ById(id string) (*VolumeIOQoS, error) {
+	resp := &VolumeIOQoS{}
+	err := c.rancherClient.doById(VOLUME_IO_QOS_TYPE, id, resp)
+	if apiError, ok := err.(*ApiError); ok {
+		if apiError.StatusCode == 404 {
+			return nil, nil
+		}
+	}
+	return resp, err
+}
+
+func (c *VolumeIOQoSClient) Delete(container *VolumeIOQoS) error {
+	return c.rancherClient.doResourceDelete(VOLUME_IO_QOS_TYPE, &container.Resource)
+}
@@ -38,6 +38,7 @@ const (
 	EventReasonHealthy  = "Healthy"
 	EventReasonFaulted  = "Faulted"
 	EventReasonDegraded = "Degraded"
+	EventReasonSlow     = "Slow"
 	EventReasonOrphaned = "Orphaned"
 	EventReasonUnknown  = "Unknown"
 
@@ -45,6 +46,7 @@ const (
 	EventReasonEvictionUserRequested = "EvictionUserRequested"
 	EventReasonEvictionCanceled      = "EvictionCanceled"
 	EventReasonEvictionFailed        = "EvictionFailed"
+	EventReasonEvictionSlowReplica   = "EvictionSlowReplica"
 
 	EventReasonDetachedUnexpectedly = "DetachedUnexpectedly"
 	EventReasonRemount              = "Remount"
@@ -55,6 +57,10 @@ const (
 
 	EventReasonSyncing = "Syncing"
 	EventReasonSynced  = "Synced"
+	EventReasonDrifted = "Drifted"
+
+	EventReasonAlertFiring   = "AlertFiring"
+	EventReasonAlertResolved = "AlertResolved"
 
 	EventReasonFailedSnapshotDataIntegrityCheck = "FailedSnapshotDataIntegrityCheck"
 
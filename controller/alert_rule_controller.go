@@ -0,0 +1,575 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/kubernetes/pkg/controller"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientset "k8s.io/client-go/kubernetes"
+	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/longhorn/longhorn-manager/constant"
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+const (
+	AlertRuleControllerName = "longhorn-alert-rule"
+
+	// The alert rules are evaluated in every resync
+	alertRuleControllerResyncPeriod = 30 * time.Second
+
+	alertNotificationTimeout = 10 * time.Second
+
+	alertObjectKindVolume = "volume"
+	alertObjectKindDisk   = "disk"
+	alertObjectKindNode   = "node"
+)
+
+// AlertNotification is the JSON body posted to the webhook receivers of an AlertRule.
+type AlertNotification struct {
+	Rule     string                 `json:"rule"`
+	Type     longhorn.AlertRuleType `json:"type"`
+	Severity longhorn.AlertSeverity `json:"severity"`
+	State    longhorn.AlertState    `json:"state"`
+	Alerts   []longhorn.Alert       `json:"alerts"`
+}
+
+type AlertRuleController struct {
+	*baseController
+
+	// which namespace controller is running with
+	namespace string
+	// use as the OwnerID of the controller
+	controllerID string
+
+	kubeClient    clientset.Interface
+	eventRecorder record.EventRecorder
+
+	ds *datastore.DataStore
+
+	cacheSyncs []cache.InformerSynced
+
+	httpClient *http.Client
+}
+
+func NewAlertRuleController(
+	logger logrus.FieldLogger,
+	ds *datastore.DataStore,
+	scheme *runtime.Scheme,
+	kubeClient clientset.Interface,
+	namespace string,
+	controllerID string) (*AlertRuleController, error) {
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(logrus.Infof)
+	// TODO: remove the wrapper when every clients have moved to use the clientset.
+	eventBroadcaster.StartRecordingToSink(&v1core.EventSinkImpl{
+		Interface: v1core.New(kubeClient.CoreV1().RESTClient()).Events(""),
+	})
+
+	c := &AlertRuleController{
+		baseController: newBaseController(AlertRuleControllerName, logger),
+
+		namespace:    namespace,
+		controllerID: controllerID,
+
+		ds: ds,
+
+		kubeClient:    kubeClient,
+		eventRecorder: eventBroadcaster.NewRecorder(scheme, corev1.EventSource{Component: AlertRuleControllerName + "-controller"}),
+
+		httpClient: &http.Client{Timeout: alertNotificationTimeout},
+	}
+
+	var err error
+	if _, err = ds.AlertRuleInformer.AddEventHandlerWithResyncPeriod(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueAlertRule,
+		UpdateFunc: c.enqueueAlertRuleOnUpdate,
+		DeleteFunc: c.enqueueAlertRule,
+	}, alertRuleControllerResyncPeriod); err != nil {
+		return nil, err
+	}
+	c.cacheSyncs = append(c.cacheSyncs, ds.AlertRuleInformer.HasSynced)
+
+	// The evaluation reads the volumes and nodes from the cache
+	c.cacheSyncs = append(c.cacheSyncs, ds.VolumeInformer.HasSynced, ds.NodeInformer.HasSynced)
+
+	return c, nil
+}
+
+func (c *AlertRuleController) enqueueAlertRule(obj interface{}) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("couldn't get key for object %#v: %v", obj, err))
+		return
+	}
+
+	c.queue.Add(key)
+}
+
+// enqueueAlertRuleOnUpdate skips the status updates made by the evaluation itself, so that the rules are evaluated
+// once per resync rather than in a loop.
+func (c *AlertRuleController) enqueueAlertRuleOnUpdate(old, cur interface{}) {
+	oldAlertRule, ok := old.(*longhorn.AlertRule)
+	if !ok {
+		utilruntime.HandleError(fmt.Errorf("received unexpected obj: %#v", old))
+		return
+	}
+	curAlertRule, ok := cur.(*longhorn.AlertRule)
+	if !ok {
+		utilruntime.HandleError(fmt.Errorf("received unexpected obj: %#v", cur))
+		return
+	}
+
+	isResync := oldAlertRule.ResourceVersion == curAlertRule.ResourceVersion
+	if !isResync &&
+		oldAlertRule.Generation == curAlertRule.Generation &&
+		oldAlertRule.Status.OwnerID == curAlertRule.Status.OwnerID &&
+		curAlertRule.DeletionTimestamp == nil {
+		return
+	}
+	c.enqueueAlertRule(cur)
+}
+
+func (c *AlertRuleController) Run(workers int, stopCh <-chan struct{}) {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	c.logger.Info("Starting Longhorn AlertRule controller")
+	defer c.logger.Info("Shut down Longhorn AlertRule controller")
+
+	if !cache.WaitForNamedCacheSync(c.name, stopCh, c.cacheSyncs...) {
+		return
+	}
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+	<-stopCh
+}
+
+func (c *AlertRuleController) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *AlertRuleController) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	err := c.syncAlertRule(key.(string))
+	c.handleErr(err, key)
+
+	return true
+}
+
+func (c *AlertRuleController) handleErr(err error, key interface{}) {
+	if err == nil {
+		c.queue.Forget(key)
+		return
+	}
+
+	log := c.logger.WithField("AlertRule", key)
+
+	if c.queue.NumRequeues(key) < maxRetries {
+		handleReconcileErrorLogging(log, err, "Failed to sync AlertRule")
+		c.queue.AddRateLimited(key)
+		return
+	}
+
+	utilruntime.HandleError(err)
+	handleReconcileErrorLogging(log, err, "Dropping Longhorn AlertRule out of the queue")
+	c.queue.Forget(key)
+}
+
+func getLoggerForAlertRule(logger logrus.FieldLogger, alertRule *longhorn.AlertRule) *logrus.Entry {
+	return logger.WithField("alertRule", alertRule.Name)
+}
+
+func (c *AlertRuleController) syncAlertRule(key string) (err error) {
+	defer func() {
+		err = errors.Wrapf(err, "%v: fail to sync AlertRule %v", c.name, key)
+	}()
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	if namespace != c.namespace {
+		return nil
+	}
+
+	return c.reconcile(name)
+}
+
+func (c *AlertRuleController) reconcile(name string) (err error) {
+	alertRule, err := c.ds.GetAlertRule(name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	log := getLoggerForAlertRule(c.logger, alertRule)
+
+	if !c.isResponsibleFor(alertRule) {
+		return nil
+	}
+
+	if alertRule.Status.OwnerID != c.controllerID {
+		alertRule.Status.OwnerID = c.controllerID
+		alertRule, err = c.ds.UpdateAlertRuleStatus(alertRule)
+		if err != nil {
+			// we don't mind others coming first
+			if apierrors.IsConflict(errors.Cause(err)) {
+				return nil
+			}
+			return err
+		}
+		log.Infof("Alert rule got new owner %v", c.controllerID)
+	}
+
+	if !alertRule.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	existingAlertRule := alertRule.DeepCopy()
+	defer func() {
+		if reflect.DeepEqual(existingAlertRule.Status, alertRule.Status) {
+			return
+		}
+		if _, updateErr := c.ds.UpdateAlertRuleStatus(alertRule); updateErr != nil {
+			log.WithError(updateErr).Debugf("Requeue %v due to error", alertRule.Name)
+			c.enqueueAlertRule(alertRule)
+		}
+	}()
+
+	if alertRule.Spec.Disabled {
+		alertRule.Status.Alerts = nil
+		return nil
+	}
+
+	active, err := c.evaluateAlertRule(alertRule)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	resolved := updateAlerts(alertRule, active, now)
+	alertRule.Status.LastEvaluatedAt = metav1.Time{Time: now}
+
+	c.notifyAlerts(log, alertRule, resolved)
+
+	return nil
+}
+
+// updateAlerts merges the alerts active in this evaluation into the status, promotes the pending alerts held for
+// long enough to firing, and returns the firing alerts no longer active.
+func updateAlerts(alertRule *longhorn.AlertRule, active []longhorn.Alert, now time.Time) (resolved []longhorn.Alert) {
+	existing := map[string]longhorn.Alert{}
+	for _, alert := range alertRule.Status.Alerts {
+		existing[getAlertKey(alert)] = alert
+	}
+
+	forDuration := time.Duration(alertRule.Spec.ForMinutes) * time.Minute
+	alerts := []longhorn.Alert{}
+	for _, alert := range active {
+		key := getAlertKey(alert)
+		if prev, ok := existing[key]; ok {
+			alert.State = prev.State
+			alert.StartsAt = prev.StartsAt
+			alert.FiredAt = prev.FiredAt
+			alert.Notified = prev.Notified
+			delete(existing, key)
+		} else {
+			alert.State = longhorn.AlertStatePending
+			alert.StartsAt = metav1.Time{Time: now}
+		}
+		if alert.State == longhorn.AlertStatePending && now.Sub(alert.StartsAt.Time) >= forDuration {
+			alert.State = longhorn.AlertStateFiring
+			alert.FiredAt = metav1.Time{Time: now}
+			alert.Notified = false
+		}
+		alerts = append(alerts, alert)
+	}
+	sort.Slice(alerts, func(i, j int) bool { return getAlertKey(alerts[i]) < getAlertKey(alerts[j]) })
+
+	for _, alert := range existing {
+		if alert.State == longhorn.AlertStateFiring {
+			alert.State = longhorn.AlertStateResolved
+			resolved = append(resolved, alert)
+		}
+	}
+	sort.Slice(resolved, func(i, j int) bool { return getAlertKey(resolved[i]) < getAlertKey(resolved[j]) })
+
+	if len(alerts) == 0 {
+		alerts = nil
+	}
+	alertRule.Status.Alerts = alerts
+	return resolved
+}
+
+func getAlertKey(alert longhorn.Alert) string {
+	return alert.ObjectKind + "/" + alert.ObjectName
+}
+
+// notifyAlerts sends the firing alerts not delivered yet and the resolved alerts to the receivers. The failure is
+// recorded in the Notified condition instead of failing the reconciliation, so that the alerts are still updated.
+func (c *AlertRuleController) notifyAlerts(log logrus.FieldLogger, alertRule *longhorn.AlertRule, resolved []longhorn.Alert) {
+	firing := []longhorn.Alert{}
+	for _, alert := range alertRule.Status.Alerts {
+		if alert.State == longhorn.AlertStateFiring && !alert.Notified {
+			firing = append(firing, alert)
+		}
+	}
+	if len(firing) == 0 && len(resolved) == 0 {
+		return
+	}
+
+	for _, alert := range firing {
+		if alert.FiredAt.Equal(&alertRule.Status.LastEvaluatedAt) {
+			log.Warnf("Alert fired: %v", alert.Message)
+			c.eventRecorder.Eventf(alertRule, corev1.EventTypeWarning, constant.EventReasonAlertFiring, "Alert fired for %v %v: %v", alert.ObjectKind, alert.ObjectName, alert.Message)
+		}
+	}
+	for _, alert := range resolved {
+		log.Infof("Alert resolved for %v %v", alert.ObjectKind, alert.ObjectName)
+		c.eventRecorder.Eventf(alertRule, corev1.EventTypeNormal, constant.EventReasonAlertResolved, "Alert resolved for %v %v", alert.ObjectKind, alert.ObjectName)
+	}
+
+	if len(alertRule.Spec.Receivers) == 0 {
+		return
+	}
+
+	failures := []string{}
+	if len(firing) > 0 {
+		notification := newAlertNotification(alertRule, longhorn.AlertStateFiring, firing)
+		failed := false
+		for _, receiver := range alertRule.Spec.Receivers {
+			if err := c.sendAlertNotification(receiver.URL, notification); err != nil {
+				failed = true
+				failures = append(failures, fmt.Sprintf("%v: %v", getAlertReceiverName(receiver), err))
+			}
+		}
+		// The firing alerts are sent again to all receivers in the next evaluation if any receiver fails
+		if !failed {
+			for i := range alertRule.Status.Alerts {
+				if alertRule.Status.Alerts[i].State == longhorn.AlertStateFiring {
+					alertRule.Status.Alerts[i].Notified = true
+				}
+			}
+		}
+	}
+	if len(resolved) > 0 {
+		notification := newAlertNotification(alertRule, longhorn.AlertStateResolved, resolved)
+		for _, receiver := range alertRule.Spec.Receivers {
+			if !receiver.SendResolved {
+				continue
+			}
+			if err := c.sendAlertNotification(receiver.URL, notification); err != nil {
+				failures = append(failures, fmt.Sprintf("%v: %v", getAlertReceiverName(receiver), err))
+			}
+		}
+	}
+
+	if len(failures) > 0 {
+		message := strings.Join(failures, "; ")
+		log.Warnf("Failed to send alert notifications: %v", message)
+		alertRule.Status.Conditions = types.SetCondition(alertRule.Status.Conditions,
+			longhorn.AlertRuleConditionTypeNotified, longhorn.ConditionStatusFalse,
+			longhorn.AlertRuleConditionReasonNotificationFailed, message)
+		return
+	}
+	alertRule.Status.Conditions = types.SetCondition(alertRule.Status.Conditions,
+		longhorn.AlertRuleConditionTypeNotified, longhorn.ConditionStatusTrue, "", "")
+}
+
+func newAlertNotification(alertRule *longhorn.AlertRule, state longhorn.AlertState, alerts []longhorn.Alert) *AlertNotification {
+	return &AlertNotification{
+		Rule:     alertRule.Name,
+		Type:     alertRule.Spec.Type,
+		Severity: alertRule.Spec.Severity,
+		State:    state,
+		Alerts:   alerts,
+	}
+}
+
+func getAlertReceiverName(receiver longhorn.AlertReceiver) string {
+	if receiver.Name != "" {
+		return receiver.Name
+	}
+	return receiver.URL
+}
+
+func (c *AlertRuleController) sendAlertNotification(url string, notification *AlertNotification) error {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), alertNotificationTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected response status %v", resp.Status)
+	}
+	return nil
+}
+
+// evaluateAlertRule returns the objects currently matching the condition of the rule as alerts.
+func (c *AlertRuleController) evaluateAlertRule(alertRule *longhorn.AlertRule) ([]longhorn.Alert, error) {
+	switch alertRule.Spec.Type {
+	case longhorn.AlertRuleTypeVolumeDegraded:
+		return c.evaluateVolumeDegraded()
+	case longhorn.AlertRuleTypeDiskUsage:
+		return c.evaluateDiskUsage(alertRule.Spec.Threshold)
+	case longhorn.AlertRuleTypeBackupAge:
+		return c.evaluateBackupAge(alertRule.Spec.Threshold)
+	case longhorn.AlertRuleTypeNodeDown:
+		return c.evaluateNodeDown()
+	}
+	return nil, fmt.Errorf("unknown alert rule type %v", alertRule.Spec.Type)
+}
+
+func (c *AlertRuleController) evaluateVolumeDegraded() ([]longhorn.Alert, error) {
+	volumes, err := c.ds.ListVolumesRO()
+	if err != nil {
+		return nil, err
+	}
+
+	alerts := []longhorn.Alert{}
+	for _, v := range volumes {
+		if v.Status.Robustness != longhorn.VolumeRobustnessDegraded {
+			continue
+		}
+		alerts = append(alerts, longhorn.Alert{
+			ObjectKind: alertObjectKindVolume,
+			ObjectName: v.Name,
+			Message:    fmt.Sprintf("volume %v is degraded", v.Name),
+		})
+	}
+	return alerts, nil
+}
+
+func (c *AlertRuleController) evaluateDiskUsage(thresholdPercentage int64) ([]longhorn.Alert, error) {
+	nodes, err := c.ds.ListNodesRO()
+	if err != nil {
+		return nil, err
+	}
+
+	alerts := []longhorn.Alert{}
+	for _, node := range nodes {
+		for diskName, diskStatus := range node.Status.DiskStatus {
+			if diskStatus == nil || diskStatus.StorageMaximum <= 0 {
+				continue
+			}
+			usagePercentage := (diskStatus.StorageMaximum - diskStatus.StorageAvailable) * 100 / diskStatus.StorageMaximum
+			if usagePercentage <= thresholdPercentage {
+				continue
+			}
+			alerts = append(alerts, longhorn.Alert{
+				ObjectKind: alertObjectKindDisk,
+				ObjectName: node.Name + "/" + diskName,
+				Message:    fmt.Sprintf("disk %v on node %v is %v%% used, above %v%%", diskName, node.Name, usagePercentage, thresholdPercentage),
+			})
+		}
+	}
+	return alerts, nil
+}
+
+// evaluateBackupAge checks the volumes backed up at least once, since the other volumes may not need backups at all.
+func (c *AlertRuleController) evaluateBackupAge(maxAgeMinutes int64) ([]longhorn.Alert, error) {
+	volumes, err := c.ds.ListVolumesRO()
+	if err != nil {
+		return nil, err
+	}
+
+	maxAge := time.Duration(maxAgeMinutes) * time.Minute
+	alerts := []longhorn.Alert{}
+	for _, v := range volumes {
+		if v.Status.LastBackupAt == "" {
+			continue
+		}
+		lastBackupAt, err := time.Parse(time.RFC3339, v.Status.LastBackupAt)
+		if err != nil {
+			c.logger.WithError(err).Warnf("Failed to parse the last backup time %v of volume %v", v.Status.LastBackupAt, v.Name)
+			continue
+		}
+		age := time.Since(lastBackupAt)
+		if age <= maxAge {
+			continue
+		}
+		alerts = append(alerts, longhorn.Alert{
+			ObjectKind: alertObjectKindVolume,
+			ObjectName: v.Name,
+			Message:    fmt.Sprintf("last backup of volume %v is %v old, older than %v", v.Name, age.Truncate(time.Minute), maxAge),
+		})
+	}
+	return alerts, nil
+}
+
+func (c *AlertRuleController) evaluateNodeDown() ([]longhorn.Alert, error) {
+	nodes, err := c.ds.ListNodesRO()
+	if err != nil {
+		return nil, err
+	}
+
+	alerts := []longhorn.Alert{}
+	for _, node := range nodes {
+		condition := types.GetCondition(node.Status.Conditions, longhorn.NodeConditionTypeReady)
+		if condition.Status == longhorn.ConditionStatusTrue {
+			continue
+		}
+		message := fmt.Sprintf("node %v is not ready", node.Name)
+		if condition.Reason != "" {
+			message = fmt.Sprintf("%v: %v", message, condition.Reason)
+		}
+		alerts = append(alerts, longhorn.Alert{
+			ObjectKind: alertObjectKindNode,
+			ObjectName: node.Name,
+			Message:    message,
+		})
+	}
+	return alerts, nil
+}
+
+func (c *AlertRuleController) isResponsibleFor(alertRule *longhorn.AlertRule) bool {
+	return isControllerResponsibleFor(c.controllerID, c.ds, alertRule.Name, "", alertRule.Status.OwnerID)
+}
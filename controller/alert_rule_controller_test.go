@@ -0,0 +1,304 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/kubernetes/pkg/controller"
+
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	lhfake "github.com/longhorn/longhorn-manager/k8s/pkg/client/clientset/versioned/fake"
+
+	. "gopkg.in/check.v1"
+)
+
+type AlertRuleTestCase struct {
+	spec           longhorn.AlertRuleSpec
+	volumes        []*longhorn.Volume
+	nodes          []*longhorn.Node
+	existingAlerts []longhorn.Alert
+	// The response status of the webhook receiver, no receiver is configured if it is 0
+	receiverStatus int
+
+	expectedAlerts            []longhorn.Alert
+	expectedNotifications     []longhorn.AlertState
+	expectedNotifiedCondition longhorn.ConditionStatus
+}
+
+type fakeAlertReceiver struct {
+	mutex         sync.Mutex
+	status        int
+	notifications []AlertNotification
+}
+
+func (r *fakeAlertReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	notification := AlertNotification{}
+	if err := json.NewDecoder(req.Body).Decode(&notification); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	r.notifications = append(r.notifications, notification)
+	w.WriteHeader(r.status)
+}
+
+func newTestAlertRuleController(lhClient *lhfake.Clientset, kubeClient *fake.Clientset, extensionsClient *apiextensionsfake.Clientset, informerFactories *util.InformerFactories) (*AlertRuleController, error) {
+	ds := datastore.NewDataStore(TestNamespace, lhClient, kubeClient, extensionsClient, informerFactories)
+
+	logger := logrus.StandardLogger()
+	c, err := NewAlertRuleController(logger, ds, scheme.Scheme, kubeClient, TestNamespace, TestNode1)
+	if err != nil {
+		return nil, err
+	}
+
+	c.eventRecorder = record.NewFakeRecorder(100)
+	for index := range c.cacheSyncs {
+		c.cacheSyncs[index] = alwaysReady
+	}
+
+	return c, nil
+}
+
+func newAlertRuleTestVolume(name string, robustness longhorn.VolumeRobustness, lastBackupAt string) *longhorn.Volume {
+	v := newVolume(name, 3)
+	v.Namespace = TestNamespace
+	v.Status.Robustness = robustness
+	v.Status.LastBackupAt = lastBackupAt
+	return v
+}
+
+func (s *TestSuite) TestReconcileAlertRule(c *C) {
+	now := time.Now().UTC()
+	readyNode := newNode(TestNode1, TestNamespace, true, longhorn.ConditionStatusTrue, "")
+	downNode := newNode(TestNode2, TestNamespace, true, longhorn.ConditionStatusFalse, longhorn.NodeConditionReasonKubernetesNodeNotReady)
+	recoveredNode := newNode(TestNode2, TestNamespace, true, longhorn.ConditionStatusTrue, "")
+	diskName := TestNode1 + "/" + TestDiskID1
+
+	testCases := map[string]AlertRuleTestCase{
+		"disk usage above threshold fires": {
+			spec: longhorn.AlertRuleSpec{
+				Type:      longhorn.AlertRuleTypeDiskUsage,
+				Threshold: 30,
+			},
+			nodes:          []*longhorn.Node{readyNode},
+			receiverStatus: http.StatusOK,
+			expectedAlerts: []longhorn.Alert{
+				{ObjectKind: alertObjectKindDisk, ObjectName: diskName, State: longhorn.AlertStateFiring, Notified: true},
+			},
+			expectedNotifications:     []longhorn.AlertState{longhorn.AlertStateFiring},
+			expectedNotifiedCondition: longhorn.ConditionStatusTrue,
+		},
+		"disk usage below threshold": {
+			spec: longhorn.AlertRuleSpec{
+				Type:      longhorn.AlertRuleTypeDiskUsage,
+				Threshold: 50,
+			},
+			nodes:          []*longhorn.Node{readyNode},
+			receiverStatus: http.StatusOK,
+		},
+		"degraded volume is pending": {
+			spec: longhorn.AlertRuleSpec{
+				Type:       longhorn.AlertRuleTypeVolumeDegraded,
+				ForMinutes: 10,
+			},
+			nodes: []*longhorn.Node{readyNode},
+			volumes: []*longhorn.Volume{
+				newAlertRuleTestVolume(TestVolumeName, longhorn.VolumeRobustnessDegraded, ""),
+				newAlertRuleTestVolume("healthy-volume", longhorn.VolumeRobustnessHealthy, ""),
+			},
+			receiverStatus: http.StatusOK,
+			expectedAlerts: []longhorn.Alert{
+				{ObjectKind: alertObjectKindVolume, ObjectName: TestVolumeName, State: longhorn.AlertStatePending},
+			},
+		},
+		"degraded volume fires after the duration": {
+			spec: longhorn.AlertRuleSpec{
+				Type:       longhorn.AlertRuleTypeVolumeDegraded,
+				ForMinutes: 10,
+			},
+			nodes: []*longhorn.Node{readyNode},
+			volumes: []*longhorn.Volume{
+				newAlertRuleTestVolume(TestVolumeName, longhorn.VolumeRobustnessDegraded, ""),
+			},
+			existingAlerts: []longhorn.Alert{
+				{
+					ObjectKind: alertObjectKindVolume,
+					ObjectName: TestVolumeName,
+					State:      longhorn.AlertStatePending,
+					StartsAt:   metav1.Time{Time: now.Add(-20 * time.Minute)},
+				},
+			},
+			receiverStatus: http.StatusOK,
+			expectedAlerts: []longhorn.Alert{
+				{ObjectKind: alertObjectKindVolume, ObjectName: TestVolumeName, State: longhorn.AlertStateFiring, Notified: true},
+			},
+			expectedNotifications:     []longhorn.AlertState{longhorn.AlertStateFiring},
+			expectedNotifiedCondition: longhorn.ConditionStatusTrue,
+		},
+		"backup older than threshold fires": {
+			spec: longhorn.AlertRuleSpec{
+				Type:      longhorn.AlertRuleTypeBackupAge,
+				Threshold: 60,
+			},
+			nodes: []*longhorn.Node{readyNode},
+			volumes: []*longhorn.Volume{
+				newAlertRuleTestVolume(TestVolumeName, longhorn.VolumeRobustnessHealthy, now.Add(-2*time.Hour).Format(time.RFC3339)),
+				newAlertRuleTestVolume("recent-backup-volume", longhorn.VolumeRobustnessHealthy, now.Add(-10*time.Minute).Format(time.RFC3339)),
+				newAlertRuleTestVolume("no-backup-volume", longhorn.VolumeRobustnessHealthy, ""),
+			},
+			expectedAlerts: []longhorn.Alert{
+				{ObjectKind: alertObjectKindVolume, ObjectName: TestVolumeName, State: longhorn.AlertStateFiring},
+			},
+		},
+		"node down with failed notification": {
+			spec: longhorn.AlertRuleSpec{
+				Type: longhorn.AlertRuleTypeNodeDown,
+			},
+			nodes:          []*longhorn.Node{readyNode, downNode},
+			receiverStatus: http.StatusInternalServerError,
+			expectedAlerts: []longhorn.Alert{
+				{ObjectKind: alertObjectKindNode, ObjectName: TestNode2, State: longhorn.AlertStateFiring},
+			},
+			expectedNotifications:     []longhorn.AlertState{longhorn.AlertStateFiring},
+			expectedNotifiedCondition: longhorn.ConditionStatusFalse,
+		},
+		"recovered node resolves alert": {
+			spec: longhorn.AlertRuleSpec{
+				Type: longhorn.AlertRuleTypeNodeDown,
+			},
+			nodes: []*longhorn.Node{readyNode, recoveredNode},
+			existingAlerts: []longhorn.Alert{
+				{
+					ObjectKind: alertObjectKindNode,
+					ObjectName: TestNode2,
+					State:      longhorn.AlertStateFiring,
+					StartsAt:   metav1.Time{Time: now.Add(-time.Hour)},
+					FiredAt:    metav1.Time{Time: now.Add(-time.Hour)},
+					Notified:   true,
+				},
+			},
+			receiverStatus:            http.StatusOK,
+			expectedNotifications:     []longhorn.AlertState{longhorn.AlertStateResolved},
+			expectedNotifiedCondition: longhorn.ConditionStatusTrue,
+		},
+		"disabled rule clears alerts": {
+			spec: longhorn.AlertRuleSpec{
+				Type:     longhorn.AlertRuleTypeNodeDown,
+				Disabled: true,
+			},
+			nodes: []*longhorn.Node{readyNode, downNode},
+			existingAlerts: []longhorn.Alert{
+				{
+					ObjectKind: alertObjectKindNode,
+					ObjectName: TestNode2,
+					State:      longhorn.AlertStateFiring,
+					Notified:   true,
+				},
+			},
+			receiverStatus: http.StatusOK,
+		},
+	}
+
+	for name, tc := range testCases {
+		c.Logf("testing %v", name)
+
+		kubeClient := fake.NewSimpleClientset()
+		lhClient := lhfake.NewSimpleClientset()
+		extensionsClient := apiextensionsfake.NewSimpleClientset()
+		informerFactories := util.NewInformerFactories(TestNamespace, kubeClient, lhClient, controller.NoResyncPeriodFunc())
+
+		nodeIndexer := informerFactories.LhInformerFactory.Longhorn().V1beta2().Nodes().Informer().GetIndexer()
+		volumeIndexer := informerFactories.LhInformerFactory.Longhorn().V1beta2().Volumes().Informer().GetIndexer()
+		alertRuleIndexer := informerFactories.LhInformerFactory.Longhorn().V1beta2().AlertRules().Informer().GetIndexer()
+
+		arc, err := newTestAlertRuleController(lhClient, kubeClient, extensionsClient, informerFactories)
+		c.Assert(err, IsNil)
+
+		for _, node := range tc.nodes {
+			n, err := lhClient.LonghornV1beta2().Nodes(TestNamespace).Create(context.TODO(), node.DeepCopy(), metav1.CreateOptions{})
+			c.Assert(err, IsNil)
+			err = nodeIndexer.Add(n)
+			c.Assert(err, IsNil)
+		}
+		for _, volume := range tc.volumes {
+			v, err := lhClient.LonghornV1beta2().Volumes(TestNamespace).Create(context.TODO(), volume.DeepCopy(), metav1.CreateOptions{})
+			c.Assert(err, IsNil)
+			err = volumeIndexer.Add(v)
+			c.Assert(err, IsNil)
+		}
+
+		receiver := &fakeAlertReceiver{status: tc.receiverStatus}
+		server := httptest.NewServer(receiver)
+
+		alertRule := &longhorn.AlertRule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "test-alert-rule",
+				Namespace:  TestNamespace,
+				Generation: 1,
+			},
+			Spec: tc.spec,
+			Status: longhorn.AlertRuleStatus{
+				Alerts: tc.existingAlerts,
+			},
+		}
+		if tc.receiverStatus != 0 {
+			alertRule.Spec.Receivers = []longhorn.AlertReceiver{
+				{Name: "test-receiver", URL: server.URL, SendResolved: true},
+			}
+		}
+		alertRule, err = lhClient.LonghornV1beta2().AlertRules(TestNamespace).Create(context.TODO(), alertRule, metav1.CreateOptions{})
+		c.Assert(err, IsNil)
+		err = alertRuleIndexer.Add(alertRule)
+		c.Assert(err, IsNil)
+
+		err = arc.reconcile(alertRule.Name)
+		c.Assert(err, IsNil)
+		server.Close()
+
+		alertRule, err = lhClient.LonghornV1beta2().AlertRules(TestNamespace).Get(context.TODO(), alertRule.Name, metav1.GetOptions{})
+		c.Assert(err, IsNil)
+
+		c.Assert(alertRule.Status.Alerts, HasLen, len(tc.expectedAlerts))
+		for i, expected := range tc.expectedAlerts {
+			alert := alertRule.Status.Alerts[i]
+			c.Assert(alert.ObjectKind, Equals, expected.ObjectKind)
+			c.Assert(alert.ObjectName, Equals, expected.ObjectName)
+			c.Assert(alert.State, Equals, expected.State)
+			c.Assert(alert.Notified, Equals, expected.Notified)
+			c.Assert(alert.StartsAt.IsZero(), Equals, false)
+			c.Assert(alert.FiredAt.IsZero(), Equals, expected.State != longhorn.AlertStateFiring)
+		}
+
+		c.Assert(receiver.notifications, HasLen, len(tc.expectedNotifications))
+		for i, expected := range tc.expectedNotifications {
+			c.Assert(receiver.notifications[i].Rule, Equals, alertRule.Name)
+			c.Assert(receiver.notifications[i].State, Equals, expected)
+			c.Assert(len(receiver.notifications[i].Alerts) > 0, Equals, true)
+		}
+
+		condition := types.GetCondition(alertRule.Status.Conditions, longhorn.AlertRuleConditionTypeNotified)
+		if tc.expectedNotifiedCondition == "" {
+			c.Assert(condition.Status, Equals, longhorn.ConditionStatusUnknown)
+		} else {
+			c.Assert(condition.Status, Equals, tc.expectedNotifiedCondition)
+		}
+	}
+}
@@ -48,6 +48,7 @@ const (
 	FailedToGetSnapshotMessage             = "Failed to get the Snapshot %v"
 	FailedToDeleteBackupMessage            = "Failed to delete the backup %v in the backupstore, err %v"
 	NoDeletionInProgressRecordMessage      = "No deletion in progress record, retry the deletion command"
+	FailedToCopyBackupMessage              = "Failed to copy the backup %v to the backupstore, err %v"
 )
 
 const (
@@ -64,6 +65,12 @@ type DeletingStatus struct {
 	ErrorMessage string
 }
 
+type CopyingStatus struct {
+	State        longhorn.BackupState
+	Progress     int
+	ErrorMessage string
+}
+
 type BackupController struct {
 	*baseController
 
@@ -89,6 +96,14 @@ type BackupController struct {
 	deletingMapLock       sync.Mutex
 	inProgressDeletingMap map[string]*DeletingStatus
 
+	// Use to track the copies running in this controller. The state and progress are persisted in the backup status,
+	// the copy interrupted by a manager restart is resumed if there is no record.
+	copyingMapLock       sync.Mutex
+	inProgressCopyingMap map[string]*CopyingStatus
+
+	backupCopier func(backupTargetClient *engineapi.BackupTargetClient, sourceBackupURL string, sourceCredential map[string]string,
+		sourceBandwidthLimits []longhorn.BandwidthLimit, backupName string, labels map[string]string, progress func(int)) error
+
 	deletingBackoff      *flowcontrol.Backoff
 	creationRetryCounter *util.TimedCounter
 }
@@ -128,6 +143,10 @@ func NewBackupController(
 		deletingMapLock:       sync.Mutex{},
 		inProgressDeletingMap: map[string]*DeletingStatus{},
 
+		copyingMapLock:       sync.Mutex{},
+		inProgressCopyingMap: map[string]*CopyingStatus{},
+		backupCopier:         (*engineapi.BackupTargetClient).BackupCopy,
+
 		deletingBackoff:      flowcontrol.NewBackOff(DeletionMinInterval, DeletionMaxInterval),
 		creationRetryCounter: util.NewTimedCounter(creationRetryCounterExpiredDuration),
 	}
@@ -356,6 +375,10 @@ func (bc *BackupController) reconcile(backupName string) (err error) {
 		// Disable monitor regardless of backup state
 		bc.disableBackupMonitor(backup.Name)
 
+		bc.copyingMapLock.Lock()
+		delete(bc.inProgressCopyingMap, backup.Name)
+		bc.copyingMapLock.Unlock()
+
 		bc.creationRetryCounter.DeleteEntry(backup.Name)
 
 		if backup.Status.State == longhorn.BackupStateError || backup.Status.State == longhorn.BackupStateUnknown {
@@ -400,6 +423,9 @@ func (bc *BackupController) reconcile(backupName string) (err error) {
 			err = nil // nolint: ineffassign
 			return
 		}
+		if existingBackup.Status.LastSyncedAt.IsZero() && !backup.Status.LastSyncedAt.IsZero() {
+			observeFinishedBackup(backup, syncTime.Time)
+		}
 		if backup.Status.State == longhorn.BackupStateCompleted && existingBackupState != backup.Status.State {
 			if err := bc.syncBackupVolume(backupTargetName, canonicalBackupVolumeName); err != nil {
 				log.Warnf("failed to sync backup volume %v for backup target %v", canonicalBackupVolumeName, backupTargetName)
@@ -408,6 +434,24 @@ func (bc *BackupController) reconcile(backupName string) (err error) {
 		}
 	}()
 
+	// Copy the backup from another backup target
+	// If the Backup CR is created with spec.sourceBackupName, the backup data comes from the source backup
+	// in another backup target instead of a volume snapshot. After the copy is complete,
+	// the source of truth becomes the remote backup target as the backups pulled by the backup volume controller.
+	if backup.Status.LastSyncedAt.IsZero() && backup.Spec.SourceBackupName != "" && !bc.backupInFinalState(backup) {
+		if err := bc.handleBackupCopy(backup, backupTarget); err != nil {
+			return err
+		}
+
+		switch backup.Status.State {
+		case longhorn.BackupStateNew, longhorn.BackupStatePending, longhorn.BackupStateInProgress:
+			return nil
+		case longhorn.BackupStateError, longhorn.BackupStateUnknown:
+			backup.Status.LastSyncedAt = syncTime
+			return nil
+		}
+	}
+
 	// Perform backup snapshot to the remote backup target
 	// If the Backup CR is created by the user/API layer (spec.snapshotName != ""), has not been synced (status.lastSyncedAt == "")
 	// and is not in final state, it means creating a backup from a volume snapshot is required.
@@ -545,6 +589,29 @@ func (bc *BackupController) reconcile(backupName string) (err error) {
 	return err
 }
 
+// observeFinishedBackup records the duration and the transferred data size of the backup once it is finished. The
+// backups pulled from the remote backup target are not created by this cluster, hence they are skipped.
+func observeFinishedBackup(backup *longhorn.Backup, finishedAt time.Time) {
+	if backup.Spec.SnapshotName == "" && backup.Spec.SourceBackupName == "" {
+		return
+	}
+
+	succeeded := backup.Status.State == longhorn.BackupStateCompleted
+	transferredBytes := int64(0)
+	if succeeded {
+		newlyUploadedSize, err := util.ConvertSize(backup.Status.NewlyUploadedDataSize)
+		if err != nil {
+			logrus.WithError(err).Warnf("Failed to parse the newly uploaded data size of backup %v", backup.Name)
+		}
+		reUploadedSize, err := util.ConvertSize(backup.Status.ReUploadedDataSize)
+		if err != nil {
+			logrus.WithError(err).Warnf("Failed to parse the re-uploaded data size of backup %v", backup.Name)
+		}
+		transferredBytes = newlyUploadedSize + reUploadedSize
+	}
+	backupLifecycleMetric.ObserveBackup(succeeded, finishedAt.Sub(backup.CreationTimestamp.Time), transferredBytes)
+}
+
 // handleAttachmentTicketDeletion check and delete attachment so that the source volume is detached if needed
 func (bc *BackupController) handleAttachmentTicketDeletion(backup *longhorn.Backup, volumeName string) (err error) {
 	defer func() {
@@ -1167,6 +1234,151 @@ func (bc *BackupController) handleBackupDeletionInBackupStore(backup *longhorn.B
 	return true
 }
 
+// handleBackupCopy triggers the asynchronous copy of the source backup to the backup target of the backup,
+// then updates the backup state and progress according to the running copy.
+func (bc *BackupController) handleBackupCopy(backup *longhorn.Backup, backupTarget *longhorn.BackupTarget) error {
+	log := getLoggerForBackup(bc.logger, backup)
+
+	bc.copyingMapLock.Lock()
+	copyingStatus := bc.inProgressCopyingMap[backup.Name]
+	bc.copyingMapLock.Unlock()
+
+	if copyingStatus != nil {
+		backup.Status.State = copyingStatus.State
+		backup.Status.Progress = copyingStatus.Progress
+		if copyingStatus.State == longhorn.BackupStateError {
+			backup.Status.Error = copyingStatus.ErrorMessage
+		}
+		return nil
+	}
+
+	// There is no running copy if the copy is new, or it was interrupted by a manager restart or an owner change.
+	// The interrupted copy is started again, the blocks already in the backup target are not transferred again.
+	if backup.Status.State == longhorn.BackupStateInProgress {
+		log.Infof("Resuming the interrupted copy at progress %v%%", backup.Status.Progress)
+	}
+
+	sourceBackup, err := bc.ds.GetBackupRO(backup.Spec.SourceBackupName)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		backup.Status.State = longhorn.BackupStateError
+		backup.Status.Error = fmt.Sprintf("cannot find the source backup %v", backup.Spec.SourceBackupName)
+		return nil
+	}
+	if sourceBackup.Status.State != longhorn.BackupStateCompleted {
+		backup.Status.State = longhorn.BackupStateError
+		backup.Status.Error = fmt.Sprintf("source backup %v is in state %v instead of %v", sourceBackup.Name, sourceBackup.Status.State, longhorn.BackupStateCompleted)
+		return nil
+	}
+
+	sourceBackupTarget, err := bc.getBackupTarget(sourceBackup)
+	if err != nil {
+		return err
+	}
+	sourceBackupVolumeName, err := bc.getBackupVolumeName(sourceBackup)
+	if err != nil {
+		return err
+	}
+	if sourceBackupTarget.Name == backupTarget.Name {
+		backup.Status.State = longhorn.BackupStateError
+		backup.Status.Error = fmt.Sprintf("source backup %v is already in the backup target %v", sourceBackup.Name, backupTarget.Name)
+		return nil
+	}
+
+	sourceBackupTargetClient, err := newBackupTargetClientFromDefaultEngineImage(bc.ds, sourceBackupTarget)
+	if err != nil {
+		return errors.Wrapf(err, "failed to init backup target client for the source backup target %v", sourceBackupTarget.Name)
+	}
+	backupTargetClient, err := newBackupTargetClientFromDefaultEngineImage(bc.ds, backupTarget)
+	if err != nil {
+		return errors.Wrapf(err, "failed to init backup target client for the backup target %v", backupTarget.Name)
+	}
+
+	// The copy runs in this manager, hence it is capped by the upload limits of the destination and the download
+	// limits of the source on this node
+	sourceBackupTargetClient.BandwidthLimits, err = bc.ds.GetBackupBandwidthLimits(sourceBackupTarget.Name, bc.controllerID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get the restore bandwidth limits")
+	}
+	backupTargetClient.BandwidthLimits, err = bc.ds.GetBackupBandwidthLimits(backupTarget.Name, bc.controllerID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get the backup bandwidth limits")
+	}
+
+	sourceBackupURL := backupstore.EncodeBackupURL(sourceBackup.Name, sourceBackupVolumeName, sourceBackupTargetClient.URL)
+	log.Infof("Copying backup from %v", sourceBackupURL)
+	bc.startCopyingBackupInBackupStore(backup, sourceBackupURL, sourceBackupTargetClient, backupTargetClient)
+
+	backup.Status.State = longhorn.BackupStateInProgress
+	backup.Status.Error = ""
+	return nil
+}
+
+func (bc *BackupController) startCopyingBackupInBackupStore(backup *longhorn.Backup, sourceBackupURL string, sourceBackupTargetClient, backupTargetClient *engineapi.BackupTargetClient) {
+	backupName := backup.Name
+	labels := backup.Spec.Labels
+
+	bc.copyingMapLock.Lock()
+	bc.inProgressCopyingMap[backupName] = &CopyingStatus{
+		State:        longhorn.BackupStateInProgress,
+		Progress:     backup.Status.Progress,
+		ErrorMessage: "",
+	}
+	bc.copyingMapLock.Unlock()
+
+	// The backup copy will be executed asynchronously.
+	// The progress and result are recorded in the in-memory map then the backup is requeued to persist them in the status.
+	go func() {
+		state := longhorn.BackupStateCompleted
+		errMsg := ""
+		defer func() {
+			if r := recover(); r != nil {
+				state = longhorn.BackupStateError
+				errMsg = fmt.Sprintf("Recovered from panic: %v", r)
+			}
+			bc.setInprogressCopyingMap(backupName, state, errMsg)
+			bc.enqueueBackup(backup)
+		}()
+		progress := func(progress int) {
+			if bc.setInprogressCopyingProgress(backupName, progress) {
+				bc.enqueueBackup(backup)
+			}
+		}
+		if err := bc.backupCopier(backupTargetClient, sourceBackupURL, sourceBackupTargetClient.Credential, sourceBackupTargetClient.BandwidthLimits, backupName, labels, progress); err != nil {
+			state = longhorn.BackupStateError
+			errMsg = fmt.Sprintf(FailedToCopyBackupMessage, backupName, err.Error())
+		}
+	}()
+}
+
+func (bc *BackupController) setInprogressCopyingMap(backupName string, state longhorn.BackupState, errMsg string) {
+	bc.copyingMapLock.Lock()
+	defer bc.copyingMapLock.Unlock()
+
+	if bc.inProgressCopyingMap[backupName] == nil {
+		return
+	}
+	bc.inProgressCopyingMap[backupName].State = state
+	bc.inProgressCopyingMap[backupName].ErrorMessage = errMsg
+	if state == longhorn.BackupStateCompleted {
+		bc.inProgressCopyingMap[backupName].Progress = 100
+	}
+}
+
+// setInprogressCopyingProgress returns true if the progress of the copy is changed
+func (bc *BackupController) setInprogressCopyingProgress(backupName string, progress int) bool {
+	bc.copyingMapLock.Lock()
+	defer bc.copyingMapLock.Unlock()
+
+	if bc.inProgressCopyingMap[backupName] == nil || bc.inProgressCopyingMap[backupName].Progress == progress {
+		return false
+	}
+	bc.inProgressCopyingMap[backupName].Progress = progress
+	return true
+}
+
 func (bc *BackupController) setInprogressDeletionMap(backupURL string, state longhorn.BackupState, errMsg string) {
 	bc.deletingMapLock.Lock()
 	defer bc.deletingMapLock.Unlock()
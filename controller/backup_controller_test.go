@@ -0,0 +1,245 @@
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/kubernetes/pkg/controller"
+
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/longhorn/backupstore"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/engineapi"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	lhfake "github.com/longhorn/longhorn-manager/k8s/pkg/client/clientset/versioned/fake"
+
+	. "gopkg.in/check.v1"
+)
+
+const (
+	TestSourceBackupTargetName = "source"
+	TestSourceBackupTargetURL  = "nfs://source.example.com:/opt/backupstore"
+	TestDestBackupTargetURL    = "nfs://dest.example.com:/opt/backupstore"
+	TestSourceBackupName       = "source-backup"
+)
+
+// fakeBackupCopier reports the progress then blocks the copy until it is released
+type fakeBackupCopier struct {
+	progress int
+	err      error
+
+	sourceBackupURLs chan string
+	release          chan struct{}
+
+	sourceBandwidthLimits []longhorn.BandwidthLimit
+	bandwidthLimits       []longhorn.BandwidthLimit
+}
+
+func (f *fakeBackupCopier) copy(backupTargetClient *engineapi.BackupTargetClient, sourceBackupURL string, sourceCredential map[string]string,
+	sourceBandwidthLimits []longhorn.BandwidthLimit, backupName string, labels map[string]string, progress func(int)) error {
+	f.sourceBandwidthLimits = sourceBandwidthLimits
+	f.bandwidthLimits = backupTargetClient.BandwidthLimits
+	f.sourceBackupURLs <- sourceBackupURL
+	progress(f.progress)
+	<-f.release
+	return f.err
+}
+
+func newTestBackupController(lhClient *lhfake.Clientset, kubeClient *fake.Clientset, extensionsClient *apiextensionsfake.Clientset, informerFactories *util.InformerFactories) (*BackupController, error) {
+	ds := datastore.NewDataStore(TestNamespace, lhClient, kubeClient, extensionsClient, informerFactories)
+
+	logger := logrus.StandardLogger()
+	c, err := NewBackupController(logger, ds, scheme.Scheme, kubeClient, TestNode1, TestNamespace, util.NewAtomicCounter())
+	if err != nil {
+		return nil, err
+	}
+
+	c.eventRecorder = record.NewFakeRecorder(100)
+	for index := range c.cacheSyncs {
+		c.cacheSyncs[index] = alwaysReady
+	}
+
+	return c, nil
+}
+
+func (s *TestSuite) TestHandleBackupCopy(c *C) {
+	sourceBackupURL := backupstore.EncodeBackupURL(TestSourceBackupName, TestVolumeName, TestSourceBackupTargetURL)
+	bandwidthLimits := map[string]longhorn.BandwidthLimit{
+		TestSourceBackupTargetName: {RestoreBytesPerSecond: 100},
+		TestBackupTargetName:       {BackupBytesPerSecond: 200},
+	}
+	nodeBandwidthLimit := longhorn.BandwidthLimit{BackupBytesPerSecond: 300, RestoreBytesPerSecond: 400}
+
+	testCases := map[string]struct {
+		state         longhorn.BackupState
+		progress      int
+		copyingStatus *CopyingStatus
+		copyErr       error
+
+		expectedCopy     bool
+		expectedState    longhorn.BackupState
+		expectedProgress int
+		expectedError    string
+	}{
+		"new copy": {
+			state:            longhorn.BackupStateNew,
+			expectedCopy:     true,
+			expectedState:    longhorn.BackupStateInProgress,
+			expectedProgress: 0,
+		},
+		"copy interrupted by manager restart is resumed": {
+			state:            longhorn.BackupStateInProgress,
+			progress:         40,
+			expectedCopy:     true,
+			expectedState:    longhorn.BackupStateInProgress,
+			expectedProgress: 40,
+		},
+		"failed copy is recorded": {
+			state:            longhorn.BackupStateNew,
+			copyErr:          fmt.Errorf("failed to export backup"),
+			expectedCopy:     true,
+			expectedState:    longhorn.BackupStateInProgress,
+			expectedProgress: 0,
+		},
+		"running copy updates progress": {
+			state:            longhorn.BackupStateInProgress,
+			progress:         40,
+			copyingStatus:    &CopyingStatus{State: longhorn.BackupStateInProgress, Progress: 80},
+			expectedState:    longhorn.BackupStateInProgress,
+			expectedProgress: 80,
+		},
+		"completed copy": {
+			state:            longhorn.BackupStateInProgress,
+			progress:         80,
+			copyingStatus:    &CopyingStatus{State: longhorn.BackupStateCompleted, Progress: 100},
+			expectedState:    longhorn.BackupStateCompleted,
+			expectedProgress: 100,
+		},
+		"failed copy": {
+			state:            longhorn.BackupStateInProgress,
+			progress:         80,
+			copyingStatus:    &CopyingStatus{State: longhorn.BackupStateError, Progress: 80, ErrorMessage: "failed"},
+			expectedState:    longhorn.BackupStateError,
+			expectedProgress: 80,
+			expectedError:    "failed",
+		},
+	}
+
+	for name, tc := range testCases {
+		c.Logf("testing %v", name)
+
+		kubeClient := fake.NewSimpleClientset()
+		lhClient := lhfake.NewSimpleClientset()
+		extensionsClient := apiextensionsfake.NewSimpleClientset()
+		informerFactories := util.NewInformerFactories(TestNamespace, kubeClient, lhClient, controller.NoResyncPeriodFunc())
+
+		settingIndexer := informerFactories.LhInformerFactory.Longhorn().V1beta2().Settings().Informer().GetIndexer()
+		backupTargetIndexer := informerFactories.LhInformerFactory.Longhorn().V1beta2().BackupTargets().Informer().GetIndexer()
+		backupIndexer := informerFactories.LhInformerFactory.Longhorn().V1beta2().Backups().Informer().GetIndexer()
+		nodeIndexer := informerFactories.LhInformerFactory.Longhorn().V1beta2().Nodes().Informer().GetIndexer()
+
+		bc, err := newTestBackupController(lhClient, kubeClient, extensionsClient, informerFactories)
+		c.Assert(err, IsNil)
+		copier := &fakeBackupCopier{progress: 60, err: tc.copyErr, sourceBackupURLs: make(chan string, 1), release: make(chan struct{})}
+		bc.backupCopier = copier.copy
+
+		err = settingIndexer.Add(newSetting(string(types.SettingNameDefaultEngineImage), TestEngineImage))
+		c.Assert(err, IsNil)
+		for name, url := range map[string]string{TestSourceBackupTargetName: TestSourceBackupTargetURL, TestBackupTargetName: TestDestBackupTargetURL} {
+			err = backupTargetIndexer.Add(&longhorn.BackupTarget{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: TestNamespace},
+				Spec:       longhorn.BackupTargetSpec{BackupTargetURL: url, BandwidthLimit: bandwidthLimits[name]},
+			})
+			c.Assert(err, IsNil)
+		}
+		node := newNode(TestNode1, TestNamespace, true, longhorn.ConditionStatusTrue, "")
+		node.Spec.BackupBandwidthLimit = nodeBandwidthLimit
+		err = nodeIndexer.Add(node)
+		c.Assert(err, IsNil)
+
+		sourceBackup := newBackup(TestSourceBackupName)
+		sourceBackup.Labels = types.GetBackupVolumeWithBackupTargetLabels(TestSourceBackupTargetName, TestVolumeName)
+		sourceBackup.Status.State = longhorn.BackupStateCompleted
+		err = backupIndexer.Add(sourceBackup)
+		c.Assert(err, IsNil)
+
+		backup := newBackup("copy-backup")
+		backup.Labels = types.GetBackupVolumeWithBackupTargetLabels(TestBackupTargetName, TestVolumeName)
+		backup.Spec.SourceBackupName = TestSourceBackupName
+		backup.Status.State = tc.state
+		backup.Status.Progress = tc.progress
+		if tc.copyingStatus != nil {
+			bc.inProgressCopyingMap[backup.Name] = tc.copyingStatus
+		}
+
+		backupTarget, err := bc.ds.GetBackupTargetRO(TestBackupTargetName)
+		c.Assert(err, IsNil)
+		err = bc.handleBackupCopy(backup, backupTarget)
+		c.Assert(err, IsNil)
+
+		c.Assert(backup.Status.State, Equals, tc.expectedState)
+		c.Assert(backup.Status.Progress, Equals, tc.expectedProgress)
+		c.Assert(backup.Status.Error, Equals, tc.expectedError)
+
+		if !tc.expectedCopy {
+			c.Assert(copier.sourceBackupURLs, HasLen, 0)
+			continue
+		}
+
+		select {
+		case url := <-copier.sourceBackupURLs:
+			c.Assert(url, Equals, sourceBackupURL)
+		case <-time.After(10 * time.Second):
+			c.Fatal("backup copy is not started")
+		}
+		// Each side of the copy is capped by the limits of its backup target and the node running the copy
+		c.Assert(copier.sourceBandwidthLimits, DeepEquals, []longhorn.BandwidthLimit{bandwidthLimits[TestSourceBackupTargetName], nodeBandwidthLimit})
+		c.Assert(copier.bandwidthLimits, DeepEquals, []longhorn.BandwidthLimit{bandwidthLimits[TestBackupTargetName], nodeBandwidthLimit})
+
+		// The progress and result of the running copy are picked up in the next reconciliations
+		c.Assert(waitForCopyingStatus(bc, backup.Name, longhorn.BackupStateInProgress, copier.progress), IsNil)
+		err = bc.handleBackupCopy(backup, backupTarget)
+		c.Assert(err, IsNil)
+		c.Assert(backup.Status.State, Equals, longhorn.BackupStateInProgress)
+		c.Assert(backup.Status.Progress, Equals, copier.progress)
+
+		close(copier.release)
+		if tc.copyErr != nil {
+			c.Assert(waitForCopyingStatus(bc, backup.Name, longhorn.BackupStateError, copier.progress), IsNil)
+			err = bc.handleBackupCopy(backup, backupTarget)
+			c.Assert(err, IsNil)
+			c.Assert(backup.Status.State, Equals, longhorn.BackupStateError)
+			c.Assert(backup.Status.Error, Matches, ".*"+tc.copyErr.Error()+".*")
+			continue
+		}
+		c.Assert(waitForCopyingStatus(bc, backup.Name, longhorn.BackupStateCompleted, 100), IsNil)
+		err = bc.handleBackupCopy(backup, backupTarget)
+		c.Assert(err, IsNil)
+		c.Assert(backup.Status.State, Equals, longhorn.BackupStateCompleted)
+		c.Assert(backup.Status.Progress, Equals, 100)
+	}
+}
+
+func waitForCopyingStatus(bc *BackupController, backupName string, state longhorn.BackupState, progress int) error {
+	for i := 0; i < 100; i++ {
+		bc.copyingMapLock.Lock()
+		copyingStatus := bc.inProgressCopyingMap[backupName]
+		bc.copyingMapLock.Unlock()
+		if copyingStatus != nil && copyingStatus.State == state && copyingStatus.Progress == progress {
+			return nil
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	return fmt.Errorf("copy of backup %v is not in state %v with progress %v", backupName, state, progress)
+}
@@ -0,0 +1,24 @@
+package controller
+
+import (
+	"time"
+)
+
+// BackupLifecycleMetric observes the backups and restores finished by the volumes owned by this longhorn manager.
+type BackupLifecycleMetric interface {
+	ObserveBackup(succeeded bool, duration time.Duration, transferredBytes int64)
+	ObserveRestore(succeeded bool, duration time.Duration, transferredBytes int64)
+}
+
+type noopBackupLifecycleMetric struct{}
+
+func (noopBackupLifecycleMetric) ObserveBackup(bool, time.Duration, int64)  {}
+func (noopBackupLifecycleMetric) ObserveRestore(bool, time.Duration, int64) {}
+
+var backupLifecycleMetric BackupLifecycleMetric = noopBackupLifecycleMetric{}
+
+// RegisterBackupLifecycleMetric sets the metric observing the finished backups and restores. It should be called
+// during initialization before the controllers start.
+func RegisterBackupLifecycleMetric(metric BackupLifecycleMetric) {
+	backupLifecycleMetric = metric
+}
@@ -35,6 +35,10 @@ import (
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
 
+const (
+	garbageCollectionRetryInterval = 30 * time.Second
+)
+
 type BackupTargetController struct {
 	*baseController
 
@@ -50,6 +54,10 @@ type BackupTargetController struct {
 	bsTimerMap     map[string]*BackupStoreTimer
 	bsTimerMapLock *sync.RWMutex
 
+	// garbage collection map records the result of the asynchronous garbage collection of each backup target
+	gcMap     map[string]*longhorn.BackupTargetGarbageCollectionStatus
+	gcMapLock *sync.Mutex
+
 	ds *datastore.DataStore
 
 	cacheSyncs []cache.InformerSynced
@@ -92,6 +100,9 @@ func NewBackupTargetController(
 		bsTimerMap:     map[string]*BackupStoreTimer{},
 		bsTimerMapLock: &sync.RWMutex{},
 
+		gcMap:     map[string]*longhorn.BackupTargetGarbageCollectionStatus{},
+		gcMapLock: &sync.Mutex{},
+
 		ds: ds,
 
 		kubeClient:    kubeClient,
@@ -140,6 +151,16 @@ func (btc *BackupTargetController) enqueueBackupTarget(obj interface{}) {
 	btc.queue.Add(key)
 }
 
+func (btc *BackupTargetController) enqueueBackupTargetAfter(obj interface{}, duration time.Duration) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("couldn't get key for object %#v: %v", obj, err))
+		return
+	}
+
+	btc.queue.AddAfter(key, duration)
+}
+
 func (btc *BackupTargetController) enqueueEngineImage(obj interface{}) {
 	ei, ok := obj.(*longhorn.EngineImage)
 	if !ok {
@@ -375,6 +396,10 @@ func (btc *BackupTargetController) reconcile(name string) (err error) {
 
 		stopTimer(backupTarget.Name)
 
+		btc.gcMapLock.Lock()
+		delete(btc.gcMap, backupTarget.Name)
+		btc.gcMapLock.Unlock()
+
 		if err := btc.cleanUpAllBackupRelatedResources(backupTarget.Name); err != nil {
 			return err
 		}
@@ -403,6 +428,11 @@ func (btc *BackupTargetController) reconcile(name string) (err error) {
 	}
 	btc.bsTimerMapLock.Unlock()
 
+	backupTarget, err = btc.handleGarbageCollection(backupTarget)
+	if err != nil {
+		return err
+	}
+
 	// Check the controller should run synchronization
 	if !backupTarget.Status.LastSyncedAt.IsZero() &&
 		!backupTarget.Spec.SyncRequestedAt.After(backupTarget.Status.LastSyncedAt.Time) {
@@ -467,6 +497,10 @@ func (btc *BackupTargetController) reconcile(name string) (err error) {
 		return err
 	}
 
+	if err = btc.syncBackupTargetStorage(backupTarget, info); err != nil {
+		return err
+	}
+
 	if err = btc.syncBackupBackingImage(backupTarget, info.backupStoreBackingImageNames, syncTime, log); err != nil {
 		return err
 	}
@@ -479,6 +513,128 @@ func (btc *BackupTargetController) reconcile(name string) (err error) {
 	return nil
 }
 
+// handleGarbageCollection starts the requested garbage collection of the backup target asynchronously,
+// and records the result in the status once it is done.
+func (btc *BackupTargetController) handleGarbageCollection(backupTarget *longhorn.BackupTarget) (*longhorn.BackupTarget, error) {
+	log := getLoggerForBackupTarget(btc.logger, backupTarget)
+
+	requestedAt := backupTarget.Spec.GarbageCollectionRequestedAt
+	gcStatus := backupTarget.Status.GarbageCollection
+	isNewRequest := !requestedAt.IsZero() && requestedAt.After(gcStatus.RequestedAt.Time)
+	if !isNewRequest && gcStatus.State != longhorn.BackupTargetGarbageCollectionStateInProgress {
+		return backupTarget, nil
+	}
+
+	existingBackupTarget := backupTarget.DeepCopy()
+
+	btc.gcMapLock.Lock()
+	result, exists := btc.gcMap[backupTarget.Name]
+	btc.gcMapLock.Unlock()
+
+	switch {
+	case exists && result.State == longhorn.BackupTargetGarbageCollectionStateInProgress:
+		return backupTarget, nil
+	case exists:
+		backupTarget.Status.GarbageCollection = *result
+		btc.gcMapLock.Lock()
+		delete(btc.gcMap, backupTarget.Name)
+		btc.gcMapLock.Unlock()
+	case isNewRequest:
+		if backupTarget.Spec.BackupTargetURL == "" {
+			backupTarget.Status.GarbageCollection = longhorn.BackupTargetGarbageCollectionStatus{
+				RequestedAt: requestedAt,
+				DryRun:      backupTarget.Spec.GarbageCollectionDryRun,
+				State:       longhorn.BackupTargetGarbageCollectionStateError,
+				Error:       "backup target URL is empty",
+			}
+			break
+		}
+		deleting, err := btc.hasBackupInDeletion(backupTarget.Name)
+		if err != nil {
+			return nil, err
+		}
+		if deleting {
+			// The blocks of the backups in deletion are not referenced anymore but still being removed,
+			// wait for the deletions to complete before scanning the backup target.
+			log.Info("Waiting for the backups in deletion before collecting garbage")
+			btc.enqueueBackupTargetAfter(backupTarget, garbageCollectionRetryInterval)
+			return backupTarget, nil
+		}
+		backupTargetClient, err := newBackupTargetClientFromDefaultEngineImage(btc.ds, backupTarget)
+		if err != nil {
+			return nil, err
+		}
+		backupTarget.Status.GarbageCollection = longhorn.BackupTargetGarbageCollectionStatus{
+			RequestedAt: requestedAt,
+			DryRun:      backupTarget.Spec.GarbageCollectionDryRun,
+			State:       longhorn.BackupTargetGarbageCollectionStateInProgress,
+		}
+		btc.startGarbageCollection(backupTarget, backupTargetClient)
+	default:
+		// The in-memory record is lost since the controller restarted
+		backupTarget.Status.GarbageCollection.State = longhorn.BackupTargetGarbageCollectionStateError
+		backupTarget.Status.GarbageCollection.Error = "cannot find the in-progress garbage collection record, the controller may have restarted"
+	}
+
+	if reflect.DeepEqual(existingBackupTarget.Status, backupTarget.Status) {
+		return backupTarget, nil
+	}
+	return btc.ds.UpdateBackupTargetStatus(backupTarget)
+}
+
+func (btc *BackupTargetController) startGarbageCollection(backupTarget *longhorn.BackupTarget, backupTargetClient *engineapi.BackupTargetClient) {
+	log := getLoggerForBackupTarget(btc.logger, backupTarget)
+	name := backupTarget.Name
+	gcStatus := backupTarget.Status.GarbageCollection.DeepCopy()
+
+	btc.gcMapLock.Lock()
+	btc.gcMap[name] = gcStatus.DeepCopy()
+	btc.gcMapLock.Unlock()
+
+	// The garbage collection will be executed asynchronously.
+	// The result is recorded in the in-memory map then the backup target is requeued to pick it up.
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				gcStatus.State = longhorn.BackupTargetGarbageCollectionStateError
+				gcStatus.Error = fmt.Sprintf("recovered from panic: %v", r)
+			}
+			gcStatus.CompletedAt = metav1.Time{Time: time.Now().UTC()}
+			btc.gcMapLock.Lock()
+			btc.gcMap[name] = gcStatus
+			btc.gcMapLock.Unlock()
+			btc.enqueueBackupTarget(backupTarget)
+		}()
+
+		log.Infof("Collecting garbage in backup target, dry run: %v", gcStatus.DryRun)
+		result, err := backupTargetClient.BackupGarbageCollect(gcStatus.DryRun)
+		if err != nil {
+			gcStatus.State = longhorn.BackupTargetGarbageCollectionStateError
+			gcStatus.Error = err.Error()
+			return
+		}
+		gcStatus.State = longhorn.BackupTargetGarbageCollectionStateCompleted
+		gcStatus.OrphanBlockCount = result.OrphanBlockCount
+		gcStatus.OrphanConfigs = result.OrphanConfigs
+		gcStatus.ReclaimableBytes = result.ReclaimableBytes
+		log.Infof("Collected garbage in backup target, orphan blocks: %v, orphan configs: %v, reclaimable bytes: %v, dry run: %v",
+			result.OrphanBlockCount, len(result.OrphanConfigs), result.ReclaimableBytes, gcStatus.DryRun)
+	}()
+}
+
+func (btc *BackupTargetController) hasBackupInDeletion(backupTargetName string) (bool, error) {
+	backups, err := btc.ds.ListBackupsRO()
+	if err != nil {
+		return false, err
+	}
+	for _, backup := range backups {
+		if backup.Labels[types.LonghornLabelBackupTarget] == backupTargetName && !backup.DeletionTimestamp.IsZero() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (btc *BackupTargetController) cleanUpAllBackupRelatedResources(backupTargetName string) error {
 	if err := btc.cleanupBackupVolumes(backupTargetName); err != nil {
 		return errors.Wrap(err, "failed to clean up BackupVolumes")
@@ -517,6 +673,7 @@ type backupStoreInfo struct {
 	backupStoreBackupVolumeNames []string
 	backupStoreBackingImageNames []string
 	backupStoreSystemBackups     systembackupstore.SystemBackups
+	listLatency                  time.Duration
 }
 
 func (btc *BackupTargetController) getInfoFromBackupStore(backupTarget *longhorn.BackupTarget) (info backupStoreInfo, err error) {
@@ -530,10 +687,12 @@ func (btc *BackupTargetController) getInfoFromBackupStore(backupTarget *longhorn
 	defer engineClientProxy.Close()
 
 	// Get required information using backup target client.
+	listStartTime := time.Now()
 	info.backupStoreBackupVolumeNames, err = backupTargetClient.BackupVolumeNameList()
 	if err != nil {
 		return backupStoreInfo{}, errors.Wrapf(err, "failed to list backup volumes in %v", backupTargetClient.URL)
 	}
+	info.listLatency = time.Since(listStartTime)
 	info.backupStoreBackingImageNames, err = backupTargetClient.BackupBackingImageNameList()
 	if err != nil {
 		return backupStoreInfo{}, errors.Wrapf(err, "failed to list backup backing images in %v", backupTargetClient.URL)
@@ -590,6 +749,59 @@ func (btc *BackupTargetController) syncBackupVolume(backupTarget *longhorn.Backu
 	return nil
 }
 
+// syncBackupTargetStorage updates the health and the storage usage of the backup target in the status.
+// The stored bytes come from the deduplicated data size of the backup volumes,
+// and the logical bytes are the sum of the backup sizes.
+func (btc *BackupTargetController) syncBackupTargetStorage(backupTarget *longhorn.BackupTarget, info backupStoreInfo) error {
+	backupVolumes, err := btc.ds.ListBackupVolumesWithBackupTargetNameRO(backupTarget.Name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to list backup volumes of backup target %v", backupTarget.Name)
+	}
+
+	var totalStoredBytes, totalLogicalBytes int64
+	volumeStorage := map[string]longhorn.BackupTargetVolumeStorage{}
+	for _, backupVolume := range backupVolumes {
+		volumeName := backupVolume.Spec.VolumeName
+		if volumeName == "" {
+			continue
+		}
+
+		storage := longhorn.BackupTargetVolumeStorage{}
+		if backupVolume.Status.DataStored != "" {
+			if storage.StoredBytes, err = util.ConvertSize(backupVolume.Status.DataStored); err != nil {
+				btc.logger.WithError(err).Warnf("Failed to parse the data stored of backup volume %v", backupVolume.Name)
+			}
+		}
+
+		backups, err := btc.ds.ListBackupsWithBackupTargetAndBackupVolumeRO(backupTarget.Name, volumeName)
+		if err != nil {
+			return errors.Wrapf(err, "failed to list backups of backup volume %v", backupVolume.Name)
+		}
+		for _, backup := range backups {
+			if backup.Status.Size == "" {
+				continue
+			}
+			size, err := util.ConvertSize(backup.Status.Size)
+			if err != nil {
+				btc.logger.WithError(err).Warnf("Failed to parse the size of backup %v", backup.Name)
+				continue
+			}
+			storage.LogicalBytes += size
+		}
+
+		totalStoredBytes += storage.StoredBytes
+		totalLogicalBytes += storage.LogicalBytes
+		volumeStorage[volumeName] = storage
+	}
+
+	backupTarget.Status.LastListLatency = metav1.Duration{Duration: info.listLatency}
+	backupTarget.Status.BackupVolumeCount = len(info.backupStoreBackupVolumeNames)
+	backupTarget.Status.TotalStoredBytes = totalStoredBytes
+	backupTarget.Status.TotalLogicalBytes = totalLogicalBytes
+	backupTarget.Status.VolumeStorage = volumeStorage
+	return nil
+}
+
 func (btc *BackupTargetController) pullBackupVolumeFromBackupTarget(backupTarget *longhorn.BackupTarget, backupStoreBackupVolumes, clusterBackupVolumesSet sets.Set[string], log logrus.FieldLogger) (err error) {
 	backupVolumesToPull := backupStoreBackupVolumes.Difference(clusterBackupVolumesSet)
 	if count := backupVolumesToPull.Len(); count > 0 {
@@ -296,8 +296,8 @@ func (bvc *BackupVolumeController) reconcile(backupVolumeName string) (err error
 	clustersSet := sets.NewString()
 	for _, b := range clusterBackups {
 		// Skip the Backup CR which is created from the local cluster and
-		// the snapshot backup hasn't be completed or pulled from the remote backup target yet
-		if b.Spec.SnapshotName != "" && b.Status.State != longhorn.BackupStateCompleted {
+		// the snapshot backup or the backup copy hasn't be completed or pulled from the remote backup target yet
+		if (b.Spec.SnapshotName != "" || b.Spec.SourceBackupName != "") && b.Status.State != longhorn.BackupStateCompleted {
 			if b.Status.State == longhorn.BackupStateError || b.Status.State == longhorn.BackupStateUnknown {
 				// Failed backup `LastSyncedAt` should not be updated after it was marked as `Error` or `Unknown`
 				if failedBackupTTL > 0 && time.Now().After(b.Status.LastSyncedAt.Add(time.Duration(failedBackupTTL)*time.Minute)) {
@@ -129,6 +129,14 @@ func StartControllers(logger logrus.FieldLogger, clients *client.Clients,
 	if err != nil {
 		return nil, err
 	}
+	settingsProfileController, err := NewSettingsProfileController(logger, ds, scheme, kubeClient, namespace, controllerID)
+	if err != nil {
+		return nil, err
+	}
+	alertRuleController, err := NewAlertRuleController(logger, ds, scheme, kubeClient, namespace, controllerID)
+	if err != nil {
+		return nil, err
+	}
 	volumeAttachmentController, err := NewLonghornVolumeAttachmentController(logger, ds, scheme, kubeClient, controllerID, namespace)
 	if err != nil {
 		return nil, err
@@ -207,6 +215,8 @@ func StartControllers(logger logrus.FieldLogger, clients *client.Clients,
 	go supportBundleController.Run(Workers, stopCh)
 	go systemBackupController.Run(Workers, stopCh)
 	go systemRestoreController.Run(Workers, stopCh)
+	go settingsProfileController.Run(Workers, stopCh)
+	go alertRuleController.Run(Workers, stopCh)
 	go volumeAttachmentController.Run(Workers, stopCh)
 	go volumeRestoreController.Run(Workers, stopCh)
 	go volumeRebuildingController.Run(Workers, stopCh)
@@ -262,11 +272,11 @@ func GetInstanceManagerCPURequirement(ds *datastore.DataStore, imName string) (*
 	case longhorn.DataEngineTypeV1:
 		cpuRequest = lhNode.Spec.InstanceManagerCPURequest
 		if cpuRequest == 0 {
-			guaranteedCPUSetting, err := ds.GetSettingWithAutoFillingRO(types.SettingNameGuaranteedInstanceManagerCPU)
+			guaranteedCPUValue, err := ds.GetSettingValueForNode(types.SettingNameGuaranteedInstanceManagerCPU, im.Spec.NodeID)
 			if err != nil {
 				return nil, err
 			}
-			guaranteedCPUPercentage, err := strconv.ParseFloat(guaranteedCPUSetting.Value, 64)
+			guaranteedCPUPercentage, err := strconv.ParseFloat(guaranteedCPUValue, 64)
 			if err != nil {
 				return nil, err
 			}
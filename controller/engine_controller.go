@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 
 	"golang.org/x/time/rate"
 
@@ -44,6 +46,7 @@ import (
 	"github.com/longhorn/longhorn-manager/engineapi"
 	"github.com/longhorn/longhorn-manager/types"
 	"github.com/longhorn/longhorn-manager/util"
+	"github.com/longhorn/longhorn-manager/util/tracing"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
@@ -70,6 +73,9 @@ var (
 	sizeUpdateLimit = 30 * time.Second
 	// number of consecutive actual size updates allowed during bursts
 	sizeUpdateBurst = 3
+
+	// amount of time between the replica IO metrics updates of the replicas
+	replicaIOMetricsUpdateLimit = 30 * time.Second
 )
 
 const (
@@ -131,6 +137,15 @@ type EngineMonitor struct {
 	restoringCounterMutex    *sync.Mutex
 
 	sizeUpdateLimiter *rate.Limiter
+
+	// ioQoS is the IO QoS applied to the running engine. The engine starts without any limit.
+	ioQoS longhorn.VolumeIOQoS
+	// ioQoSFailed is the last IO QoS failed to apply, which is not retried until the IO QoS changes.
+	ioQoSFailed *longhorn.VolumeIOQoS
+
+	replicaIOMetricsUpdateLimiter *rate.Limiter
+	// slowReplicaSince records when each replica started to be slower than the others
+	slowReplicaSince map[string]time.Time
 }
 
 func NewEngineController(
@@ -256,7 +271,15 @@ func (ec *EngineController) getEngineClientProxy(e *longhorn.Engine, image strin
 		return nil, err
 	}
 
-	return engineapi.GetCompatibleClient(e, engineCliClient, ec.ds, ec.logger, ec.proxyConnCounter)
+	engineClientProxy, err := engineapi.GetCompatibleClient(e, engineCliClient, ec.ds, ec.logger, ec.proxyConnCounter)
+	if err != nil {
+		return nil, err
+	}
+	// The fallback engine binary client is not traced
+	if proxy, ok := engineClientProxy.(*engineapi.Proxy); ok {
+		proxy.SetTraceContext(tracing.ContextFromAnnotations(e))
+	}
+	return engineClientProxy, nil
 }
 
 func (ec *EngineController) syncEngine(key string) (err error) {
@@ -444,6 +467,9 @@ func (ec *EngineController) CreateInstance(obj interface{}) (*longhorn.InstanceP
 	if !ok {
 		return nil, fmt.Errorf("invalid object for engine process creation: %v", obj)
 	}
+
+	ctx, span := tracing.StartSpanFromAnnotations(e, "EngineController.CreateInstance", attribute.String("engine", e.Name))
+	defer span.End()
 	if e.Spec.VolumeName == "" || e.Spec.NodeID == "" {
 		return nil, fmt.Errorf("missing parameters for engine instance creation: %v", e)
 	}
@@ -466,6 +492,7 @@ func (ec *EngineController) CreateInstance(obj interface{}) (*longhorn.InstanceP
 			ec.logger.WithError(closeErr).Warn("Failed to close instance manager client")
 		}
 	}(c)
+	c.SetTraceContext(ctx)
 
 	engineReplicaTimeout, err := ec.ds.GetSettingAsInt(types.SettingNameEngineReplicaTimeout)
 	if err != nil {
@@ -513,6 +540,11 @@ func (ec *EngineController) DeleteInstance(obj interface{}) (err error) {
 		return fmt.Errorf("invalid object for engine process deletion: %v", obj)
 	}
 
+	ctx, span := tracing.StartSpanFromAnnotations(e, "EngineController.DeleteInstance", attribute.String("engine", e.Name))
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
 	log := getLoggerForEngine(ec.logger, e)
 	var im *longhorn.InstanceManager
 
@@ -598,6 +630,7 @@ func (ec *EngineController) DeleteInstance(obj interface{}) (err error) {
 			ec.logger.WithError(closeErr).Warn("Failed to close instance manager client")
 		}
 	}(c)
+	c.SetTraceContext(ctx)
 
 	err = c.InstanceDelete(e.Spec.DataEngine, e.Name, string(longhorn.InstanceManagerTypeEngine), "", true)
 	if err != nil && !types.ErrorIsNotFound(err) {
@@ -689,6 +722,9 @@ func (ec *EngineController) startMonitoring(e *longhorn.Engine) {
 		restoringCounter:       ec.restoringCounter,
 		restoringCounterMutex:  ec.restoringCounterMutex,
 		sizeUpdateLimiter:      rate.NewLimiter(rate.Every(sizeUpdateLimit), sizeUpdateBurst),
+
+		replicaIOMetricsUpdateLimiter: rate.NewLimiter(rate.Every(replicaIOMetricsUpdateLimit), 1),
+		slowReplicaSince:              map[string]time.Time{},
 	}
 
 	ec.engineMonitorMutex.Lock()
@@ -798,6 +834,156 @@ func (m *EngineMonitor) sync() bool {
 	return false
 }
 
+// syncIOQoS applies the IO QoS in the engine spec to the running engine. The failure doesn't fail the monitoring,
+// since the engine frontend may not support throttling the IO.
+func (m *EngineMonitor) syncIOQoS(engine *longhorn.Engine, engineClientProxy engineapi.EngineClientProxy) {
+	defer func() {
+		engine.Status.IOQoS = m.ioQoS
+	}()
+
+	if engine.Spec.IOQoS == m.ioQoS {
+		m.ioQoSFailed = nil
+		return
+	}
+	if m.ioQoSFailed != nil && *m.ioQoSFailed == engine.Spec.IOQoS {
+		return
+	}
+
+	if err := engineClientProxy.VolumeIOQoSSet(engine); err != nil {
+		ioQoSFailed := engine.Spec.IOQoS
+		m.ioQoSFailed = &ioQoSFailed
+		m.logger.WithError(err).Warnf("Failed to apply IO QoS %+v", engine.Spec.IOQoS)
+		m.eventRecorder.Eventf(engine, corev1.EventTypeWarning, constant.EventReasonFailed, "Failed to apply IO QoS %+v: %v", engine.Spec.IOQoS, err)
+		return
+	}
+
+	m.logger.Infof("Applied IO QoS %+v", engine.Spec.IOQoS)
+	m.ioQoS = engine.Spec.IOQoS
+	m.ioQoSFailed = nil
+}
+
+// syncReplicaIOMetrics records the IO statistics of each replica observed by the engine in the replica status, and
+// marks the replicas that stay slower than the others for the detection window with the condition Slow. The replicas
+// are updated at most once per replicaIOMetricsUpdateLimit since the statistics keep changing.
+func (m *EngineMonitor) syncReplicaIOMetrics(engine *longhorn.Engine, engineClientProxy engineapi.EngineClientProxy, addressReplicaMap map[string]string) {
+	if !m.replicaIOMetricsUpdateLimiter.Allow() {
+		return
+	}
+
+	replicaMetrics, err := engineClientProxy.ReplicaMetricsGet(engine)
+	if err != nil {
+		m.logger.WithError(err).Debug("Failed to get replica IO metrics")
+		return
+	}
+
+	replicaNameMetrics := map[string]*engineapi.ReplicaMetrics{}
+	for url, metrics := range replicaMetrics {
+		replicaName := addressReplicaMap[engineapi.GetAddressFromBackendReplicaURL(url)]
+		if replicaName == "" {
+			continue
+		}
+		replicaNameMetrics[replicaName] = metrics
+	}
+
+	latencyFactor, err := m.ds.GetSettingAsInt(types.SettingNameSlowReplicaLatencyFactor)
+	if err != nil {
+		m.logger.WithError(err).Warnf("Failed to get %v setting", types.SettingNameSlowReplicaLatencyFactor)
+		return
+	}
+	detectionWindow, err := m.ds.GetSettingAsInt(types.SettingNameSlowReplicaDetectionWindow)
+	if err != nil {
+		m.logger.WithError(err).Warnf("Failed to get %v setting", types.SettingNameSlowReplicaDetectionWindow)
+		return
+	}
+
+	rwReplicaMetrics := map[string]*engineapi.ReplicaMetrics{}
+	for replicaName, metrics := range replicaNameMetrics {
+		if engine.Status.ReplicaModeMap[replicaName] == longhorn.ReplicaModeRW {
+			rwReplicaMetrics[replicaName] = metrics
+		}
+	}
+	slowReplicas := getSlowReplicas(rwReplicaMetrics, latencyFactor)
+	for replicaName := range m.slowReplicaSince {
+		if _, ok := slowReplicas[replicaName]; !ok {
+			delete(m.slowReplicaSince, replicaName)
+		}
+	}
+
+	now := time.Now()
+	for replicaName, metrics := range replicaNameMetrics {
+		replica, err := m.ds.GetReplica(replicaName)
+		if err != nil {
+			m.logger.WithError(err).Debugf("Failed to get replica %v for updating IO metrics", replicaName)
+			continue
+		}
+
+		slowStatus, slowReason, slowMessage := longhorn.ConditionStatusFalse, "", ""
+		if message, isSlow := slowReplicas[replicaName]; isSlow {
+			since, ok := m.slowReplicaSince[replicaName]
+			if !ok {
+				since = now
+				m.slowReplicaSince[replicaName] = now
+			}
+			if now.Sub(since) >= time.Duration(detectionWindow)*time.Second {
+				slowStatus, slowReason, slowMessage = longhorn.ConditionStatusTrue, longhorn.ReplicaConditionReasonSlowHighLatency, message
+			}
+		}
+		if slowStatus == longhorn.ConditionStatusTrue &&
+			types.GetCondition(replica.Status.Conditions, longhorn.ReplicaConditionTypeSlow).Status != longhorn.ConditionStatusTrue {
+			m.logger.Warnf("Detected slow replica %v: %v", replicaName, slowMessage)
+			m.eventRecorder.Eventf(replica, corev1.EventTypeWarning, constant.EventReasonSlow, "Detected slow replica %v: %v", replicaName, slowMessage)
+		}
+		replica.Status.Conditions = types.SetCondition(replica.Status.Conditions, longhorn.ReplicaConditionTypeSlow,
+			slowStatus, slowReason, slowMessage)
+
+		replica.Status.IOMetrics = &longhorn.ReplicaIOMetrics{
+			ReadLatency:       int64(metrics.ReadLatency),
+			WriteLatency:      int64(metrics.WriteLatency),
+			ReadErrorCount:    int64(metrics.ReadErrorCount),
+			WriteErrorCount:   int64(metrics.WriteErrorCount),
+			RebuildThroughput: int64(metrics.RebuildThroughput),
+			LastUpdatedAt:     now.UTC().Format(time.RFC3339),
+		}
+		if _, err := m.ds.UpdateReplicaStatus(replica); err != nil {
+			m.logger.WithError(err).Debugf("Failed to update IO metrics of replica %v", replicaName)
+		}
+	}
+}
+
+// getSlowReplicas returns the replicas whose read or write latency exceeds the median latency of the replicas by the
+// factor, along with the reasons. The lower median is used, so one slow replica of two is detected. A factor not
+// greater than 1 disables the detection.
+func getSlowReplicas(replicaMetrics map[string]*engineapi.ReplicaMetrics, factor int64) map[string]string {
+	slowReplicas := map[string]string{}
+	if factor <= 1 || len(replicaMetrics) < 2 {
+		return slowReplicas
+	}
+
+	check := func(op string, getLatency func(*engineapi.ReplicaMetrics) uint64) {
+		latencies := []uint64{}
+		for _, metrics := range replicaMetrics {
+			latencies = append(latencies, getLatency(metrics))
+		}
+		sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+		median := latencies[(len(latencies)-1)/2]
+		if median == 0 {
+			return
+		}
+		for replicaName, metrics := range replicaMetrics {
+			if latency := getLatency(metrics); latency > median*uint64(factor) {
+				reason := fmt.Sprintf("%v latency %vns exceeds %v times the median %vns", op, latency, factor, median)
+				if slowReplicas[replicaName] != "" {
+					reason = slowReplicas[replicaName] + ", " + reason
+				}
+				slowReplicas[replicaName] = reason
+			}
+		}
+	}
+	check("read", func(metrics *engineapi.ReplicaMetrics) uint64 { return metrics.ReadLatency })
+	check("write", func(metrics *engineapi.ReplicaMetrics) uint64 { return metrics.WriteLatency })
+	return slowReplicas
+}
+
 func (m *EngineMonitor) refresh(engine *longhorn.Engine) error {
 	existingEngine := engine.DeepCopy()
 
@@ -977,6 +1163,9 @@ func (m *EngineMonitor) refresh(engine *longhorn.Engine) error {
 				}
 			}
 		}
+
+		m.syncIOQoS(engine, engineClientProxy)
+		m.syncReplicaIOMetrics(engine, engineClientProxy, addressReplicaMap)
 	} else {
 		// For incompatible running engine, the current size is always `engine.Spec.VolumeSize`.
 		engine.Status.CurrentSize = engine.Spec.VolumeSize
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	etypes "github.com/longhorn/longhorn-engine/pkg/types"
+	"github.com/longhorn/longhorn-manager/engineapi"
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 	"github.com/longhorn/longhorn-manager/util"
 )
@@ -132,3 +133,84 @@ func TestNeedStatusUpdate(t *testing.T) {
 		assert.Equal(tc.expectRateLimited, rateLimited, "rateLimited")
 	}
 }
+
+func TestGetSlowReplicas(t *testing.T) {
+	assert := require.New(t)
+
+	newMetrics := func(readLatency, writeLatency uint64) *engineapi.ReplicaMetrics {
+		return &engineapi.ReplicaMetrics{
+			ReadLatency:  readLatency,
+			WriteLatency: writeLatency,
+		}
+	}
+
+	type testCase struct {
+		replicaMetrics       map[string]*engineapi.ReplicaMetrics
+		factor               int64
+		expectedSlowReplicas []string
+	}
+	tests := map[string]testCase{
+		"one slow replica of three": {
+			replicaMetrics: map[string]*engineapi.ReplicaMetrics{
+				"r1": newMetrics(100, 100),
+				"r2": newMetrics(120, 110),
+				"r3": newMetrics(110, 1000),
+			},
+			factor:               5,
+			expectedSlowReplicas: []string{"r3"},
+		},
+		"one slow replica of two": {
+			replicaMetrics: map[string]*engineapi.ReplicaMetrics{
+				"r1": newMetrics(100, 100),
+				"r2": newMetrics(600, 100),
+			},
+			factor:               5,
+			expectedSlowReplicas: []string{"r2"},
+		},
+		"latency within the factor": {
+			replicaMetrics: map[string]*engineapi.ReplicaMetrics{
+				"r1": newMetrics(100, 100),
+				"r2": newMetrics(120, 110),
+				"r3": newMetrics(500, 500),
+			},
+			factor:               5,
+			expectedSlowReplicas: []string{},
+		},
+		"single replica": {
+			replicaMetrics: map[string]*engineapi.ReplicaMetrics{
+				"r1": newMetrics(100000, 100000),
+			},
+			factor:               5,
+			expectedSlowReplicas: []string{},
+		},
+		"no IO": {
+			replicaMetrics: map[string]*engineapi.ReplicaMetrics{
+				"r1": newMetrics(0, 0),
+				"r2": newMetrics(0, 0),
+				"r3": newMetrics(100, 0),
+			},
+			factor:               5,
+			expectedSlowReplicas: []string{},
+		},
+		"detection disabled": {
+			replicaMetrics: map[string]*engineapi.ReplicaMetrics{
+				"r1": newMetrics(100, 100),
+				"r2": newMetrics(100, 100),
+				"r3": newMetrics(100000, 100000),
+			},
+			factor:               0,
+			expectedSlowReplicas: []string{},
+		},
+	}
+
+	for name, tc := range tests {
+		fmt.Printf("testing %v\n", name)
+		slowReplicas := getSlowReplicas(tc.replicaMetrics, tc.factor)
+		slowReplicaNames := []string{}
+		for replicaName, reason := range slowReplicas {
+			assert.NotEmpty(reason, name)
+			slowReplicaNames = append(slowReplicaNames, replicaName)
+		}
+		assert.ElementsMatch(tc.expectedSlowReplicas, slowReplicaNames, name)
+	}
+}
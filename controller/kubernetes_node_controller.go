@@ -293,7 +293,7 @@ func (knc *KubernetesNodeController) syncDefaultDisks(node *longhorn.Node) (err
 		return nil
 	}
 	val = strings.ToLower(val)
-	storageReservedPercentageForDefaultDisk, err := knc.ds.GetSettingAsInt(types.SettingNameStorageReservedPercentageForDefaultDisk)
+	storageReservedPercentageForDefaultDisk, err := knc.ds.GetSettingAsIntForNode(types.SettingNameStorageReservedPercentageForDefaultDisk, node.Name)
 	if err != nil {
 		return err
 	}
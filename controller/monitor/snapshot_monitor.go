@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -494,10 +495,17 @@ func (m *SnapshotMonitor) syncHashStatusFromEngineReplicas(engine *longhorn.Engi
 	if err != nil {
 		m.eventRecorder.Eventf(engine, corev1.EventTypeWarning, constant.EventReasonFailedSnapshotDataIntegrityCheck,
 			"Failed to check the data integrity of snapshot %v for volume %v", snapshotName, engine.Spec.VolumeName)
+		snapshot.Status.DataIntegrityError = err.Error()
+		if !reflect.DeepEqual(existingSnapshot.Status, snapshot.Status) {
+			if _, updateErr := m.ds.UpdateSnapshotStatus(snapshot); updateErr != nil {
+				m.logger.WithField("monitor", monitorName).WithError(updateErr).Warnf("Failed to update data integrity error for snapshot %v", snapshotName)
+			}
+		}
 		return errors.Wrapf(err, "failed to determine checksum for snapshot %v", snapshotName)
 	}
 
 	snapshot.Status.Checksum = checksum
+	snapshot.Status.DataIntegrityError = getCorruptedReplicasMessage(checksum, hashStatus)
 
 	if !reflect.DeepEqual(existingSnapshot.Status, snapshot.Status) {
 		if _, err := m.ds.UpdateSnapshotStatus(snapshot); err != nil {
@@ -525,6 +533,22 @@ func (m *SnapshotMonitor) kickOutCorruptedReplicas(engine *longhorn.Engine, engi
 	}
 }
 
+// getCorruptedReplicasMessage returns the message about the replicas whose checksum differs from the determined one,
+// or an empty string if there is none.
+func getCorruptedReplicasMessage(checksum string, hashStatus map[string]*longhorn.HashStatus) string {
+	addresses := []string{}
+	for address, status := range hashStatus {
+		if status.Checksum != checksum {
+			addresses = append(addresses, address)
+		}
+	}
+	if len(addresses) == 0 {
+		return ""
+	}
+	sort.Strings(addresses)
+	return fmt.Sprintf("detected corrupted replicas %v", strings.Join(addresses, ", "))
+}
+
 func determineChecksumFromHashStatus(log logrus.FieldLogger, snapshotName, existingChecksum string, hashStatus map[string]*longhorn.HashStatus) (string, error) {
 	checksum := ""
 	defer func() {
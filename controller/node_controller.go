@@ -846,10 +846,6 @@ func (nc *NodeController) updateDiskStatusSchedulableCondition(node *longhorn.No
 	diskStatusMap := node.Status.DiskStatus
 
 	// update Schedulable condition
-	minimalAvailablePercentage, err := nc.ds.GetSettingAsInt(types.SettingNameStorageMinimalAvailablePercentage)
-	if err != nil {
-		return err
-	}
 	backingImages, err := nc.ds.ListBackingImagesRO()
 	if err != nil {
 		return err
@@ -918,7 +914,7 @@ func (nc *NodeController) updateDiskStatusSchedulableCondition(node *longhorn.No
 			diskStatus.ScheduledReplica = scheduledReplica
 			diskStatus.ScheduledBackingImage = scheduledBackingImage
 			// check disk pressure
-			info, err := nc.scheduler.GetDiskSchedulingInfo(disk, diskStatus)
+			info, err := nc.scheduler.GetDiskSchedulingInfo(node, disk, diskStatus)
 			if err != nil {
 				return err
 			}
@@ -927,7 +923,7 @@ func (nc *NodeController) updateDiskStatusSchedulableCondition(node *longhorn.No
 					longhorn.DiskConditionTypeSchedulable, longhorn.ConditionStatusFalse,
 					string(longhorn.DiskConditionReasonDiskPressure),
 					fmt.Sprintf("Disk %v (%v) on the node %v has %v available, but requires reserved %v, minimal %v%s to schedule more replicas",
-						diskName, disk.Path, node.Name, diskStatus.StorageAvailable, disk.StorageReserved, minimalAvailablePercentage, "%"),
+						diskName, disk.Path, node.Name, diskStatus.StorageAvailable, disk.StorageReserved, info.MinimalAvailablePercentage, "%"),
 					nc.eventRecorder, node, corev1.EventTypeWarning)
 			} else {
 				diskStatus.Conditions = types.SetConditionAndRecord(diskStatus.Conditions,
@@ -1676,6 +1672,10 @@ func (nc *NodeController) syncReplicaEvictionRequested(node *longhorn.Node, kube
 	if err != nil {
 		return errors.Wrapf(err, "failed to get %v setting", types.SettingNameNodeDrainPolicy)
 	}
+	slowReplicaAutoReplacement, err := nc.ds.GetSettingAsBool(types.SettingNameSlowReplicaAutoReplacement)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get %v setting", types.SettingNameSlowReplicaAutoReplacement)
+	}
 
 	type replicaToSync struct {
 		*longhorn.Replica
@@ -1691,7 +1691,7 @@ func (nc *NodeController) syncReplicaEvictionRequested(node *longhorn.Node, kube
 				return err
 			}
 			shouldEvictReplica, reason, err := nc.shouldEvictReplica(node, kubeNode, &diskSpec, replica,
-				nodeDrainPolicy)
+				nodeDrainPolicy, slowReplicaAutoReplacement)
 			if err != nil {
 				return err
 			}
@@ -1700,8 +1700,10 @@ func (nc *NodeController) syncReplicaEvictionRequested(node *longhorn.Node, kube
 				replicasToSync = append(replicasToSync, replicaToSync{replica, reason})
 			}
 
-			if replica.Spec.EvictionRequested && !node.Spec.EvictionRequested && !diskSpec.EvictionRequested {
-				// We don't consider the node to be auto evicting if eviction was manually requested.
+			if replica.Spec.EvictionRequested && !node.Spec.EvictionRequested && !diskSpec.EvictionRequested &&
+				reason != constant.EventReasonEvictionSlowReplica {
+				// We don't consider the node to be auto evicting if eviction was manually requested or the replica
+				// is replaced for being slow.
 				node.Status.AutoEvicting = true
 			}
 		}
@@ -1732,7 +1734,7 @@ func (nc *NodeController) syncReplicaEvictionRequested(node *longhorn.Node, kube
 }
 
 func (nc *NodeController) shouldEvictReplica(node *longhorn.Node, kubeNode *corev1.Node, diskSpec *longhorn.DiskSpec,
-	replica *longhorn.Replica, nodeDrainPolicy string) (bool, string, error) {
+	replica *longhorn.Replica, nodeDrainPolicy string, slowReplicaAutoReplacement bool) (bool, string, error) {
 	// Replica eviction was cancelled on down or deleted nodes in previous implementations. It seems safest to continue
 	// this behavior unless we find a reason to change it.
 	if isDownOrDeleted, err := nc.ds.IsNodeDownOrDeleted(node.Spec.Name); err != nil {
@@ -1747,6 +1749,11 @@ func (nc *NodeController) shouldEvictReplica(node *longhorn.Node, kubeNode *core
 	if node.Spec.EvictionRequested || diskSpec.EvictionRequested {
 		return true, constant.EventReasonEvictionUserRequested, nil
 	}
+	if slowReplicaAutoReplacement && replica.Spec.FailedAt == "" &&
+		types.GetCondition(replica.Status.Conditions, longhorn.ReplicaConditionTypeSlow).Status == longhorn.ConditionStatusTrue {
+		// The volume controller replaces the slow replica in the same way as the eviction.
+		return true, constant.EventReasonEvictionSlowReplica, nil
+	}
 	if !kubeNode.Spec.Unschedulable {
 		// Node drain policy only takes effect on cordoned nodes.
 		return false, constant.EventReasonEvictionCanceled, nil
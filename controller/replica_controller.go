@@ -13,6 +13,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -33,6 +34,7 @@ import (
 	"github.com/longhorn/longhorn-manager/datastore"
 	"github.com/longhorn/longhorn-manager/engineapi"
 	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util/tracing"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
@@ -317,6 +319,9 @@ func (rc *ReplicaController) CreateInstance(obj interface{}) (*longhorn.Instance
 		return nil, fmt.Errorf("invalid object for replica instance creation: %v", obj)
 	}
 
+	ctx, span := tracing.StartSpanFromAnnotations(r, "ReplicaController.CreateInstance", attribute.String("replica", r.Name))
+	defer span.End()
+
 	dataPath := types.GetReplicaDataPath(r.Spec.DiskPath, r.Spec.DataDirectoryName)
 	if r.Spec.NodeID == "" || dataPath == "" || r.Spec.DiskID == "" || r.Spec.VolumeSize == 0 {
 		return nil, fmt.Errorf("missing parameters for replica instance creation: %v", r)
@@ -364,6 +369,7 @@ func (rc *ReplicaController) CreateInstance(obj interface{}) (*longhorn.Instance
 			rc.logger.WithError(closeErr).Warn("Failed to close instance manager client")
 		}
 	}(c)
+	c.SetTraceContext(ctx)
 
 	v, err := rc.ds.GetVolumeRO(r.Spec.VolumeName)
 	if err != nil {
@@ -453,7 +459,7 @@ func IsRebuildingReplica(r *longhorn.Replica) bool {
 func (rc *ReplicaController) CanStartRebuildingReplica(r *longhorn.Replica) (bool, error) {
 	log := getLoggerForReplica(rc.logger, r)
 
-	concurrentRebuildingLimit, err := rc.ds.GetSettingAsInt(types.SettingNameConcurrentReplicaRebuildPerNodeLimit)
+	concurrentRebuildingLimit, err := rc.ds.GetSettingAsIntForNode(types.SettingNameConcurrentReplicaRebuildPerNodeLimit, r.Spec.NodeID)
 	if err != nil {
 		return false, err
 	}
@@ -519,6 +525,11 @@ func (rc *ReplicaController) DeleteInstance(obj interface{}) (err error) {
 	if !ok {
 		return fmt.Errorf("invalid object for replica instance deletion: %v", obj)
 	}
+
+	ctx, span := tracing.StartSpanFromAnnotations(r, "ReplicaController.DeleteInstance", attribute.String("replica", r.Name))
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
 	log := getLoggerForReplica(rc.logger, r)
 
 	var im *longhorn.InstanceManager
@@ -576,6 +587,7 @@ func (rc *ReplicaController) DeleteInstance(obj interface{}) (err error) {
 			rc.logger.WithError(closeErr).Warn("Failed to close instance manager client")
 		}
 	}(c)
+	c.SetTraceContext(ctx)
 
 	// No need to delete the instance if the replica is backed by a SPDK lvol
 	var cleanupRequired = false
@@ -1,12 +1,9 @@
 package controller
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 	"reflect"
 	"slices"
 	"sort"
@@ -48,6 +45,9 @@ import (
 const (
 	VersionTagLatest = "latest"
 	VersionTagStable = "stable"
+
+	// The number of recent value changes kept in the setting history
+	settingHistoryLimit = 20
 )
 
 var (
@@ -78,6 +78,19 @@ type Version struct {
 	Name        string // must be in semantic versioning
 	ReleaseDate string
 	Tags        []string
+	// The minimal version that can be upgraded to this version directly
+	MinUpgradableVersion string `json:",omitempty"`
+	// The minimal engine CLI API version supported by this version
+	MinEngineCLIAPIVersion int `json:",omitempty"`
+}
+
+// Advisory is a known issue of the Longhorn versions
+type Advisory struct {
+	ID               string
+	Summary          string
+	AffectedVersions []string
+	FixedVersion     string `json:",omitempty"`
+	URL              string `json:",omitempty"`
 }
 
 type CheckUpgradeRequest struct {
@@ -91,7 +104,8 @@ type CheckUpgradeExtraInfo interface {
 }
 
 type CheckUpgradeResponse struct {
-	Versions []Version `json:"versions"`
+	Versions   []Version  `json:"versions"`
+	Advisories []Advisory `json:"advisories,omitempty"`
 }
 
 func NewSettingController(
@@ -141,6 +155,15 @@ func NewSettingController(
 	}
 	sc.cacheSyncs = append(sc.cacheSyncs, ds.NodeInformer.HasSynced)
 
+	if _, err = ds.ConfigMapInformer.AddEventHandlerWithResyncPeriod(cache.ResourceEventHandlerFuncs{
+		AddFunc:    sc.enqueueSettingForUpgradeManifest,
+		UpdateFunc: func(old, cur interface{}) { sc.enqueueSettingForUpgradeManifest(cur) },
+		DeleteFunc: sc.enqueueSettingForUpgradeManifest,
+	}, 0); err != nil {
+		return nil, err
+	}
+	sc.cacheSyncs = append(sc.cacheSyncs, ds.ConfigMapInformer.HasSynced)
+
 	return sc, nil
 }
 
@@ -220,7 +243,8 @@ func (sc *SettingController) syncSetting(key string) (err error) {
 		} else if err != nil {
 			setting.Status.Applied = false
 		}
-		if setting.Status.Applied != existingApplied {
+		historyRecorded := recordSettingHistory(setting)
+		if setting.Status.Applied != existingApplied || historyRecorded {
 			if _, dsErr := sc.ds.UpdateSettingStatus(setting); dsErr != nil {
 				sc.logger.WithError(dsErr).Warnf("Failed to update setting: %v", name)
 			}
@@ -234,9 +258,41 @@ func (sc *SettingController) syncSetting(key string) (err error) {
 	return sc.syncDangerZoneSettingsForManagedComponents(types.SettingName(name))
 }
 
+// recordSettingHistory appends the value change noted by the setting mutator to the setting history. Only the latest
+// change is recorded if the setting is changed multiple times before it is synced.
+func recordSettingHistory(setting *longhorn.Setting) bool {
+	revision, err := strconv.ParseInt(setting.Annotations[types.GetLonghornLabelKey(types.SettingRevisionAnnotationKeySuffix)], 10, 64)
+	if err != nil {
+		return false
+	}
+
+	history := setting.Status.History
+	if len(history) > 0 && history[len(history)-1].Revision >= revision {
+		return false
+	}
+
+	changedAt, err := time.Parse(time.RFC3339, setting.Annotations[types.GetLonghornLabelKey(types.SettingChangedAtAnnotationKeySuffix)])
+	if err != nil {
+		changedAt = time.Now().UTC()
+	}
+
+	history = append(history, longhorn.SettingHistoryEntry{
+		Revision:  revision,
+		OldValue:  setting.Annotations[types.GetLonghornLabelKey(types.SettingPreviousValueAnnotationKeySuffix)],
+		NewValue:  setting.Value,
+		Actor:     setting.Annotations[types.GetLonghornLabelKey(types.SettingChangedByAnnotationKeySuffix)],
+		Timestamp: metav1.NewTime(changedAt),
+	})
+	if len(history) > settingHistoryLimit {
+		history = history[len(history)-settingHistoryLimit:]
+	}
+	setting.Status.History = history
+	return true
+}
+
 func (sc *SettingController) syncNonDangerZoneSettingsForManagedComponents(settingName types.SettingName) error {
 	switch settingName {
-	case types.SettingNameUpgradeChecker:
+	case types.SettingNameUpgradeChecker, types.SettingNameUpgradeCheckerBackend, types.SettingNameUpgradeCheckerLocalManifest:
 		if err := sc.syncUpgradeChecker(); err != nil {
 			return err
 		}
@@ -1091,27 +1147,50 @@ func (sc *SettingController) syncUpgradeChecker() error {
 				return err
 			}
 		}
+		if err := sc.updateUpgradeCheckerConditions(nil, ""); err != nil {
+			return err
+		}
 		// reset timestamp so it can be triggered immediately when
 		// setting changes next time
 		sc.lastUpgradeCheckedTimestamp = time.Time{}
 		return nil
 	}
 
+	backend, err := sc.getUpgradeCheckerBackend()
+	if err != nil {
+		// non-critical error, don't retry
+		sc.logger.WithError(err).Warn("Failed to get the upgrade checker backend")
+		return nil
+	}
+
 	now := time.Now()
-	if now.Before(sc.lastUpgradeCheckedTimestamp.Add(upgradeCheckInterval)) {
+	if _, isLocal := backend.(*localManifestUpgradeCheckerBackend); isLocal {
+		// The local manifest is cheap to read, so it is always checked. Reset the timestamp so the upgrade responder
+		// is queried immediately when the backend is switched back.
+		sc.lastUpgradeCheckedTimestamp = time.Time{}
+	} else if now.Before(sc.lastUpgradeCheckedTimestamp.Add(upgradeCheckInterval)) {
+		return nil
+	}
+
+	resp, err := sc.checkUpgrade(backend)
+	if err != nil {
+		// non-critical error, don't retry
+		sc.logger.WithError(err).Warn("Failed to check for the latest and stable Longhorn versions")
 		return nil
 	}
 
 	currentLatestVersion := latestLonghornVersion.Value
 	currentStableVersions := stableLonghornVersions.Value
-	latestLonghornVersion.Value, stableLonghornVersions.Value, err = sc.CheckLatestAndStableLonghornVersions()
+	latestLonghornVersion.Value, stableLonghornVersions.Value, err = getLatestAndStableLonghornVersions(resp)
 	if err != nil {
 		// non-critical error, don't retry
 		sc.logger.WithError(err).Warn("Failed to check for the latest and stable Longhorn versions")
 		return nil
 	}
 
-	sc.lastUpgradeCheckedTimestamp = now
+	if _, isLocal := backend.(*localManifestUpgradeCheckerBackend); !isLocal {
+		sc.lastUpgradeCheckedTimestamp = now
+	}
 
 	if latestLonghornVersion.Value != currentLatestVersion {
 		sc.logger.Infof("Latest Longhorn version is %v", latestLonghornVersion.Value)
@@ -1130,61 +1209,33 @@ func (sc *SettingController) syncUpgradeChecker() error {
 		}
 	}
 
-	return nil
+	return sc.updateUpgradeCheckerConditions(resp, latestLonghornVersion.Value)
 }
 
-func (sc *SettingController) CheckLatestAndStableLonghornVersions() (string, string, error) {
-	var (
-		resp    CheckUpgradeResponse
-		content bytes.Buffer
-	)
-
-	extraTagInfo, extraFieldInfo, err := sc.GetCheckUpgradeRequestExtraInfo()
-	if err != nil {
-		return "", "", errors.Wrap(err, "failed to get extra info for upgrade checker")
-	}
-
+// checkUpgrade sends the cluster info to the upgrade checker backend and returns the available versions and advisories.
+func (sc *SettingController) checkUpgrade(backend upgradeCheckerBackend) (*CheckUpgradeResponse, error) {
 	version := sc.version
 	if strings.Contains(version, "dev") {
 		version = "dev"
 	}
 	req := &CheckUpgradeRequest{
-		AppVersion:     version,
-		ExtraTagInfo:   extraTagInfo,
-		ExtraFieldInfo: extraFieldInfo,
-	}
-	if err := json.NewEncoder(&content).Encode(req); err != nil {
-		return "", "", err
+		AppVersion: version,
 	}
 
-	upgradeResponderURL, err := sc.ds.GetSettingValueExisted(types.SettingNameUpgradeResponderURL)
-	if err != nil {
-		return "", "", err
-	}
-
-	r, err := http.Post(upgradeResponderURL, "application/json", &content)
-	if err != nil {
-		return "", "", err
-	}
-	defer func(body io.ReadCloser) {
-		if closeErr := body.Close(); closeErr != nil {
-			sc.logger.WithError(closeErr).Warn("Failed to close upgrade responder response body")
-		}
-	}(r.Body)
-	if r.StatusCode != http.StatusOK {
-		message := ""
-		messageBytes, err := io.ReadAll(r.Body)
+	// The cluster info is only sent to the upgrade responder
+	if _, isLocal := backend.(*localManifestUpgradeCheckerBackend); !isLocal {
+		extraTagInfo, extraFieldInfo, err := sc.GetCheckUpgradeRequestExtraInfo()
 		if err != nil {
-			message = err.Error()
-		} else {
-			message = string(messageBytes)
+			return nil, errors.Wrap(err, "failed to get extra info for upgrade checker")
 		}
-		return "", "", fmt.Errorf("query return status code %v, message %v", r.StatusCode, message)
-	}
-	if err := json.NewDecoder(r.Body).Decode(&resp); err != nil {
-		return "", "", err
+		req.ExtraTagInfo = extraTagInfo
+		req.ExtraFieldInfo = extraFieldInfo
 	}
 
+	return backend.CheckUpgrade(req)
+}
+
+func getLatestAndStableLonghornVersions(resp *CheckUpgradeResponse) (string, string, error) {
 	latestVersion := ""
 	stableVersions := []string{}
 	for _, v := range resp.Versions {
@@ -1198,7 +1249,7 @@ func (sc *SettingController) CheckLatestAndStableLonghornVersions() (string, str
 		}
 	}
 	if latestVersion == "" {
-		return "", "", fmt.Errorf("failed to find latest Longhorn version during CheckLatestAndStableLonghornVersions")
+		return "", "", fmt.Errorf("failed to find latest Longhorn version in the upgrade check response")
 	}
 	sort.Strings(stableVersions)
 	return latestVersion, strings.Join(stableVersions, ","), nil
@@ -1223,6 +1274,33 @@ func (sc *SettingController) enqueueSettingForNode(obj interface{}) {
 	sc.queue.Add(sc.namespace + "/" + string(types.SettingNameGuaranteedInstanceManagerCPU))
 }
 
+func (sc *SettingController) enqueueSettingForUpgradeManifest(obj interface{}) {
+	configMap, ok := obj.(*corev1.ConfigMap)
+	if !ok {
+		deletedState, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			return
+		}
+		if configMap, ok = deletedState.Obj.(*corev1.ConfigMap); !ok {
+			return
+		}
+	}
+	if configMap.Namespace != sc.namespace {
+		return
+	}
+
+	manifest, err := sc.ds.GetSettingValueExisted(types.SettingNameUpgradeCheckerLocalManifest)
+	if err != nil || manifest == "" {
+		return
+	}
+	configMapName, _, err := types.ParseUpgradeCheckerLocalManifest(manifest)
+	if err != nil || configMapName != configMap.Name {
+		return
+	}
+
+	sc.queue.Add(sc.namespace + "/" + string(types.SettingNameUpgradeChecker))
+}
+
 // updateInstanceManagerCPURequest deletes all instance manager pods immediately with the updated CPU request.
 func (sc *SettingController) updateInstanceManagerCPURequest(dataEngine longhorn.DataEngineType) error {
 	settingName := types.SettingNameGuaranteedInstanceManagerCPU
@@ -0,0 +1,54 @@
+package controller
+
+import (
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/longhorn/longhorn-manager/types"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestRecordSettingHistory(c *C) {
+	setting := &longhorn.Setting{
+		ObjectMeta: metav1.ObjectMeta{Name: string(types.SettingNameBackupstorePollInterval)},
+		Value:      "300",
+	}
+
+	// No change noted by the setting mutator
+	c.Assert(recordSettingHistory(setting), Equals, false)
+
+	changeSetting := func(revision int64, value, previousValue string) {
+		setting.Value = value
+		setting.Annotations = map[string]string{
+			types.GetLonghornLabelKey(types.SettingRevisionAnnotationKeySuffix):      strconv.FormatInt(revision, 10),
+			types.GetLonghornLabelKey(types.SettingChangedByAnnotationKeySuffix):     "admin",
+			types.GetLonghornLabelKey(types.SettingChangedAtAnnotationKeySuffix):     "2024-01-02T03:04:05Z",
+			types.GetLonghornLabelKey(types.SettingPreviousValueAnnotationKeySuffix): previousValue,
+		}
+	}
+
+	changeSetting(1, "600", "300")
+	c.Assert(recordSettingHistory(setting), Equals, true)
+	c.Assert(setting.Status.History, HasLen, 1)
+	c.Assert(setting.Status.History[0].Revision, Equals, int64(1))
+	c.Assert(setting.Status.History[0].OldValue, Equals, "300")
+	c.Assert(setting.Status.History[0].NewValue, Equals, "600")
+	c.Assert(setting.Status.History[0].Actor, Equals, "admin")
+	c.Assert(setting.Status.History[0].Timestamp.UTC().Format("2006-01-02T15:04:05Z"), Equals, "2024-01-02T03:04:05Z")
+
+	// The change is recorded only once
+	c.Assert(recordSettingHistory(setting), Equals, false)
+	c.Assert(setting.Status.History, HasLen, 1)
+
+	for revision := int64(2); revision <= settingHistoryLimit+5; revision++ {
+		changeSetting(revision, strconv.FormatInt(revision, 10), setting.Value)
+		c.Assert(recordSettingHistory(setting), Equals, true)
+	}
+	c.Assert(setting.Status.History, HasLen, settingHistoryLimit)
+	c.Assert(setting.Status.History[0].Revision, Equals, int64(6))
+	c.Assert(setting.Status.History[settingHistoryLimit-1].Revision, Equals, int64(settingHistoryLimit+5))
+}
@@ -0,0 +1,328 @@
+package controller
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/kubernetes/pkg/controller"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientset "k8s.io/client-go/kubernetes"
+	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/longhorn/longhorn-manager/constant"
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+const (
+	SettingsProfileControllerName = "longhorn-settings-profile"
+)
+
+type SettingsProfileController struct {
+	*baseController
+
+	// which namespace controller is running with
+	namespace string
+	// use as the OwnerID of the controller
+	controllerID string
+
+	kubeClient    clientset.Interface
+	eventRecorder record.EventRecorder
+
+	ds *datastore.DataStore
+
+	cacheSyncs []cache.InformerSynced
+}
+
+func NewSettingsProfileController(
+	logger logrus.FieldLogger,
+	ds *datastore.DataStore,
+	scheme *runtime.Scheme,
+	kubeClient clientset.Interface,
+	namespace string,
+	controllerID string) (*SettingsProfileController, error) {
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(logrus.Infof)
+	// TODO: remove the wrapper when every clients have moved to use the clientset.
+	eventBroadcaster.StartRecordingToSink(&v1core.EventSinkImpl{
+		Interface: v1core.New(kubeClient.CoreV1().RESTClient()).Events(""),
+	})
+
+	c := &SettingsProfileController{
+		baseController: newBaseController(SettingsProfileControllerName, logger),
+
+		namespace:    namespace,
+		controllerID: controllerID,
+
+		ds: ds,
+
+		kubeClient:    kubeClient,
+		eventRecorder: eventBroadcaster.NewRecorder(scheme, corev1.EventSource{Component: SettingsProfileControllerName + "-controller"}),
+	}
+
+	var err error
+	if _, err = ds.SettingsProfileInformer.AddEventHandlerWithResyncPeriod(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueSettingsProfile,
+		UpdateFunc: func(old, cur interface{}) { c.enqueueSettingsProfile(cur) },
+		DeleteFunc: c.enqueueSettingsProfile,
+	}, 0); err != nil {
+		return nil, err
+	}
+	c.cacheSyncs = append(c.cacheSyncs, ds.SettingsProfileInformer.HasSynced)
+
+	// The settings modified outside the profiles are reported as drift
+	if _, err = ds.SettingInformer.AddEventHandlerWithResyncPeriod(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(old, cur interface{}) { c.enqueueSettingsProfilesForSetting(cur) },
+	}, 0); err != nil {
+		return nil, err
+	}
+	c.cacheSyncs = append(c.cacheSyncs, ds.SettingInformer.HasSynced)
+
+	return c, nil
+}
+
+func (c *SettingsProfileController) enqueueSettingsProfile(obj interface{}) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("couldn't get key for object %#v: %v", obj, err))
+		return
+	}
+
+	c.queue.Add(key)
+}
+
+func (c *SettingsProfileController) enqueueSettingsProfilesForSetting(obj interface{}) {
+	setting, ok := obj.(*longhorn.Setting)
+	if !ok {
+		utilruntime.HandleError(fmt.Errorf("received unexpected obj: %#v", obj))
+		return
+	}
+
+	settingsProfiles, err := c.ds.ListSettingsProfiles()
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to list settings profiles for setting %v: %v", setting.Name, err))
+		return
+	}
+
+	for _, settingsProfile := range settingsProfiles {
+		if _, ok := settingsProfile.Spec.Settings[setting.Name]; ok {
+			c.enqueueSettingsProfile(settingsProfile)
+		}
+	}
+}
+
+func (c *SettingsProfileController) Run(workers int, stopCh <-chan struct{}) {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	c.logger.Info("Starting Longhorn SettingsProfile controller")
+	defer c.logger.Info("Shut down Longhorn SettingsProfile controller")
+
+	if !cache.WaitForNamedCacheSync(c.name, stopCh, c.cacheSyncs...) {
+		return
+	}
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+	<-stopCh
+}
+
+func (c *SettingsProfileController) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *SettingsProfileController) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	err := c.syncSettingsProfile(key.(string))
+	c.handleErr(err, key)
+
+	return true
+}
+
+func (c *SettingsProfileController) handleErr(err error, key interface{}) {
+	if err == nil {
+		c.queue.Forget(key)
+		return
+	}
+
+	log := c.logger.WithField("SettingsProfile", key)
+
+	if c.queue.NumRequeues(key) < maxRetries {
+		handleReconcileErrorLogging(log, err, "Failed to sync SettingsProfile")
+		c.queue.AddRateLimited(key)
+		return
+	}
+
+	utilruntime.HandleError(err)
+	handleReconcileErrorLogging(log, err, "Dropping Longhorn SettingsProfile out of the queue")
+	c.queue.Forget(key)
+}
+
+func getLoggerForSettingsProfile(logger logrus.FieldLogger, settingsProfile *longhorn.SettingsProfile) *logrus.Entry {
+	return logger.WithField("settingsProfile", settingsProfile.Name)
+}
+
+func (c *SettingsProfileController) syncSettingsProfile(key string) (err error) {
+	defer func() {
+		err = errors.Wrapf(err, "%v: fail to sync SettingsProfile %v", c.name, key)
+	}()
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	if namespace != c.namespace {
+		return nil
+	}
+
+	return c.reconcile(name)
+}
+
+func (c *SettingsProfileController) reconcile(name string) (err error) {
+	settingsProfile, err := c.ds.GetSettingsProfile(name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	log := getLoggerForSettingsProfile(c.logger, settingsProfile)
+
+	if !c.isResponsibleFor(settingsProfile) {
+		return nil
+	}
+
+	if settingsProfile.Status.OwnerID != c.controllerID {
+		settingsProfile.Status.OwnerID = c.controllerID
+		settingsProfile, err = c.ds.UpdateSettingsProfileStatus(settingsProfile)
+		if err != nil {
+			// we don't mind others coming first
+			if apierrors.IsConflict(errors.Cause(err)) {
+				return nil
+			}
+			return err
+		}
+		log.Infof("Settings profile got new owner %v", c.controllerID)
+	}
+
+	if !settingsProfile.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	existingSettingsProfile := settingsProfile.DeepCopy()
+	defer func() {
+		if reflect.DeepEqual(existingSettingsProfile.Status, settingsProfile.Status) {
+			return
+		}
+		if _, updateErr := c.ds.UpdateSettingsProfileStatus(settingsProfile); updateErr != nil {
+			log.WithError(updateErr).Debugf("Requeue %v due to error", settingsProfile.Name)
+			c.enqueueSettingsProfile(settingsProfile)
+		}
+	}()
+
+	if settingsProfile.Generation != settingsProfile.Status.AppliedGeneration {
+		if err := c.applySettingsProfile(settingsProfile); err != nil {
+			c.eventRecorder.Eventf(settingsProfile, corev1.EventTypeWarning, constant.EventReasonFailed, "Failed to apply settings profile: %v", err)
+			return err
+		}
+		log.Info("Applied settings profile")
+		c.eventRecorder.Event(settingsProfile, corev1.EventTypeNormal, constant.EventReasonSynced, "Applied settings profile")
+		// The drift is checked in the next round triggered by the status update, since the setting cache
+		// may not catch up with the update yet
+		settingsProfile.Status.State = longhorn.SettingsProfileStateApplied
+		settingsProfile.Status.DriftedSettings = nil
+		return nil
+	}
+
+	drifted, err := c.getDriftedSettings(settingsProfile)
+	if err != nil {
+		return err
+	}
+	if len(drifted) > 0 && settingsProfile.Status.State != longhorn.SettingsProfileStateDrifted {
+		log.Warnf("Settings %+v are modified outside the settings profile", drifted)
+		c.eventRecorder.Eventf(settingsProfile, corev1.EventTypeWarning, constant.EventReasonDrifted, "Settings are modified outside the settings profile: %+v", drifted)
+	}
+	settingsProfile.Status.DriftedSettings = drifted
+	if len(drifted) > 0 {
+		settingsProfile.Status.State = longhorn.SettingsProfileStateDrifted
+	} else {
+		settingsProfile.Status.State = longhorn.SettingsProfileStateApplied
+	}
+
+	return nil
+}
+
+// applySettingsProfile applies all settings in the profile together and records the result in the status.
+func (c *SettingsProfileController) applySettingsProfile(settingsProfile *longhorn.SettingsProfile) error {
+	values := map[types.SettingName]string{}
+	for name, value := range settingsProfile.Spec.Settings {
+		values[types.SettingName(name)] = value
+	}
+
+	if err := c.ds.UpdateSettings(values); err != nil {
+		settingsProfile.Status.State = longhorn.SettingsProfileStateError
+		settingsProfile.Status.Conditions = types.SetCondition(settingsProfile.Status.Conditions,
+			longhorn.SettingsProfileConditionTypeApplied, longhorn.ConditionStatusFalse,
+			longhorn.SettingsProfileConditionReasonApplyFailed, err.Error())
+		return err
+	}
+
+	settingsProfile.Status.AppliedGeneration = settingsProfile.Generation
+	settingsProfile.Status.LastAppliedAt = metav1.Time{Time: time.Now().UTC()}
+	settingsProfile.Status.Conditions = types.SetCondition(settingsProfile.Status.Conditions,
+		longhorn.SettingsProfileConditionTypeApplied, longhorn.ConditionStatusTrue, "", "")
+	return nil
+}
+
+// getDriftedSettings returns the settings whose current values differ from the profile, sorted by name.
+func (c *SettingsProfileController) getDriftedSettings(settingsProfile *longhorn.SettingsProfile) ([]longhorn.SettingsProfileDrift, error) {
+	drifted := []longhorn.SettingsProfileDrift{}
+	for name, value := range settingsProfile.Spec.Settings {
+		setting, err := c.ds.GetSettingWithAutoFillingRO(types.SettingName(name))
+		if err != nil {
+			return nil, err
+		}
+		if setting.Value == value {
+			continue
+		}
+		drifted = append(drifted, longhorn.SettingsProfileDrift{
+			Name:          name,
+			ExpectedValue: value,
+			ActualValue:   setting.Value,
+		})
+	}
+	sort.Slice(drifted, func(i, j int) bool { return drifted[i].Name < drifted[j].Name })
+
+	if len(drifted) == 0 {
+		return nil, nil
+	}
+	return drifted, nil
+}
+
+func (c *SettingsProfileController) isResponsibleFor(settingsProfile *longhorn.SettingsProfile) bool {
+	return isControllerResponsibleFor(c.controllerID, c.ds, settingsProfile.Name, "", settingsProfile.Status.OwnerID)
+}
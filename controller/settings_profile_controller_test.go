@@ -0,0 +1,196 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/kubernetes/pkg/controller"
+
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	lhfake "github.com/longhorn/longhorn-manager/k8s/pkg/client/clientset/versioned/fake"
+
+	. "gopkg.in/check.v1"
+)
+
+type SettingsProfileTestCase struct {
+	settings        map[types.SettingName]string
+	profileSettings map[string]string
+	// The setting modified outside the profile after the profile is applied
+	driftedSettings map[types.SettingName]string
+
+	expectedState           longhorn.SettingsProfileState
+	expectedSettings        map[types.SettingName]string
+	expectedDriftedSettings []longhorn.SettingsProfileDrift
+}
+
+func newTestSettingsProfileController(lhClient *lhfake.Clientset, kubeClient *fake.Clientset, extensionsClient *apiextensionsfake.Clientset, informerFactories *util.InformerFactories) (*SettingsProfileController, error) {
+	ds := datastore.NewDataStore(TestNamespace, lhClient, kubeClient, extensionsClient, informerFactories)
+
+	logger := logrus.StandardLogger()
+	c, err := NewSettingsProfileController(logger, ds, scheme.Scheme, kubeClient, TestNamespace, TestNode1)
+	if err != nil {
+		return nil, err
+	}
+
+	c.eventRecorder = record.NewFakeRecorder(100)
+	for index := range c.cacheSyncs {
+		c.cacheSyncs[index] = alwaysReady
+	}
+
+	return c, nil
+}
+
+func (s *TestSuite) TestReconcileSettingsProfile(c *C) {
+	testCases := map[string]SettingsProfileTestCase{
+		"apply settings": {
+			settings: map[types.SettingName]string{
+				types.SettingNameSnapshotMaxCount:                  "250",
+				types.SettingNameStorageMinimalAvailablePercentage: "25",
+			},
+			profileSettings: map[string]string{
+				string(types.SettingNameSnapshotMaxCount):                  "100",
+				string(types.SettingNameStorageMinimalAvailablePercentage): "20",
+			},
+			expectedState: longhorn.SettingsProfileStateApplied,
+			expectedSettings: map[types.SettingName]string{
+				types.SettingNameSnapshotMaxCount:                  "100",
+				types.SettingNameStorageMinimalAvailablePercentage: "20",
+			},
+		},
+		"report drift": {
+			settings: map[types.SettingName]string{
+				types.SettingNameSnapshotMaxCount: "250",
+			},
+			profileSettings: map[string]string{
+				string(types.SettingNameSnapshotMaxCount): "100",
+			},
+			driftedSettings: map[types.SettingName]string{
+				types.SettingNameSnapshotMaxCount: "50",
+			},
+			expectedState: longhorn.SettingsProfileStateDrifted,
+			expectedSettings: map[types.SettingName]string{
+				types.SettingNameSnapshotMaxCount: "50",
+			},
+			expectedDriftedSettings: []longhorn.SettingsProfileDrift{
+				{
+					Name:          string(types.SettingNameSnapshotMaxCount),
+					ExpectedValue: "100",
+					ActualValue:   "50",
+				},
+			},
+		},
+		"reject inconsistent settings": {
+			settings: map[types.SettingName]string{
+				types.SettingNameSnapshotMaxCount:                   "250",
+				types.SettingNameAutoCleanupSystemGeneratedSnapshot: "true",
+				types.SettingNameDisableSnapshotPurge:               "false",
+			},
+			profileSettings: map[string]string{
+				string(types.SettingNameSnapshotMaxCount):     "100",
+				string(types.SettingNameDisableSnapshotPurge): "true",
+			},
+			expectedState: longhorn.SettingsProfileStateError,
+			expectedSettings: map[types.SettingName]string{
+				types.SettingNameSnapshotMaxCount:     "250",
+				types.SettingNameDisableSnapshotPurge: "false",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		c.Logf("testing %v", name)
+
+		kubeClient := fake.NewSimpleClientset()
+		lhClient := lhfake.NewSimpleClientset()
+		extensionsClient := apiextensionsfake.NewSimpleClientset()
+		informerFactories := util.NewInformerFactories(TestNamespace, kubeClient, lhClient, controller.NoResyncPeriodFunc())
+
+		settingIndexer := informerFactories.LhInformerFactory.Longhorn().V1beta2().Settings().Informer().GetIndexer()
+		settingsProfileIndexer := informerFactories.LhInformerFactory.Longhorn().V1beta2().SettingsProfiles().Informer().GetIndexer()
+
+		spc, err := newTestSettingsProfileController(lhClient, kubeClient, extensionsClient, informerFactories)
+		c.Assert(err, IsNil)
+
+		for sName, value := range tc.settings {
+			setting, err := lhClient.LonghornV1beta2().Settings(TestNamespace).Create(context.TODO(), newSetting(string(sName), value), metav1.CreateOptions{})
+			c.Assert(err, IsNil)
+			err = settingIndexer.Add(setting)
+			c.Assert(err, IsNil)
+		}
+
+		settingsProfile := &longhorn.SettingsProfile{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "test-profile",
+				Namespace:  TestNamespace,
+				Generation: 1,
+			},
+			Spec: longhorn.SettingsProfileSpec{
+				Settings: tc.profileSettings,
+			},
+		}
+		settingsProfile, err = lhClient.LonghornV1beta2().SettingsProfiles(TestNamespace).Create(context.TODO(), settingsProfile, metav1.CreateOptions{})
+		c.Assert(err, IsNil)
+		err = settingsProfileIndexer.Add(settingsProfile)
+		c.Assert(err, IsNil)
+
+		syncIndexers := func() {
+			settings, err := lhClient.LonghornV1beta2().Settings(TestNamespace).List(context.TODO(), metav1.ListOptions{})
+			c.Assert(err, IsNil)
+			for i := range settings.Items {
+				err = settingIndexer.Update(&settings.Items[i])
+				c.Assert(err, IsNil)
+			}
+			settingsProfile, err = lhClient.LonghornV1beta2().SettingsProfiles(TestNamespace).Get(context.TODO(), settingsProfile.Name, metav1.GetOptions{})
+			c.Assert(err, IsNil)
+			err = settingsProfileIndexer.Update(settingsProfile)
+			c.Assert(err, IsNil)
+		}
+
+		err = spc.reconcile(settingsProfile.Name)
+		if tc.expectedState == longhorn.SettingsProfileStateError {
+			c.Assert(err, NotNil)
+		} else {
+			c.Assert(err, IsNil)
+		}
+		syncIndexers()
+
+		for sName, value := range tc.driftedSettings {
+			setting, err := lhClient.LonghornV1beta2().Settings(TestNamespace).Get(context.TODO(), string(sName), metav1.GetOptions{})
+			c.Assert(err, IsNil)
+			setting.Value = value
+			_, err = lhClient.LonghornV1beta2().Settings(TestNamespace).Update(context.TODO(), setting, metav1.UpdateOptions{})
+			c.Assert(err, IsNil)
+		}
+		if len(tc.driftedSettings) > 0 {
+			syncIndexers()
+			err = spc.reconcile(settingsProfile.Name)
+			c.Assert(err, IsNil)
+			syncIndexers()
+		}
+
+		c.Assert(settingsProfile.Status.State, Equals, tc.expectedState)
+		c.Assert(settingsProfile.Status.DriftedSettings, DeepEquals, tc.expectedDriftedSettings)
+		if tc.expectedState == longhorn.SettingsProfileStateError {
+			c.Assert(settingsProfile.Status.AppliedGeneration, Equals, int64(0))
+		} else {
+			c.Assert(settingsProfile.Status.AppliedGeneration, Equals, int64(1))
+		}
+
+		for sName, value := range tc.expectedSettings {
+			setting, err := lhClient.LonghornV1beta2().Settings(TestNamespace).Get(context.TODO(), string(sName), metav1.GetOptions{})
+			c.Assert(err, IsNil)
+			c.Assert(setting.Value, Equals, value, Commentf("setting %v", sName))
+		}
+	}
+}
@@ -0,0 +1,410 @@
+package controller
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/longhorn/longhorn-manager/engineapi"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// getSystemBackupYAMLSchemes returns the schemes to decode the resource YAMLs in each sub-directory of the system backup.
+func getSystemBackupYAMLSchemes() (map[string]*runtime.Scheme, error) {
+	apiExtensionsScheme := runtime.NewScheme()
+	if err := apiextensionsv1.AddToScheme(apiExtensionsScheme); err != nil {
+		return nil, errors.Wrap(err, "failed to add API Extension to scheme")
+	}
+
+	longhornScheme := runtime.NewScheme()
+	if err := longhorn.AddToScheme(longhornScheme); err != nil {
+		return nil, errors.Wrap(err, "failed to add Longhorn to scheme")
+	}
+
+	return map[string]*runtime.Scheme{
+		types.SystemBackupSubDirAPIExtensions: apiExtensionsScheme,
+		types.SystemBackupSubDirKubernetes:    kubernetesscheme.Scheme,
+		types.SystemBackupSubDirLonghorn:      longhornScheme,
+	}, nil
+}
+
+// walkSystemBackupYAMLs calls fn with the path relative to yamlsDir and the decoded resource list of each resource YAML.
+func walkSystemBackupYAMLs(yamlsDir string, fn func(relPath string, list runtime.Object, scheme *runtime.Scheme) error) error {
+	schemes, err := getSystemBackupYAMLSchemes()
+	if err != nil {
+		return err
+	}
+
+	subDirs := make([]string, 0, len(schemes))
+	for subDir := range schemes {
+		subDirs = append(subDirs, subDir)
+	}
+	sort.Strings(subDirs)
+
+	for _, subDir := range subDirs {
+		files, err := os.ReadDir(filepath.Join(yamlsDir, subDir))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return errors.Wrapf(err, "failed to read directory %v", filepath.Join(yamlsDir, subDir))
+		}
+
+		for _, f := range files {
+			if f.IsDir() {
+				continue
+			}
+
+			relPath := filepath.Join(subDir, f.Name())
+			list, err := readSystemBackupYAML(filepath.Join(yamlsDir, relPath), schemes[subDir])
+			if err != nil {
+				return err
+			}
+
+			if err := fn(relPath, list, schemes[subDir]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func readSystemBackupYAML(path string, scheme *runtime.Scheme) (runtime.Object, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read file %v", path)
+	}
+
+	obj, _, err := serializer.NewCodecFactory(scheme).UniversalDeserializer().Decode(contents, nil, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode %v", path)
+	}
+
+	if !apimeta.IsListType(obj) {
+		return nil, fmt.Errorf("%v is not a resource list", path)
+	}
+	return obj, nil
+}
+
+func writeSystemBackupYAML(path string, list runtime.Object, scheme *runtime.Scheme) error {
+	getListFunc := func() (runtime.Object, error) {
+		return list, nil
+	}
+	return getObjectsAndPrintToYAML(filepath.Dir(path), strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)), getListFunc, scheme)
+}
+
+// getSystemBackupResourceKey returns the key identifying the resource across the system backups.
+func getSystemBackupResourceKey(obj runtime.Object) (string, metav1.Object, error) {
+	accessor, err := apimeta.Accessor(obj)
+	if err != nil {
+		return "", nil, err
+	}
+	return fmt.Sprintf("%v/%v/%v", getSystemRolloutResourceKind(obj), accessor.GetNamespace(), accessor.GetName()), accessor, nil
+}
+
+// filterSystemBackupResourceList keeps the items in the resource list that fn returns true for.
+func filterSystemBackupResourceList(list runtime.Object, fn func(key string, obj runtime.Object, accessor metav1.Object) bool) error {
+	items, err := apimeta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+
+	kept := []runtime.Object{}
+	for _, item := range items {
+		key, accessor, err := getSystemBackupResourceKey(item)
+		if err != nil {
+			return err
+		}
+
+		if fn(key, item, accessor) {
+			kept = append(kept, item)
+		}
+	}
+	return apimeta.SetList(list, kept)
+}
+
+// scopeSystemBackupYAMLs keeps only the PersistentVolumeClaims in the namespaces, with the PersistentVolumes, Volumes
+// and BackingImages they use.
+func scopeSystemBackupYAMLs(yamlsDir string, namespaces []string) error {
+	schemes, err := getSystemBackupYAMLSchemes()
+	if err != nil {
+		return err
+	}
+
+	scopedNamespaces := map[string]bool{}
+	for _, namespace := range namespaces {
+		scopedNamespaces[namespace] = true
+	}
+	volumeNames := map[string]bool{}
+	backingImageNames := map[string]bool{}
+
+	// Each resource is kept by the resources before it, so the order matters.
+	scopedYAMLs := []struct {
+		subDir string
+		name   string
+		keep   func(obj runtime.Object) bool
+	}{
+		{types.SystemBackupSubDirKubernetes, "persistentvolumeclaims", func(obj runtime.Object) bool {
+			return scopedNamespaces[obj.(*corev1.PersistentVolumeClaim).Namespace]
+		}},
+		{types.SystemBackupSubDirKubernetes, "persistentvolumes", func(obj runtime.Object) bool {
+			pv := obj.(*corev1.PersistentVolume)
+			if pv.Spec.ClaimRef == nil || !scopedNamespaces[pv.Spec.ClaimRef.Namespace] {
+				return false
+			}
+			if pv.Spec.CSI != nil {
+				volumeNames[pv.Spec.CSI.VolumeHandle] = true
+			}
+			return true
+		}},
+		{types.SystemBackupSubDirLonghorn, "volumes", func(obj runtime.Object) bool {
+			volume := obj.(*longhorn.Volume)
+			if !volumeNames[volume.Name] {
+				return false
+			}
+			if volume.Spec.BackingImage != "" {
+				backingImageNames[volume.Spec.BackingImage] = true
+			}
+			return true
+		}},
+		{types.SystemBackupSubDirLonghorn, "backingimages", func(obj runtime.Object) bool {
+			return backingImageNames[obj.(*longhorn.BackingImage).Name]
+		}},
+	}
+
+	scopedPaths := map[string]bool{}
+	for _, scoped := range scopedYAMLs {
+		path := filepath.Join(yamlsDir, scoped.subDir, scoped.name+".yaml")
+		scopedPaths[path] = true
+
+		list, err := readSystemBackupYAML(path, schemes[scoped.subDir])
+		if err != nil {
+			return err
+		}
+
+		keep := scoped.keep
+		err = filterSystemBackupResourceList(list, func(_ string, obj runtime.Object, _ metav1.Object) bool {
+			return keep(obj)
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to scope %v", path)
+		}
+
+		if err := writeSystemBackupYAML(path, list, schemes[scoped.subDir]); err != nil {
+			return err
+		}
+	}
+
+	for subDir := range schemes {
+		dir := filepath.Join(yamlsDir, subDir)
+		files, err := os.ReadDir(dir)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return errors.Wrapf(err, "failed to read directory %v", dir)
+		}
+
+		for _, f := range files {
+			path := filepath.Join(dir, f.Name())
+			if scopedPaths[path] {
+				continue
+			}
+
+			if err := os.RemoveAll(path); err != nil {
+				return errors.Wrapf(err, "failed to remove %v", path)
+			}
+		}
+	}
+	return nil
+}
+
+// getSystemBackupResourceVersions returns the resource versions of all resources in the system backup.
+func getSystemBackupResourceVersions(yamlsDir string) (map[string]string, error) {
+	resourceVersions := map[string]string{}
+	err := walkSystemBackupYAMLs(yamlsDir, func(_ string, list runtime.Object, _ *runtime.Scheme) error {
+		return filterSystemBackupResourceList(list, func(key string, _ runtime.Object, accessor metav1.Object) bool {
+			resourceVersions[key] = accessor.GetResourceVersion()
+			return true
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resourceVersions, nil
+}
+
+// pruneUnchangedSystemBackupYAMLs removes the resources having the same resource versions in the base system backup.
+func pruneUnchangedSystemBackupYAMLs(yamlsDir string, baseResourceVersions map[string]string) error {
+	return walkSystemBackupYAMLs(yamlsDir, func(relPath string, list runtime.Object, scheme *runtime.Scheme) error {
+		err := filterSystemBackupResourceList(list, func(key string, _ runtime.Object, accessor metav1.Object) bool {
+			// Cannot tell whether the resource is changed without the resource version
+			if accessor.GetResourceVersion() == "" {
+				return true
+			}
+
+			baseResourceVersion, exists := baseResourceVersions[key]
+			return !exists || baseResourceVersion != accessor.GetResourceVersion()
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to prune %v", relPath)
+		}
+
+		return writeSystemBackupYAML(filepath.Join(yamlsDir, relPath), list, scheme)
+	})
+}
+
+// mergeSystemBackupYAMLs merges the resources of the system backup chain, ordered from the oldest to the newest, into
+// the newest system backup. The resources in the newer system backups override the older ones, and the resources not
+// in resourceVersions are dropped since they are deleted before the newest system backup.
+func mergeSystemBackupYAMLs(systemBackupDirs []string, resourceVersions map[string]string) error {
+	type mergedYAML struct {
+		list   runtime.Object
+		scheme *runtime.Scheme
+		items  map[string]runtime.Object
+	}
+
+	merged := map[string]*mergedYAML{}
+	for _, systemBackupDir := range systemBackupDirs {
+		yamlsDir := filepath.Join(systemBackupDir, types.SystemBackupSubDirYaml)
+		err := walkSystemBackupYAMLs(yamlsDir, func(relPath string, list runtime.Object, scheme *runtime.Scheme) error {
+			m, exists := merged[relPath]
+			if !exists {
+				m = &mergedYAML{
+					list:   list,
+					scheme: scheme,
+					items:  map[string]runtime.Object{},
+				}
+				merged[relPath] = m
+			}
+
+			return filterSystemBackupResourceList(list, func(key string, obj runtime.Object, _ metav1.Object) bool {
+				m.items[key] = obj
+				return true
+			})
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to merge system backup %v", filepath.Base(systemBackupDir))
+		}
+	}
+
+	yamlsDir := filepath.Join(systemBackupDirs[len(systemBackupDirs)-1], types.SystemBackupSubDirYaml)
+	for relPath, m := range merged {
+		keys := []string{}
+		for key := range m.items {
+			if _, exists := resourceVersions[key]; exists {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		items := make([]runtime.Object, 0, len(keys))
+		for _, key := range keys {
+			items = append(items, m.items[key])
+		}
+
+		if err := apimeta.SetList(m.list, items); err != nil {
+			return err
+		}
+
+		if err := writeSystemBackupYAML(filepath.Join(yamlsDir, relPath), m.list, m.scheme); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readSystemBackupMeta(systemBackupDir string) (*systemBackupMeta, error) {
+	systemBackupMeta := &systemBackupMeta{}
+	if err := util.DecodeFromYAMLFile(filepath.Join(systemBackupDir, types.SystemBackupMetadataFile), systemBackupMeta); err != nil {
+		return nil, err
+	}
+	return systemBackupMeta, nil
+}
+
+// downloadAndUnpackSystemBackup downloads the system backup into dir and returns the unpacked system backup directory.
+func downloadAndUnpackSystemBackup(backupTargetClient engineapi.SystemBackupOperationInterface, name, version, dir string) (string, error) {
+	archivePath := filepath.Join(dir, name+types.SystemBackupExtension)
+	if err := backupTargetClient.DownloadSystemBackup(name, version, archivePath); err != nil {
+		return "", err
+	}
+
+	cmd := exec.Command("unzip", "-o", archivePath)
+	cmd.Dir = dir
+	if err := cmd.Run(); err != nil {
+		return "", errors.Wrapf(err, "failed to unzip %v", archivePath)
+	}
+
+	if err := os.Remove(archivePath); err != nil {
+		logrus.WithError(err).Warnf("Failed to remove %v", archivePath)
+	}
+
+	return filepath.Join(dir, name), nil
+}
+
+// reassembleSystemBackup merges the resources of the base system backups into the incremental system backup to restore.
+func (c *SystemRolloutController) reassembleSystemBackup(log logrus.FieldLogger) error {
+	tempDir := filepath.Dir(c.downloadPath)
+	systemBackupDir := filepath.Join(tempDir, c.systemRestore.Spec.SystemBackup)
+
+	systemBackupMeta, err := readSystemBackupMeta(systemBackupDir)
+	if err != nil {
+		return err
+	}
+
+	if systemBackupMeta.BaseSystemBackup == "" {
+		return nil
+	}
+
+	baseDir, err := os.MkdirTemp(tempDir, c.systemRestore.Name+"-base-")
+	if err != nil {
+		return errors.Wrap(err, "failed to create directory for base system backups")
+	}
+	defer func() {
+		if err := os.RemoveAll(baseDir); err != nil {
+			log.WithError(err).Warnf("Failed to remove %v", baseDir)
+		}
+	}()
+
+	chain := []string{systemBackupDir}
+	visited := map[string]bool{c.systemRestore.Spec.SystemBackup: true}
+	for base := systemBackupMeta.BaseSystemBackup; base != ""; {
+		if visited[base] {
+			return fmt.Errorf("found circular base system backup %v", base)
+		}
+		visited[base] = true
+
+		log.Infof("Downloading base system backup %v", base)
+		dir, err := downloadAndUnpackSystemBackup(c.backupTargetClient, base, c.systemRestoreVersion, baseDir)
+		if err != nil {
+			return errors.Wrapf(err, "failed to download base system backup %v", base)
+		}
+		chain = append([]string{dir}, chain...)
+
+		baseMeta, err := readSystemBackupMeta(dir)
+		if err != nil {
+			return err
+		}
+		base = baseMeta.BaseSystemBackup
+	}
+
+	return mergeSystemBackupYAMLs(chain, systemBackupMeta.ResourceVersions)
+}
@@ -375,6 +375,14 @@ func (c *SystemBackupController) reconcile(name string, backupTargetClient engin
 		return fmt.Errorf(string(SystemBackupErrGetBackupTarget)+": %v", types.DefaultBackupTargetName)
 	}
 
+	// The system backup is uploaded by the owner, hence the bandwidth limits of the owner node apply
+	if client, ok := backupTargetClient.(*engineapi.BackupTargetClient); ok && backupTarget != nil {
+		client.BandwidthLimits, err = c.ds.GetBackupBandwidthLimits(backupTarget.Name, systemBackup.Status.OwnerID)
+		if err != nil {
+			return errors.Wrap(err, "failed to get the backup bandwidth limits")
+		}
+	}
+
 	tempBackupArchivePath := filepath.Join(SystemBackupTempDir, systemBackup.Name+types.SystemBackupExtension)
 	tempBackupDir := filepath.Join(SystemBackupTempDir, systemBackup.Name)
 
@@ -463,7 +471,7 @@ func (c *SystemBackupController) reconcile(name string, backupTargetClient engin
 		go c.WaitForBackingImageBackupToComplete(backupBackingImages, systemBackup)
 
 	case longhorn.SystemBackupStateGenerating:
-		go c.GenerateSystemBackup(systemBackup, tempBackupArchivePath, tempBackupDir)
+		go c.GenerateSystemBackup(systemBackup, tempBackupArchivePath, tempBackupDir, backupTargetClient)
 
 	case longhorn.SystemBackupStateUploading:
 		go c.UploadSystemBackup(systemBackup, tempBackupArchivePath, tempBackupDir, backupTargetClient)
@@ -664,6 +672,10 @@ type systemBackupMeta struct {
 	LonghornNamespaceUUID string      `json:"longhornNamspaceUUID"`
 	SystemBackupCreatedAt metav1.Time `json:"systemBackupCreatedAt"`
 	ManagerImage          string      `json:"managerImage"`
+	BaseSystemBackup      string      `json:"baseSystemBackup"`
+	// The resource versions of all resources at the time of the system backup, including the ones unchanged since the
+	// base system backup.
+	ResourceVersions map[string]string `json:"resourceVersions"`
 }
 
 func (c *SystemBackupController) newSystemBackupMeta(systemBackup *longhorn.SystemBackup) (*systemBackupMeta, error) {
@@ -687,7 +699,7 @@ func (c *SystemBackupController) newSystemBackupMeta(systemBackup *longhorn.Syst
 	}, nil
 }
 
-func (c *SystemBackupController) GenerateSystemBackup(systemBackup *longhorn.SystemBackup, archievePath, tempDir string) {
+func (c *SystemBackupController) GenerateSystemBackup(systemBackup *longhorn.SystemBackup, archievePath, tempDir string, backupTargetClient engineapi.SystemBackupOperationInterface) {
 	log := getLoggerForSystemBackup(c.logger, systemBackup)
 
 	var err error
@@ -713,6 +725,7 @@ func (c *SystemBackupController) GenerateSystemBackup(systemBackup *longhorn.Sys
 	systemBackupMeta, err := c.newSystemBackupMeta(systemBackup)
 	if err != nil {
 		errMessage = fmt.Sprint(errors.Wrapf(err, SystemBackupErrGetFmt, "system backup meta"))
+		return
 	}
 
 	err = os.MkdirAll(tempDir, os.FileMode(0755))
@@ -721,7 +734,7 @@ func (c *SystemBackupController) GenerateSystemBackup(systemBackup *longhorn.Sys
 		return
 	}
 
-	err = c.generateSystemBackup(systemBackupMeta, tempDir)
+	err = c.generateSystemBackup(systemBackup, systemBackupMeta, tempDir, backupTargetClient)
 	if err != nil {
 		errMessage = fmt.Sprint(errors.Wrap(err, SystemBackupErrGenerate))
 		return
@@ -758,11 +771,20 @@ func (c *SystemBackupController) backupVolumesAlways(systemBackup *longhorn.Syst
 		return nil, err
 	}
 
+	scopedVolumeNames, err := c.getScopedVolumeNames(systemBackup)
+	if err != nil {
+		return nil, err
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
 	volumeBackups := make(map[string]*longhorn.Backup, len(volumes))
 	for _, volume := range volumes {
+		if scopedVolumeNames != nil && !scopedVolumeNames[volume.Name] {
+			continue
+		}
+
 		// Don't need to create volume data backup for DR volumes since it will
 		// be restored from the source volume's backup.
 		if volume.Status.IsStandby {
@@ -794,11 +816,20 @@ func (c *SystemBackupController) backupVolumesIfNotPresent(systemBackup *longhor
 		return nil, err
 	}
 
+	scopedVolumeNames, err := c.getScopedVolumeNames(systemBackup)
+	if err != nil {
+		return nil, err
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
 	volumeBackups := make(map[string]*longhorn.Backup, len(volumes))
 	for _, volume := range volumes {
+		if scopedVolumeNames != nil && !scopedVolumeNames[volume.Name] {
+			continue
+		}
+
 		// Don't need to create volume data backup for DR volumes since it will
 		// be restored from the source volume's backup.
 		if volume.Status.IsStandby {
@@ -833,6 +864,38 @@ func (c *SystemBackupController) backupVolumesIfNotPresent(systemBackup *longhor
 	return volumeBackups, nil
 }
 
+// getScopedVolumeNames returns the names of the volumes used by the PersistentVolumeClaims in the system backup scope,
+// or nil if the system backup is not scoped.
+func (c *SystemBackupController) getScopedVolumeNames(systemBackup *longhorn.SystemBackup) (map[string]bool, error) {
+	if len(systemBackup.Spec.Scope.Namespaces) == 0 {
+		return nil, nil
+	}
+
+	obj, err := c.ds.GetAllPersistentVolumesWithLonghornProvisioner()
+	if err != nil {
+		return nil, err
+	}
+
+	pvList, ok := obj.(*corev1.PersistentVolumeList)
+	if !ok {
+		return nil, fmt.Errorf("unexpected PersistentVolume list type %T", obj)
+	}
+
+	scopedNamespaces := map[string]bool{}
+	for _, namespace := range systemBackup.Spec.Scope.Namespaces {
+		scopedNamespaces[namespace] = true
+	}
+
+	volumeNames := map[string]bool{}
+	for _, pv := range pvList.Items {
+		if pv.Spec.ClaimRef == nil || !scopedNamespaces[pv.Spec.ClaimRef.Namespace] || pv.Spec.CSI == nil {
+			continue
+		}
+		volumeNames[pv.Spec.CSI.VolumeHandle] = true
+	}
+	return volumeNames, nil
+}
+
 func (c *SystemBackupController) WaitForVolumeBackupToComplete(backups map[string]*longhorn.Backup, systemBackup *longhorn.SystemBackup) (err error) {
 	log := getLoggerForSystemBackup(c.logger, systemBackup)
 
@@ -1148,14 +1211,73 @@ func (c *SystemBackupController) createBackingImageBackup(backingImage *longhorn
 	return backupBackingImage, nil
 }
 
-func (c *SystemBackupController) generateSystemBackup(systemBackupMeta *systemBackupMeta, tempDir string) (err error) {
-	metaFile := filepath.Join(tempDir, "metadata.yaml")
-	err = util.EncodeToYAMLFile(systemBackupMeta, metaFile)
+func (c *SystemBackupController) generateSystemBackup(systemBackup *longhorn.SystemBackup, systemBackupMeta *systemBackupMeta, tempDir string, backupTargetClient engineapi.SystemBackupOperationInterface) (err error) {
+	yamlsDir := filepath.Join(tempDir, types.SystemBackupSubDirYaml)
+	err = c.generateSystemBackupYAMLs(yamlsDir)
+	if err != nil {
+		return err
+	}
+
+	if len(systemBackup.Spec.Scope.Namespaces) != 0 {
+		err = scopeSystemBackupYAMLs(yamlsDir, systemBackup.Spec.Scope.Namespaces)
+		if err != nil {
+			return errors.Wrap(err, "failed to scope system backup")
+		}
+	}
+
+	systemBackupMeta.ResourceVersions, err = getSystemBackupResourceVersions(yamlsDir)
 	if err != nil {
 		return err
 	}
 
-	return c.generateSystemBackupYAMLs(filepath.Join(tempDir, "yamls"))
+	if systemBackup.Spec.BaseSystemBackup != "" {
+		baseMeta, err := c.getBaseSystemBackupMeta(systemBackup, tempDir, backupTargetClient)
+		if err != nil {
+			return errors.Wrapf(err, SystemBackupErrGetFmt, "base system backup "+systemBackup.Spec.BaseSystemBackup)
+		}
+
+		err = pruneUnchangedSystemBackupYAMLs(yamlsDir, baseMeta.ResourceVersions)
+		if err != nil {
+			return err
+		}
+		systemBackupMeta.BaseSystemBackup = systemBackup.Spec.BaseSystemBackup
+	}
+
+	metaFile := filepath.Join(tempDir, types.SystemBackupMetadataFile)
+	return util.EncodeToYAMLFile(systemBackupMeta, metaFile)
+}
+
+// getBaseSystemBackupMeta downloads the base system backup of the incremental system backup and returns its metadata.
+func (c *SystemBackupController) getBaseSystemBackupMeta(systemBackup *longhorn.SystemBackup, tempDir string, backupTargetClient engineapi.SystemBackupOperationInterface) (*systemBackupMeta, error) {
+	baseSystemBackup, err := c.ds.GetSystemBackupRO(systemBackup.Spec.BaseSystemBackup)
+	if err != nil {
+		return nil, err
+	}
+
+	if baseSystemBackup.Status.State != longhorn.SystemBackupStateReady {
+		return nil, fmt.Errorf("base system backup is in %v state", baseSystemBackup.Status.State)
+	}
+
+	if baseSystemBackup.Status.Version != meta.Version {
+		return nil, fmt.Errorf("base system backup is of version %v, expecting %v", baseSystemBackup.Status.Version, meta.Version)
+	}
+
+	baseDir, err := os.MkdirTemp(filepath.Dir(tempDir), systemBackup.Name+"-base-")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := os.RemoveAll(baseDir); err != nil {
+			c.logger.WithError(err).Warnf("Failed to remove %v", baseDir)
+		}
+	}()
+
+	dir, err := downloadAndUnpackSystemBackup(backupTargetClient, baseSystemBackup.Name, baseSystemBackup.Status.Version, baseDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return readSystemBackupMeta(dir)
 }
 
 func (c *SystemBackupController) generateSystemBackupYAMLs(yamlsDir string) (err error) {
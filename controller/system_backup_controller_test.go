@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/sirupsen/logrus"
 
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -310,7 +312,7 @@ func (s *TestSuite) TestReconcileSystemBackup(c *C) {
 			systemBackupController.WaitForBackingImageBackupToComplete(backupBackingImages, systemBackup)
 
 		case longhorn.SystemBackupStateGenerating:
-			systemBackupController.GenerateSystemBackup(systemBackup, archievePath, tempDir)
+			systemBackupController.GenerateSystemBackup(systemBackup, archievePath, tempDir, backupTargetClient)
 
 		case longhorn.SystemBackupStateUploading:
 			systemBackupController.UploadSystemBackup(systemBackup, archievePath, tempDir, backupTargetClient)
@@ -410,3 +412,145 @@ func fakeSystemRolloutNamespace(c *C, informerFactory informers.SharedInformerFa
 	err = nsIndexer.Add(namespace)
 	c.Assert(err, IsNil)
 }
+
+func (s *TestSuite) TestScopeSystemBackupYAMLs(c *C) {
+	yamlsDir := filepath.Join(c.MkDir(), types.SystemBackupSubDirYaml)
+	otherNamespace := "other-namespace"
+
+	fakeSystemBackupYAML(c, yamlsDir, types.SystemBackupSubDirKubernetes, "persistentvolumeclaims", &corev1.PersistentVolumeClaimList{
+		Items: []corev1.PersistentVolumeClaim{
+			{ObjectMeta: metav1.ObjectMeta{Name: TestPVCName, Namespace: TestNamespace}},
+			{ObjectMeta: metav1.ObjectMeta{Name: TestPVCName, Namespace: otherNamespace}},
+		},
+	})
+	fakeSystemBackupYAML(c, yamlsDir, types.SystemBackupSubDirKubernetes, "persistentvolumes", &corev1.PersistentVolumeList{
+		Items: []corev1.PersistentVolume{
+			newSystemBackupTestPV("pv-1", "volume-1", TestNamespace),
+			newSystemBackupTestPV("pv-2", "volume-2", otherNamespace),
+		},
+	})
+	fakeSystemBackupYAML(c, yamlsDir, types.SystemBackupSubDirKubernetes, "configmaps", &corev1.ConfigMapList{
+		Items: []corev1.ConfigMap{
+			{ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: TestNamespace}},
+		},
+	})
+	fakeSystemBackupYAML(c, yamlsDir, types.SystemBackupSubDirLonghorn, "volumes", &longhorn.VolumeList{
+		Items: []longhorn.Volume{
+			{ObjectMeta: metav1.ObjectMeta{Name: "volume-1", Namespace: TestNamespace}, Spec: longhorn.VolumeSpec{BackingImage: "backing-image-1"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "volume-2", Namespace: TestNamespace}, Spec: longhorn.VolumeSpec{BackingImage: "backing-image-2"}},
+		},
+	})
+	fakeSystemBackupYAML(c, yamlsDir, types.SystemBackupSubDirLonghorn, "backingimages", &longhorn.BackingImageList{
+		Items: []longhorn.BackingImage{
+			{ObjectMeta: metav1.ObjectMeta{Name: "backing-image-1", Namespace: TestNamespace}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "backing-image-2", Namespace: TestNamespace}},
+		},
+	})
+
+	err := scopeSystemBackupYAMLs(yamlsDir, []string{TestNamespace})
+	c.Assert(err, IsNil)
+
+	c.Assert(getSystemBackupTestResourceNames(c, yamlsDir), DeepEquals, []string{
+		"BackingImage/" + TestNamespace + "/backing-image-1",
+		"PersistentVolume//pv-1",
+		"PersistentVolumeClaim/" + TestNamespace + "/" + TestPVCName,
+		"Volume/" + TestNamespace + "/volume-1",
+	})
+
+	_, err = os.Stat(filepath.Join(yamlsDir, types.SystemBackupSubDirKubernetes, "configmaps.yaml"))
+	c.Assert(os.IsNotExist(err), Equals, true)
+}
+
+func (s *TestSuite) TestIncrementalSystemBackupChain(c *C) {
+	tempDir := c.MkDir()
+	baseDir := filepath.Join(tempDir, "base")
+	incrementalDir := filepath.Join(tempDir, "incremental")
+
+	newVolume := func(name, resourceVersion string) longhorn.Volume {
+		return longhorn.Volume{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: TestNamespace, ResourceVersion: resourceVersion}}
+	}
+	setting := longhorn.Setting{ObjectMeta: metav1.ObjectMeta{Name: "setting", Namespace: TestNamespace, ResourceVersion: "1"}}
+
+	baseYAMLsDir := filepath.Join(baseDir, types.SystemBackupSubDirYaml)
+	fakeSystemBackupYAML(c, baseYAMLsDir, types.SystemBackupSubDirLonghorn, "volumes", &longhorn.VolumeList{
+		Items: []longhorn.Volume{newVolume("volume-1", "1"), newVolume("volume-2", "1")},
+	})
+	fakeSystemBackupYAML(c, baseYAMLsDir, types.SystemBackupSubDirLonghorn, "setting", &longhorn.SettingList{
+		Items: []longhorn.Setting{setting},
+	})
+	baseResourceVersions, err := getSystemBackupResourceVersions(baseYAMLsDir)
+	c.Assert(err, IsNil)
+
+	// volume-1 is updated, volume-2 is deleted and volume-3 is created since the base system backup
+	incrementalYAMLsDir := filepath.Join(incrementalDir, types.SystemBackupSubDirYaml)
+	fakeSystemBackupYAML(c, incrementalYAMLsDir, types.SystemBackupSubDirLonghorn, "volumes", &longhorn.VolumeList{
+		Items: []longhorn.Volume{newVolume("volume-1", "2"), newVolume("volume-3", "1")},
+	})
+	fakeSystemBackupYAML(c, incrementalYAMLsDir, types.SystemBackupSubDirLonghorn, "setting", &longhorn.SettingList{
+		Items: []longhorn.Setting{setting},
+	})
+	resourceVersions, err := getSystemBackupResourceVersions(incrementalYAMLsDir)
+	c.Assert(err, IsNil)
+
+	err = pruneUnchangedSystemBackupYAMLs(incrementalYAMLsDir, baseResourceVersions)
+	c.Assert(err, IsNil)
+	c.Assert(getSystemBackupTestResourceNames(c, incrementalYAMLsDir), DeepEquals, []string{
+		"Volume/" + TestNamespace + "/volume-1",
+		"Volume/" + TestNamespace + "/volume-3",
+	})
+
+	err = util.EncodeToYAMLFile(&systemBackupMeta{
+		SystemBackupCreatedAt: metav1.Now(),
+		BaseSystemBackup:      "base",
+		ResourceVersions:      resourceVersions,
+	}, filepath.Join(incrementalDir, types.SystemBackupMetadataFile))
+	c.Assert(err, IsNil)
+	incrementalMeta, err := readSystemBackupMeta(incrementalDir)
+	c.Assert(err, IsNil)
+	c.Assert(incrementalMeta.BaseSystemBackup, Equals, "base")
+	c.Assert(incrementalMeta.ResourceVersions, DeepEquals, resourceVersions)
+
+	err = mergeSystemBackupYAMLs([]string{baseDir, incrementalDir}, incrementalMeta.ResourceVersions)
+	c.Assert(err, IsNil)
+	c.Assert(getSystemBackupTestResourceNames(c, incrementalYAMLsDir), DeepEquals, []string{
+		"Setting/" + TestNamespace + "/setting",
+		"Volume/" + TestNamespace + "/volume-1",
+		"Volume/" + TestNamespace + "/volume-3",
+	})
+
+	mergedResourceVersions, err := getSystemBackupResourceVersions(incrementalYAMLsDir)
+	c.Assert(err, IsNil)
+	c.Assert(mergedResourceVersions["Volume/"+TestNamespace+"/volume-1"], Equals, "2")
+}
+
+func fakeSystemBackupYAML(c *C, yamlsDir, subDir, name string, list runtime.Object) {
+	schemes, err := getSystemBackupYAMLSchemes()
+	c.Assert(err, IsNil)
+
+	err = writeSystemBackupYAML(filepath.Join(yamlsDir, subDir, name+".yaml"), list, schemes[subDir])
+	c.Assert(err, IsNil)
+}
+
+func newSystemBackupTestPV(name, volumeName, claimNamespace string) corev1.PersistentVolume {
+	return corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: corev1.PersistentVolumeSpec{
+			PersistentVolumeSource: corev1.PersistentVolumeSource{
+				CSI: &corev1.CSIPersistentVolumeSource{Driver: types.LonghornDriverName, VolumeHandle: volumeName},
+			},
+			ClaimRef: &corev1.ObjectReference{Name: TestPVCName, Namespace: claimNamespace},
+		},
+	}
+}
+
+func getSystemBackupTestResourceNames(c *C, yamlsDir string) []string {
+	resourceVersions, err := getSystemBackupResourceVersions(yamlsDir)
+	c.Assert(err, IsNil)
+
+	keys := []string{}
+	for key := range resourceVersions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
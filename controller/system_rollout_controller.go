@@ -6,7 +6,9 @@ import (
 	"os/exec"
 	"path/filepath"
 	"reflect"
+	"sort"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -16,6 +18,7 @@ import (
 	"golang.org/x/time/rate"
 
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
@@ -67,6 +70,7 @@ const (
 	SystemRolloutMsgUnpackedFmt         = "Unpacked %v"
 
 	SystemRolloutMsgCompleted       = "System rollout completed"
+	SystemRolloutMsgDryRunCompleted = "System rollout dry run completed"
 	SystemRolloutMsgCreating        = "System rollout creating"
 	SystemRolloutMsgIgnoreItemFmt   = "System rollout ignoring item: %v"
 	SystemRolloutMsgRestoredItem    = "System rollout restored item"
@@ -149,6 +153,9 @@ type SystemRolloutController struct {
 
 	extractedResources
 
+	dryRunReport     map[string]longhorn.SystemRestoreResourceChange
+	dryRunReportLock sync.Mutex
+
 	cacheErrors util.MultiError
 	cacheSyncs  []cache.InformerSynced
 }
@@ -467,9 +474,15 @@ func (c *SystemRolloutController) systemRollout() error {
 		c.restore(types.KubernetesKindPersistentVolumeClaimList, c.restorePersistentVolumeClaims, log)
 
 		if len(c.cacheErrors) == 0 {
+			message := SystemRolloutMsgCompleted
+			if c.systemRestore.Spec.DryRun {
+				c.systemRestore.Status.DryRunReport = c.getDryRunReport()
+				message = SystemRolloutMsgDryRunCompleted
+			}
+
 			c.updateSystemRolloutRecord(record,
 				systemRolloutRecordTypeNormal, longhorn.SystemRestoreStateCompleted,
-				constant.EventReasonRestored, SystemRolloutMsgCompleted,
+				constant.EventReasonRestored, message,
 			)
 		}
 	}
@@ -556,6 +569,11 @@ func (c *SystemRolloutController) syncController() error {
 	if err != nil {
 		return errors.Wrap(err, "failed to init rollout backup target clients")
 	}
+	// The system backup is downloaded by this rollout, which runs on the node selected by the system restore Job
+	rolloutBackupTargetClient.BandwidthLimits, err = c.ds.GetBackupBandwidthLimits(backupTarget.Name, c.controllerID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get the restore bandwidth limits")
+	}
 
 	c.backupTargetClient = rolloutBackupTargetClient
 	c.backupTargetCredential = backupTargetClient.Credential
@@ -592,14 +610,12 @@ func (c *SystemRolloutController) createSystemBackupEngineImage(engineImage stri
 			Image: engineImage,
 		},
 	}
-	fnCreate := func(restore runtime.Object) (runtime.Object, error) {
-		obj, ok := restore.(*longhorn.EngineImage)
-		if !ok {
-			return nil, fmt.Errorf(SystemRolloutErrFailedConvertToObjectFmt, restore.GetObjectKind(), types.LonghornKindEngineImage)
-		}
-		return c.ds.CreateEngineImage(obj)
+	// The engine image is required to download the system backup, so it is created in dry run as well.
+	err = c.tagLonghornLastSystemRestoreAnnotation(newEngineImage, false, log, SystemRolloutMsgRestoredItem)
+	if err != nil {
+		return nil, err
 	}
-	return c.rolloutResource(newEngineImage, fnCreate, false, log, SystemRolloutMsgRestoredItem)
+	return c.ds.CreateEngineImage(newEngineImage)
 }
 
 func (c *SystemRolloutController) cacheKubernetesResources() error {
@@ -733,6 +749,10 @@ func (c *SystemRolloutController) Unpack(log logrus.FieldLogger) error {
 		return errors.Wrapf(err, "failed to unzip %v", c.downloadPath)
 	}
 
+	if err := c.reassembleSystemBackup(log); err != nil {
+		return errors.Wrap(err, "failed to reassemble incremental system backup")
+	}
+
 	if err := c.cacheKubernetesResources(); err != nil {
 		return errors.Wrap(err, "failed to extract Kubernetes resources")
 	}
@@ -745,6 +765,13 @@ func (c *SystemRolloutController) Unpack(log logrus.FieldLogger) error {
 		return errors.Wrap(err, "failed to extract Longhorn resources")
 	}
 
+	if err := c.filterResources(log); err != nil {
+		return errors.Wrap(err, "failed to filter resources")
+	}
+
+	// The filters select the resources by their names and namespaces in the system backup, so remap them afterward
+	c.remapResources(log)
+
 	return nil
 }
 
@@ -828,6 +855,25 @@ func (c *SystemRolloutController) postRestoreHandle(kind string, restoreError er
 }
 
 func (c *SystemRolloutController) rolloutResource(obj runtime.Object, fnRollout func(runtime.Object) (runtime.Object, error), isSkipped bool, log logrus.FieldLogger, message string) (runtime.Object, error) {
+	if c.systemRestore.Spec.DryRun {
+		metadata, err := meta.Accessor(obj)
+		if err != nil {
+			return nil, err
+		}
+
+		// The new resources are rolled out with SystemRolloutMsgRestoredItem, and the existing ones with SystemRolloutMsgSkipIdentical
+		action := longhorn.SystemRestoreResourceActionUpdate
+		reason := ""
+		if message == SystemRolloutMsgRestoredItem {
+			action = longhorn.SystemRestoreResourceActionCreate
+		} else if isSkipped {
+			action = longhorn.SystemRestoreResourceActionSkip
+			reason = SystemRolloutMsgIdentical
+		}
+		c.recordDryRunChange(getSystemRolloutResourceKind(obj), metadata, action, reason)
+		return obj, nil
+	}
+
 	err := c.tagLonghornLastSystemRestoreAnnotation(obj, isSkipped, log, message)
 	if err != nil {
 		if types.ErrorAlreadyExists(err) {
@@ -839,6 +885,286 @@ func (c *SystemRolloutController) rolloutResource(obj runtime.Object, fnRollout
 	return fnRollout(obj)
 }
 
+// recordDryRunChange records the change of the resource in the dry run report. It does nothing if not in dry run.
+func (c *SystemRolloutController) recordDryRunChange(kind string, obj metav1.Object, action longhorn.SystemRestoreResourceAction, reason string) {
+	if !c.systemRestore.Spec.DryRun {
+		return
+	}
+
+	c.dryRunReportLock.Lock()
+	defer c.dryRunReportLock.Unlock()
+
+	if c.dryRunReport == nil {
+		c.dryRunReport = map[string]longhorn.SystemRestoreResourceChange{}
+	}
+
+	// The restore functions are retried on failure, so the resources are reported by key to avoid duplicates
+	key := strings.Join([]string{kind, obj.GetNamespace(), obj.GetName()}, "/")
+	c.dryRunReport[key] = longhorn.SystemRestoreResourceChange{
+		Kind:      kind,
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+		Action:    action,
+		Reason:    reason,
+	}
+}
+
+func (c *SystemRolloutController) getDryRunReport() []longhorn.SystemRestoreResourceChange {
+	c.dryRunReportLock.Lock()
+	defer c.dryRunReportLock.Unlock()
+
+	keys := make([]string, 0, len(c.dryRunReport))
+	for key := range c.dryRunReport {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	report := make([]longhorn.SystemRestoreResourceChange, 0, len(keys))
+	for _, key := range keys {
+		report = append(report, c.dryRunReport[key])
+	}
+	return report
+}
+
+// filterResources removes the resources filtered out by the SystemRestore include and exclude filters from the
+// extracted resources, so the restore functions never see them.
+func (c *SystemRolloutController) filterResources(log logrus.FieldLogger) error {
+	filter, err := newSystemRestoreResourceFilter(c.systemRestore.Spec.Include, c.systemRestore.Spec.Exclude)
+	if err != nil {
+		return err
+	}
+	if filter == nil {
+		return nil
+	}
+
+	// Volumes go before PersistentVolumes, and PersistentVolumes before PersistentVolumeClaims, so the
+	// resources bound to the filtered out volumes are filtered out as well.
+	resourceLists := []struct {
+		kind string
+		list runtime.Object
+	}{
+		{types.LonghornKindVolume, c.volumeList},
+		{types.KubernetesKindPersistentVolume, c.persistentVolumeList},
+		{types.KubernetesKindPersistentVolumeClaim, c.persistentVolumeClaimList},
+		{types.APIExtensionsKindCustomResourceDefinition, c.customResourceDefinitionList},
+		{types.KubernetesKindClusterRole, c.clusterRoleList},
+		{types.KubernetesKindClusterRoleBinding, c.clusterRoleBindingList},
+		{types.KubernetesKindRole, c.roleList},
+		{types.KubernetesKindRoleBinding, c.roleBindingList},
+		{types.KubernetesKindDaemonSet, c.daemonSetList},
+		{types.KubernetesKindDeployment, c.deploymentList},
+		{types.KubernetesKindConfigMap, c.configMapList},
+		{types.KubernetesKindService, c.serviceList},
+		{types.KubernetesKindServiceAccount, c.serviceAccountList},
+		{types.KubernetesKindStorageClass, c.storageClassList},
+		{types.LonghornKindEngineImage, c.engineImageList},
+		{types.LonghornKindRecurringJob, c.recurringJobList},
+		{types.LonghornKindSetting, c.settingList},
+		{types.LonghornKindBackingImage, c.backingImageList},
+		{types.LonghornKindBackupTarget, c.backupTargetList},
+	}
+	for _, resourceList := range resourceLists {
+		if reflect.ValueOf(resourceList.list).IsNil() {
+			continue
+		}
+
+		items, err := meta.ExtractList(resourceList.list)
+		if err != nil {
+			return err
+		}
+
+		restoreItems := []runtime.Object{}
+		for _, item := range items {
+			metadata, err := meta.Accessor(item)
+			if err != nil {
+				return err
+			}
+
+			reason := filter.getFilteredReason(resourceList.kind, item, metadata)
+			if reason == "" {
+				restoreItems = append(restoreItems, item)
+				continue
+			}
+
+			log.WithField(resourceList.kind, metadata.GetName()).Infof(SystemRolloutMsgIgnoreItemFmt, reason)
+			c.recordDryRunChange(resourceList.kind, metadata, longhorn.SystemRestoreResourceActionSkip, reason)
+		}
+
+		if err := meta.SetList(resourceList.list, restoreItems); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// remapResources applies the SystemRestore remapping rules to the extracted resources, so the resources from another
+// cluster are rolled out with the node names, StorageClasses, namespaces and backup targets of this cluster.
+func (c *SystemRolloutController) remapResources(log logrus.FieldLogger) {
+	remap := c.systemRestore.Spec.Remap
+
+	if c.volumeList != nil {
+		for i := range c.volumeList.Items {
+			volume := &c.volumeList.Items[i]
+			volume.Spec.MigrationNodeID = getRemappedName(remap.NodeNames, volume.Spec.MigrationNodeID)
+		}
+	}
+
+	if c.persistentVolumeList != nil {
+		for i := range c.persistentVolumeList.Items {
+			pv := &c.persistentVolumeList.Items[i]
+			pv.Spec.StorageClassName = getRemappedName(remap.StorageClassNames, pv.Spec.StorageClassName)
+			if pv.Spec.ClaimRef != nil {
+				pv.Spec.ClaimRef.Namespace = getRemappedName(remap.Namespaces, pv.Spec.ClaimRef.Namespace)
+			}
+			if pv.Spec.NodeAffinity != nil && pv.Spec.NodeAffinity.Required != nil {
+				remapNodeSelectorTerms(remap.NodeNames, pv.Spec.NodeAffinity.Required.NodeSelectorTerms)
+			}
+		}
+	}
+
+	if c.persistentVolumeClaimList != nil {
+		for i := range c.persistentVolumeClaimList.Items {
+			pvc := &c.persistentVolumeClaimList.Items[i]
+			pvc.Namespace = getRemappedName(remap.Namespaces, pvc.Namespace)
+			if pvc.Spec.StorageClassName != nil {
+				storageClassName := getRemappedName(remap.StorageClassNames, *pvc.Spec.StorageClassName)
+				pvc.Spec.StorageClassName = &storageClassName
+			}
+		}
+	}
+
+	if c.backupTargetList != nil {
+		for i := range c.backupTargetList.Items {
+			backupTarget := &c.backupTargetList.Items[i]
+			override, ok := remap.BackupTargets[backupTarget.Name]
+			if !ok {
+				continue
+			}
+
+			log.WithField(types.LonghornKindBackupTarget, backupTarget.Name).Infof("Overriding backup target spec with %+v", override)
+			if override.BackupTargetURL != "" {
+				backupTarget.Spec.BackupTargetURL = override.BackupTargetURL
+			}
+			if override.CredentialSecret != "" {
+				backupTarget.Spec.CredentialSecret = override.CredentialSecret
+			}
+		}
+	}
+}
+
+func getRemappedName(nameMap map[string]string, name string) string {
+	if remapped, ok := nameMap[name]; ok {
+		return remapped
+	}
+	return name
+}
+
+func remapNodeSelectorTerms(nodeNames map[string]string, terms []corev1.NodeSelectorTerm) {
+	for i := range terms {
+		for j := range terms[i].MatchExpressions {
+			expression := &terms[i].MatchExpressions[j]
+			if expression.Key != corev1.LabelHostname {
+				continue
+			}
+			for k := range expression.Values {
+				expression.Values[k] = getRemappedName(nodeNames, expression.Values[k])
+			}
+		}
+	}
+}
+
+type systemRestoreResourceFilter struct {
+	include longhorn.SystemRestoreResourceFilter
+	exclude longhorn.SystemRestoreResourceFilter
+
+	includeVolumeSelector labels.Selector
+	excludeVolumeSelector labels.Selector
+
+	// The names of the filtered out Volumes and PersistentVolumes
+	filteredVolumes map[string]bool
+}
+
+// newSystemRestoreResourceFilter returns nil if both filters are empty
+func newSystemRestoreResourceFilter(include, exclude longhorn.SystemRestoreResourceFilter) (*systemRestoreResourceFilter, error) {
+	if reflect.DeepEqual(include, longhorn.SystemRestoreResourceFilter{}) && reflect.DeepEqual(exclude, longhorn.SystemRestoreResourceFilter{}) {
+		return nil, nil
+	}
+
+	filter := &systemRestoreResourceFilter{
+		include:         include,
+		exclude:         exclude,
+		filteredVolumes: map[string]bool{},
+	}
+
+	var err error
+	if include.VolumeSelector != nil {
+		filter.includeVolumeSelector, err = metav1.LabelSelectorAsSelector(include.VolumeSelector)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid included volume selector")
+		}
+	}
+	if exclude.VolumeSelector != nil {
+		filter.excludeVolumeSelector, err = metav1.LabelSelectorAsSelector(exclude.VolumeSelector)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid excluded volume selector")
+		}
+	}
+	return filter, nil
+}
+
+// getFilteredReason returns why the resource is filtered out, or an empty string if the resource should be restored
+func (f *systemRestoreResourceFilter) getFilteredReason(kind string, obj runtime.Object, metadata metav1.Object) string {
+	reason := f.getKindAndNamespaceFilteredReason(kind, metadata.GetNamespace())
+	if reason == "" {
+		switch o := obj.(type) {
+		case *longhorn.Volume:
+			if f.includeVolumeSelector != nil && !f.includeVolumeSelector.Matches(labels.Set(o.Labels)) {
+				reason = "volume labels do not match the included volume selector"
+			} else if f.excludeVolumeSelector != nil && f.excludeVolumeSelector.Matches(labels.Set(o.Labels)) {
+				reason = "volume labels match the excluded volume selector"
+			}
+		case *corev1.PersistentVolume:
+			if o.Spec.CSI != nil && f.filteredVolumes[o.Spec.CSI.VolumeHandle] {
+				reason = fmt.Sprintf("%v %v is not restored", types.LonghornKindVolume, o.Spec.CSI.VolumeHandle)
+			}
+		case *corev1.PersistentVolumeClaim:
+			if f.filteredVolumes[o.Spec.VolumeName] {
+				reason = fmt.Sprintf("%v %v is not restored", types.KubernetesKindPersistentVolume, o.Spec.VolumeName)
+			}
+		}
+	}
+
+	if reason != "" && (kind == types.LonghornKindVolume || kind == types.KubernetesKindPersistentVolume) {
+		f.filteredVolumes[metadata.GetName()] = true
+	}
+	return reason
+}
+
+func (f *systemRestoreResourceFilter) getKindAndNamespaceFilteredReason(kind, namespace string) string {
+	if len(f.include.Kinds) != 0 && !util.Contains(f.include.Kinds, kind) {
+		return fmt.Sprintf("kind %v is not included", kind)
+	}
+	if util.Contains(f.exclude.Kinds, kind) {
+		return fmt.Sprintf("kind %v is excluded", kind)
+	}
+
+	// Cluster-scoped resources are not filtered by namespace
+	if namespace == "" {
+		return ""
+	}
+	if len(f.include.Namespaces) != 0 && !util.Contains(f.include.Namespaces, namespace) {
+		return fmt.Sprintf("namespace %v is not included", namespace)
+	}
+	if util.Contains(f.exclude.Namespaces, namespace) {
+		return fmt.Sprintf("namespace %v is excluded", namespace)
+	}
+	return ""
+}
+
+func getSystemRolloutResourceKind(obj runtime.Object) string {
+	return reflect.Indirect(reflect.ValueOf(obj)).Type().Name()
+}
+
 func (c *SystemRolloutController) restoreClusterRoles() (err error) {
 	if c.clusterRoleList == nil {
 		return nil
@@ -1289,18 +1615,21 @@ func (c *SystemRolloutController) restorePersistentVolumes() (err error) {
 				return err
 			}
 
-			volume, err := c.ds.GetVolumeRO(restore.Spec.CSI.VolumeHandle)
-			if err != nil {
-				return err
-			}
+			// The volume is not restored in dry run
+			if !c.systemRestore.Spec.DryRun {
+				volume, err := c.ds.GetVolumeRO(restore.Spec.CSI.VolumeHandle)
+				if err != nil {
+					return err
+				}
 
-			restoreCondition := types.GetCondition(volume.Status.Conditions, longhorn.VolumeConditionTypeRestore)
-			if restoreCondition.Status == longhorn.ConditionStatusTrue {
-				return errors.Errorf("volume is restoring data")
-			}
+				restoreCondition := types.GetCondition(volume.Status.Conditions, longhorn.VolumeConditionTypeRestore)
+				if restoreCondition.Status == longhorn.ConditionStatusTrue {
+					return errors.Errorf("volume is restoring data")
+				}
 
-			if volume.Status.RestoreRequired {
-				return errors.Errorf("volume is waiting to restore data")
+				if volume.Status.RestoreRequired {
+					return errors.Errorf("volume is waiting to restore data")
+				}
 			}
 
 			// Remove ClaimRef to reuse the persistent volume resource.
@@ -1361,9 +1690,12 @@ func (c *SystemRolloutController) restorePersistentVolumeClaims() (err error) {
 				return err
 			}
 
-			_, err := c.ds.GetPersistentVolumeRO(restore.Spec.VolumeName)
-			if err != nil {
-				return err
+			// The persistent volume is not restored in dry run
+			if !c.systemRestore.Spec.DryRun {
+				_, err := c.ds.GetPersistentVolumeRO(restore.Spec.VolumeName)
+				if err != nil {
+					return err
+				}
 			}
 
 			restore.ResourceVersion = ""
@@ -1927,7 +2259,8 @@ func (c *SystemRolloutController) restoreBackingIamges() (err error) {
 }
 
 func (c *SystemRolloutController) restoreVolumes() (err error) {
-	if c.engineImageList != nil {
+	// The engine images are not restored in dry run
+	if c.engineImageList != nil && !c.systemRestore.Spec.DryRun {
 		for _, restoreEngineImage := range c.engineImageList.Items {
 			obj, err := c.ds.GetLonghornEngineImage(restoreEngineImage.Name)
 			if err != nil {
@@ -1960,6 +2293,7 @@ func (c *SystemRolloutController) restoreVolumes() (err error) {
 		exist, err := c.ds.GetVolume(restore.Name)
 		if err == nil && exist != nil && exist.Spec.NodeID != "" {
 			log.Warn("Failed to restore attached volume")
+			c.recordDryRunChange(types.LonghornKindVolume, exist, longhorn.SystemRestoreResourceActionSkip, "volume is attached")
 			continue
 
 		} else if err != nil {
@@ -44,6 +44,10 @@ type SystemRolloutTestCase struct {
 	systemRestoreName string
 	restoreErrors     []string
 
+	dryRun         bool
+	restoreInclude longhorn.SystemRestoreResourceFilter
+	restoreExclude longhorn.SystemRestoreResourceFilter
+
 	backupClusterRoles           map[SystemRolloutCRName]*rbacv1.ClusterRole
 	backupClusterRoleBindings    map[SystemRolloutCRName]*rbacv1.ClusterRoleBinding
 	backupConfigMaps             map[SystemRolloutCRName]*corev1.ConfigMap
@@ -101,6 +105,8 @@ type SystemRolloutTestCase struct {
 	expectRestoredVolumes                map[SystemRolloutCRName]*longhorn.Volume
 	expectRestoredBackingImages          map[SystemRolloutCRName]*longhorn.BackingImage
 
+	expectDryRunReport []longhorn.SystemRestoreResourceChange
+
 	expectError                 string
 	expectErrorConditionMessage string
 	expectState                 longhorn.SystemRestoreState
@@ -870,6 +876,96 @@ func (s *TestSuite) TestSystemRollout(c *C) {
 				},
 			},
 		},
+		"system rollout dry run": {
+			state:        longhorn.SystemRestoreStateRestoring,
+			isInProgress: true,
+			expectState:  longhorn.SystemRestoreStateCompleted,
+			dryRun:       true,
+
+			existSettings: map[SystemRolloutCRName]*longhorn.Setting{
+				SystemRolloutCRName(types.SettingNameDefaultReplicaCount): {Value: "2"},
+			},
+			backupSettings: map[SystemRolloutCRName]*longhorn.Setting{
+				SystemRolloutCRName(types.SettingNameDefaultReplicaCount): {Value: "3"},
+			},
+			backupVolumes: map[SystemRolloutCRName]*longhorn.Volume{
+				SystemRolloutCRName(TestVolumeName): {
+					Spec: longhorn.VolumeSpec{
+						NumberOfReplicas: 3,
+					},
+				},
+			},
+			expectDryRunReport: []longhorn.SystemRestoreResourceChange{
+				{
+					Kind:   types.KubernetesKindClusterRole,
+					Name:   TestClusterRoleName,
+					Action: longhorn.SystemRestoreResourceActionSkip,
+					Reason: SystemRolloutMsgIdentical,
+				},
+				{
+					Kind:      types.LonghornKindSetting,
+					Namespace: TestNamespace,
+					Name:      string(types.SettingNameDefaultReplicaCount),
+					Action:    longhorn.SystemRestoreResourceActionUpdate,
+				},
+				{
+					Kind:      types.LonghornKindVolume,
+					Namespace: TestNamespace,
+					Name:      TestVolumeName,
+					Action:    longhorn.SystemRestoreResourceActionCreate,
+				},
+			},
+		},
+		"system rollout dry run exclude kind": {
+			state:          longhorn.SystemRestoreStateRestoring,
+			isInProgress:   true,
+			expectState:    longhorn.SystemRestoreStateCompleted,
+			dryRun:         true,
+			restoreExclude: longhorn.SystemRestoreResourceFilter{Kinds: []string{types.LonghornKindVolume}},
+
+			backupVolumes: map[SystemRolloutCRName]*longhorn.Volume{
+				SystemRolloutCRName(TestVolumeName): {
+					Spec: longhorn.VolumeSpec{
+						NumberOfReplicas: 3,
+					},
+				},
+			},
+			expectDryRunReport: []longhorn.SystemRestoreResourceChange{
+				{
+					Kind:      types.LonghornKindVolume,
+					Namespace: TestNamespace,
+					Name:      TestVolumeName,
+					Action:    longhorn.SystemRestoreResourceActionSkip,
+					Reason:    fmt.Sprintf("kind %v is excluded", types.LonghornKindVolume),
+				},
+			},
+		},
+		"system rollout dry run include volume selector": {
+			state:        longhorn.SystemRestoreStateRestoring,
+			isInProgress: true,
+			expectState:  longhorn.SystemRestoreStateCompleted,
+			dryRun:       true,
+			restoreInclude: longhorn.SystemRestoreResourceFilter{
+				VolumeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "test"}},
+			},
+
+			backupVolumes: map[SystemRolloutCRName]*longhorn.Volume{
+				SystemRolloutCRName(TestVolumeName): {
+					Spec: longhorn.VolumeSpec{
+						NumberOfReplicas: 3,
+					},
+				},
+			},
+			expectDryRunReport: []longhorn.SystemRestoreResourceChange{
+				{
+					Kind:      types.LonghornKindVolume,
+					Namespace: TestNamespace,
+					Name:      TestVolumeName,
+					Action:    longhorn.SystemRestoreResourceActionSkip,
+					Reason:    "volume labels do not match the included volume selector",
+				},
+			},
+		},
 		"system rollout BackingImage exist in cluster": {
 			state:        longhorn.SystemRestoreStateRestoring,
 			isInProgress: true,
@@ -1026,6 +1122,16 @@ func (s *TestSuite) TestSystemRollout(c *C) {
 		controller.systemRestore, err = lhClient.LonghornV1beta2().SystemRestores(TestNamespace).Get(context.TODO(), tc.systemRestoreName, metav1.GetOptions{})
 		c.Assert(err, IsNil)
 
+		if tc.dryRun {
+			controller.systemRestore.Spec.DryRun = tc.dryRun
+			controller.systemRestore.Spec.Include = tc.restoreInclude
+			controller.systemRestore.Spec.Exclude = tc.restoreExclude
+			controller.systemRestore, err = lhClient.LonghornV1beta2().SystemRestores(TestNamespace).Update(context.TODO(), controller.systemRestore, metav1.UpdateOptions{})
+			c.Assert(err, IsNil)
+			err = informerFactories.LhInformerFactory.Longhorn().V1beta2().SystemRestores().Informer().GetIndexer().Update(controller.systemRestore)
+			c.Assert(err, IsNil)
+		}
+
 		rolloutTempDir, err := os.MkdirTemp(os.TempDir(), fmt.Sprintf("*-%v", TestSystemRestoreName))
 		c.Assert(err, IsNil)
 		tempDirs = append(tempDirs, rolloutTempDir)
@@ -1082,6 +1188,25 @@ func (s *TestSuite) TestSystemRollout(c *C) {
 		c.Assert(err, IsNil)
 		c.Assert(systemRestore.Status.State, Equals, tc.expectState)
 
+		if tc.dryRun {
+			c.Assert(systemRestore.Status.DryRunReport, NotNil)
+			for _, expectChange := range tc.expectDryRunReport {
+				found := false
+				for _, change := range systemRestore.Status.DryRunReport {
+					if change.Kind == expectChange.Kind && change.Name == expectChange.Name {
+						c.Assert(change, DeepEquals, expectChange)
+						found = true
+					}
+				}
+				c.Assert(found, Equals, true, Commentf("missing %v %v in dry run report", expectChange.Kind, expectChange.Name))
+			}
+
+			// Nothing is applied in dry run
+			assertRolloutVolumes(tc.existVolumes, nil, c, lhClient)
+			assertRolloutSettings(tc.existSettings, tc.existSettings, c, lhClient)
+			continue
+		}
+
 		if tc.expectState == longhorn.SystemRestoreStateCompleted {
 			assertRolloutClusterRoles(tc.expectRestoredClusterRoles, c, kubeClient)
 			assertRolloutClusterRoleBindings(tc.expectRestoredClusterRoleBindings, c, kubeClient)
@@ -1116,6 +1241,104 @@ func (s *TestSuite) TestSystemRollout(c *C) {
 	}
 }
 
+func (s *TestSuite) TestSystemRolloutRemapResources(c *C) {
+	storageClassName := TestStorageClassName
+	remappedStorageClassName := "remapped-storage-class"
+	remappedNamespace := "remapped-namespace"
+	remappedNode := "remapped-node"
+	remappedBackupTargetURL := "s3://remapped@us-east-1/"
+
+	controller := &SystemRolloutController{
+		systemRestore: &longhorn.SystemRestore{
+			Spec: longhorn.SystemRestoreSpec{
+				Remap: longhorn.SystemRestoreRemap{
+					NodeNames:         map[string]string{TestNode1: remappedNode},
+					StorageClassNames: map[string]string{TestStorageClassName: remappedStorageClassName},
+					Namespaces:        map[string]string{TestNamespace: remappedNamespace},
+					BackupTargets: map[string]longhorn.SystemRestoreBackupTargetOverride{
+						types.DefaultBackupTargetName: {BackupTargetURL: remappedBackupTargetURL},
+					},
+				},
+			},
+		},
+	}
+	controller.volumeList = &longhorn.VolumeList{
+		Items: []longhorn.Volume{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: TestVolumeName},
+				Spec:       longhorn.VolumeSpec{MigrationNodeID: TestNode1},
+			},
+		},
+	}
+	controller.persistentVolumeList = &corev1.PersistentVolumeList{
+		Items: []corev1.PersistentVolume{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: TestPVName},
+				Spec: corev1.PersistentVolumeSpec{
+					StorageClassName:       TestStorageClassName,
+					PersistentVolumeSource: newPVSourceCSI(),
+					ClaimRef:               &corev1.ObjectReference{Name: TestPVCName, Namespace: TestNamespace},
+					NodeAffinity: &corev1.VolumeNodeAffinity{
+						Required: &corev1.NodeSelector{
+							NodeSelectorTerms: []corev1.NodeSelectorTerm{
+								{
+									MatchExpressions: []corev1.NodeSelectorRequirement{
+										{
+											Key:      corev1.LabelHostname,
+											Operator: corev1.NodeSelectorOpIn,
+											Values:   []string{TestNode1, TestNode2},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	controller.persistentVolumeClaimList = &corev1.PersistentVolumeClaimList{
+		Items: []corev1.PersistentVolumeClaim{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: TestPVCName, Namespace: TestNamespace},
+				Spec: corev1.PersistentVolumeClaimSpec{
+					StorageClassName: &storageClassName,
+					VolumeName:       TestPVName,
+				},
+			},
+		},
+	}
+	controller.backupTargetList = &longhorn.BackupTargetList{
+		Items: []longhorn.BackupTarget{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: types.DefaultBackupTargetName},
+				Spec: longhorn.BackupTargetSpec{
+					BackupTargetURL:  "s3://backupbucket@us-east-1/",
+					CredentialSecret: "backup-target-secret",
+				},
+			},
+		},
+	}
+
+	controller.remapResources(logrus.StandardLogger())
+
+	c.Assert(controller.volumeList.Items[0].Spec.MigrationNodeID, Equals, remappedNode)
+
+	pv := controller.persistentVolumeList.Items[0]
+	c.Assert(pv.Spec.StorageClassName, Equals, remappedStorageClassName)
+	c.Assert(pv.Spec.ClaimRef.Namespace, Equals, remappedNamespace)
+	c.Assert(pv.Spec.NodeAffinity.Required.NodeSelectorTerms[0].MatchExpressions[0].Values, DeepEquals, []string{remappedNode, TestNode2})
+
+	pvc := controller.persistentVolumeClaimList.Items[0]
+	c.Assert(pvc.Namespace, Equals, remappedNamespace)
+	c.Assert(*pvc.Spec.StorageClassName, Equals, remappedStorageClassName)
+	c.Assert(storageClassName, Equals, TestStorageClassName)
+
+	backupTarget := controller.backupTargetList.Items[0]
+	c.Assert(backupTarget.Spec.BackupTargetURL, Equals, remappedBackupTargetURL)
+	c.Assert(backupTarget.Spec.CredentialSecret, Equals, "backup-target-secret")
+}
+
 func newFakeSystemRolloutController(
 	systemRestoreName, controllerID string,
 	ds *datastore.DataStore,
@@ -1444,7 +1667,7 @@ func fakeSystemBackupArchieve(c *C, systemBackupName, systemRolloutOwnerID, roll
 
 	systemBackup := fakeSystemBackup(systemBackupName, systemRolloutOwnerID, "", false, "", longhorn.SystemBackupStateGenerating, c, informerFactories.LhInformerFactory, lhClient)
 
-	systemBackupController.GenerateSystemBackup(systemBackup, downloadPath, tempDir)
+	systemBackupController.GenerateSystemBackup(systemBackup, downloadPath, tempDir, nil)
 	systemBackup, err = lhClient.LonghornV1beta2().SystemBackups(TestNamespace).Get(context.TODO(), systemBackupName, metav1.GetOptions{})
 	c.Assert(err, IsNil)
 	c.Assert(systemBackup.Status.State, Equals, longhorn.SystemBackupStateUploading)
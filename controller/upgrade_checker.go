@@ -0,0 +1,263 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/mod/semver"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// upgradeCheckerBackend returns the available Longhorn versions and the advisories for the upgrade checker.
+type upgradeCheckerBackend interface {
+	CheckUpgrade(req *CheckUpgradeRequest) (*CheckUpgradeResponse, error)
+}
+
+// upgradeResponderUpgradeCheckerBackend posts the cluster info to the upgrade responder.
+type upgradeResponderUpgradeCheckerBackend struct {
+	logger logrus.FieldLogger
+	url    string
+}
+
+// localManifestUpgradeCheckerBackend reads the version manifest from a ConfigMap or a file. The manifest has the same
+// format as the upgrade responder response.
+type localManifestUpgradeCheckerBackend struct {
+	ds            *datastore.DataStore
+	namespace     string
+	configMapName string
+	filePath      string
+}
+
+func (sc *SettingController) getUpgradeCheckerBackend() (upgradeCheckerBackend, error) {
+	backend, err := sc.ds.GetSettingValueExisted(types.SettingNameUpgradeCheckerBackend)
+	if err != nil {
+		return nil, err
+	}
+
+	switch types.UpgradeCheckerBackend(backend) {
+	case types.UpgradeCheckerBackendUpgradeResponder:
+		url, err := sc.ds.GetSettingValueExisted(types.SettingNameUpgradeResponderURL)
+		if err != nil {
+			return nil, err
+		}
+		return &upgradeResponderUpgradeCheckerBackend{
+			logger: sc.logger,
+			url:    url,
+		}, nil
+	case types.UpgradeCheckerBackendLocalManifest:
+		manifest, err := sc.ds.GetSettingValueExisted(types.SettingNameUpgradeCheckerLocalManifest)
+		if err != nil {
+			return nil, err
+		}
+		configMapName, filePath, err := types.ParseUpgradeCheckerLocalManifest(manifest)
+		if err != nil {
+			return nil, err
+		}
+		return &localManifestUpgradeCheckerBackend{
+			ds:            sc.ds,
+			namespace:     sc.namespace,
+			configMapName: configMapName,
+			filePath:      filePath,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unknown upgrade checker backend %v", backend)
+}
+
+func (b *upgradeResponderUpgradeCheckerBackend) CheckUpgrade(req *CheckUpgradeRequest) (*CheckUpgradeResponse, error) {
+	var (
+		resp    CheckUpgradeResponse
+		content bytes.Buffer
+	)
+
+	if err := json.NewEncoder(&content).Encode(req); err != nil {
+		return nil, err
+	}
+
+	r, err := http.Post(b.url, "application/json", &content)
+	if err != nil {
+		return nil, err
+	}
+	defer func(body io.ReadCloser) {
+		if closeErr := body.Close(); closeErr != nil {
+			b.logger.WithError(closeErr).Warn("Failed to close upgrade responder response body")
+		}
+	}(r.Body)
+	if r.StatusCode != http.StatusOK {
+		message := ""
+		messageBytes, err := io.ReadAll(r.Body)
+		if err != nil {
+			message = err.Error()
+		} else {
+			message = string(messageBytes)
+		}
+		return nil, fmt.Errorf("query return status code %v, message %v", r.StatusCode, message)
+	}
+	if err := json.NewDecoder(r.Body).Decode(&resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (b *localManifestUpgradeCheckerBackend) CheckUpgrade(req *CheckUpgradeRequest) (*CheckUpgradeResponse, error) {
+	var (
+		resp    CheckUpgradeResponse
+		content []byte
+	)
+
+	if b.configMapName != "" {
+		configMap, err := b.ds.GetConfigMapRO(b.namespace, b.configMapName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get version manifest ConfigMap %v", b.configMapName)
+		}
+		data, ok := configMap.Data[types.UpgradeCheckerLocalManifestConfigMapKey]
+		if !ok {
+			return nil, fmt.Errorf("cannot find key %v in version manifest ConfigMap %v", types.UpgradeCheckerLocalManifestConfigMapKey, b.configMapName)
+		}
+		content = []byte(data)
+	} else {
+		data, err := os.ReadFile(b.filePath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read version manifest file %v", b.filePath)
+		}
+		content = data
+	}
+
+	if err := json.Unmarshal(content, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse version manifest")
+	}
+
+	return &resp, nil
+}
+
+// updateUpgradeCheckerConditions reports the advisories for the current version and the upgrade path compatibility to
+// the latest version in the upgrade checker setting status. The conditions are removed if resp is nil.
+func (sc *SettingController) updateUpgradeCheckerConditions(resp *CheckUpgradeResponse, latestVersion string) error {
+	setting, err := sc.ds.GetSettingExact(types.SettingNameUpgradeChecker)
+	if err != nil {
+		return err
+	}
+	existingConditions := setting.DeepCopy().Status.Conditions
+
+	if resp == nil {
+		setting.Status.Conditions = types.RemoveCondition(setting.Status.Conditions, longhorn.SettingConditionTypeAdvisory)
+		setting.Status.Conditions = types.RemoveCondition(setting.Status.Conditions, longhorn.SettingConditionTypeUpgradePathCompatible)
+	} else {
+		advisories := getAdvisoriesForVersion(resp.Advisories, sc.version)
+		if len(advisories) > 0 {
+			setting.Status.Conditions = types.SetCondition(setting.Status.Conditions,
+				longhorn.SettingConditionTypeAdvisory, longhorn.ConditionStatusTrue,
+				longhorn.SettingConditionReasonKnownIssues,
+				fmt.Sprintf("Longhorn %v is affected by known issues: %v", sc.version, strings.Join(advisories, "; ")))
+		} else {
+			setting.Status.Conditions = types.SetCondition(setting.Status.Conditions,
+				longhorn.SettingConditionTypeAdvisory, longhorn.ConditionStatusFalse, "", "")
+		}
+
+		reason, message, err := sc.checkUpgradePathCompatibility(resp, latestVersion)
+		if err != nil {
+			return err
+		}
+		if reason != "" {
+			setting.Status.Conditions = types.SetCondition(setting.Status.Conditions,
+				longhorn.SettingConditionTypeUpgradePathCompatible, longhorn.ConditionStatusFalse, reason, message)
+		} else {
+			setting.Status.Conditions = types.SetCondition(setting.Status.Conditions,
+				longhorn.SettingConditionTypeUpgradePathCompatible, longhorn.ConditionStatusTrue, "", "")
+		}
+	}
+
+	if reflect.DeepEqual(existingConditions, setting.Status.Conditions) {
+		return nil
+	}
+	_, err = sc.ds.UpdateSettingStatus(setting)
+	return err
+}
+
+// getAdvisoriesForVersion returns the descriptions of the advisories affecting the given version, sorted by ID.
+func getAdvisoriesForVersion(advisories []Advisory, version string) []string {
+	affected := []Advisory{}
+	for _, advisory := range advisories {
+		if slices.Contains(advisory.AffectedVersions, version) {
+			affected = append(affected, advisory)
+		}
+	}
+	sort.Slice(affected, func(i, j int) bool { return affected[i].ID < affected[j].ID })
+
+	descriptions := []string{}
+	for _, advisory := range affected {
+		description := fmt.Sprintf("%v: %v", advisory.ID, advisory.Summary)
+		if advisory.FixedVersion != "" {
+			description += fmt.Sprintf(" (fixed in %v)", advisory.FixedVersion)
+		}
+		if advisory.URL != "" {
+			description += fmt.Sprintf(" %v", advisory.URL)
+		}
+		descriptions = append(descriptions, description)
+	}
+	return descriptions
+}
+
+// checkUpgradePathCompatibility checks if the current version and the engine images in use can be upgraded to the
+// latest version directly. It returns the reason and the message if not.
+func (sc *SettingController) checkUpgradePathCompatibility(resp *CheckUpgradeResponse, latestVersion string) (reason, message string, err error) {
+	var latest *Version
+	for i := range resp.Versions {
+		if resp.Versions[i].Name == latestVersion {
+			latest = &resp.Versions[i]
+			break
+		}
+	}
+	if latest == nil {
+		return "", "", nil
+	}
+
+	if semver.IsValid(sc.version) && semver.IsValid(latest.MinUpgradableVersion) &&
+		semver.Compare(sc.version, latest.Name) < 0 &&
+		semver.Compare(sc.version, latest.MinUpgradableVersion) < 0 {
+		return longhorn.SettingConditionReasonUnsupportedUpgradePath,
+			fmt.Sprintf("Longhorn %v cannot be upgraded to %v directly, upgrade to %v or later first", sc.version, latest.Name, latest.MinUpgradableVersion),
+			nil
+	}
+
+	if latest.MinEngineCLIAPIVersion <= 0 {
+		return "", "", nil
+	}
+
+	engineImages, err := sc.ds.ListEngineImages()
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to list engine images for upgrade path compatibility check")
+	}
+	incompatibleImages := []string{}
+	for _, ei := range engineImages {
+		if ei.Status.RefCount == 0 {
+			continue
+		}
+		if ei.Status.CLIAPIVersion < latest.MinEngineCLIAPIVersion {
+			incompatibleImages = append(incompatibleImages, ei.Spec.Image)
+		}
+	}
+	if len(incompatibleImages) > 0 {
+		sort.Strings(incompatibleImages)
+		return longhorn.SettingConditionReasonIncompatibleEngineImages,
+			fmt.Sprintf("Engine images %v in use are not supported by Longhorn %v, upgrade the volume engines first", strings.Join(incompatibleImages, ", "), latest.Name),
+			nil
+	}
+
+	return "", "", nil
+}
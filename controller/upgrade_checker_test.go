@@ -0,0 +1,197 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/kubernetes/pkg/controller"
+
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	lhfake "github.com/longhorn/longhorn-manager/k8s/pkg/client/clientset/versioned/fake"
+
+	. "gopkg.in/check.v1"
+)
+
+const (
+	TestUpgradeManifestConfigMapName = "test-upgrade-manifest"
+)
+
+type UpgradeCheckerTestCase struct {
+	version                  string
+	manifest                 CheckUpgradeResponse
+	engineImageCLIAPIVersion int
+
+	expectedLatestVersion  string
+	expectedStableVersions string
+	expectedConditions     map[string]longhorn.ConditionStatus
+	expectedReasons        map[string]string
+}
+
+func newTestSettingController(lhClient *lhfake.Clientset, kubeClient *fake.Clientset, extensionsClient *apiextensionsfake.Clientset, informerFactories *util.InformerFactories, version string) (*SettingController, error) {
+	ds := datastore.NewDataStore(TestNamespace, lhClient, kubeClient, extensionsClient, informerFactories)
+
+	logger := logrus.StandardLogger()
+	sc, err := NewSettingController(logger, ds, scheme.Scheme, kubeClient, nil, TestNamespace, TestNode1, version)
+	if err != nil {
+		return nil, err
+	}
+
+	sc.eventRecorder = record.NewFakeRecorder(100)
+	for index := range sc.cacheSyncs {
+		sc.cacheSyncs[index] = alwaysReady
+	}
+
+	return sc, nil
+}
+
+func (s *TestSuite) TestSyncUpgradeCheckerLocalManifest(c *C) {
+	versions := []Version{
+		{Name: "v1.6.2", Tags: []string{VersionTagStable}},
+		{Name: "v1.7.1", Tags: []string{VersionTagLatest, VersionTagStable}, MinUpgradableVersion: "v1.6.0", MinEngineCLIAPIVersion: 8},
+	}
+
+	testCases := map[string]UpgradeCheckerTestCase{
+		"compatible upgrade path": {
+			version:                  "v1.6.2",
+			manifest:                 CheckUpgradeResponse{Versions: versions},
+			engineImageCLIAPIVersion: 8,
+
+			expectedLatestVersion:  "v1.7.1",
+			expectedStableVersions: "v1.6.2,v1.7.1",
+			expectedConditions: map[string]longhorn.ConditionStatus{
+				longhorn.SettingConditionTypeAdvisory:              longhorn.ConditionStatusFalse,
+				longhorn.SettingConditionTypeUpgradePathCompatible: longhorn.ConditionStatusTrue,
+			},
+		},
+		"known issues of current version": {
+			version: "v1.6.2",
+			manifest: CheckUpgradeResponse{
+				Versions: versions,
+				Advisories: []Advisory{
+					{ID: "LH-2", Summary: "issue 2", AffectedVersions: []string{"v1.6.2"}, FixedVersion: "v1.7.1"},
+					{ID: "LH-1", Summary: "issue 1", AffectedVersions: []string{"v1.5.0"}},
+				},
+			},
+			engineImageCLIAPIVersion: 8,
+
+			expectedLatestVersion:  "v1.7.1",
+			expectedStableVersions: "v1.6.2,v1.7.1",
+			expectedConditions: map[string]longhorn.ConditionStatus{
+				longhorn.SettingConditionTypeAdvisory:              longhorn.ConditionStatusTrue,
+				longhorn.SettingConditionTypeUpgradePathCompatible: longhorn.ConditionStatusTrue,
+			},
+			expectedReasons: map[string]string{
+				longhorn.SettingConditionTypeAdvisory: longhorn.SettingConditionReasonKnownIssues,
+			},
+		},
+		"unsupported upgrade path": {
+			version:                  "v1.5.3",
+			manifest:                 CheckUpgradeResponse{Versions: versions},
+			engineImageCLIAPIVersion: 8,
+
+			expectedLatestVersion:  "v1.7.1",
+			expectedStableVersions: "v1.6.2,v1.7.1",
+			expectedConditions: map[string]longhorn.ConditionStatus{
+				longhorn.SettingConditionTypeAdvisory:              longhorn.ConditionStatusFalse,
+				longhorn.SettingConditionTypeUpgradePathCompatible: longhorn.ConditionStatusFalse,
+			},
+			expectedReasons: map[string]string{
+				longhorn.SettingConditionTypeUpgradePathCompatible: longhorn.SettingConditionReasonUnsupportedUpgradePath,
+			},
+		},
+		"incompatible engine images": {
+			version:                  "v1.6.2",
+			manifest:                 CheckUpgradeResponse{Versions: versions},
+			engineImageCLIAPIVersion: 7,
+
+			expectedLatestVersion:  "v1.7.1",
+			expectedStableVersions: "v1.6.2,v1.7.1",
+			expectedConditions: map[string]longhorn.ConditionStatus{
+				longhorn.SettingConditionTypeAdvisory:              longhorn.ConditionStatusFalse,
+				longhorn.SettingConditionTypeUpgradePathCompatible: longhorn.ConditionStatusFalse,
+			},
+			expectedReasons: map[string]string{
+				longhorn.SettingConditionTypeUpgradePathCompatible: longhorn.SettingConditionReasonIncompatibleEngineImages,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		c.Logf("testing %v", name)
+
+		kubeClient := fake.NewSimpleClientset()
+		lhClient := lhfake.NewSimpleClientset()
+		extensionsClient := apiextensionsfake.NewSimpleClientset()
+		informerFactories := util.NewInformerFactories(TestNamespace, kubeClient, lhClient, controller.NoResyncPeriodFunc())
+
+		settingIndexer := informerFactories.LhInformerFactory.Longhorn().V1beta2().Settings().Informer().GetIndexer()
+		eiIndexer := informerFactories.LhInformerFactory.Longhorn().V1beta2().EngineImages().Informer().GetIndexer()
+		cmIndexer := informerFactories.KubeNamespaceFilteredInformerFactory.Core().V1().ConfigMaps().Informer().GetIndexer()
+
+		sc, err := newTestSettingController(lhClient, kubeClient, extensionsClient, informerFactories, tc.version)
+		c.Assert(err, IsNil)
+
+		for sName, value := range map[types.SettingName]string{
+			types.SettingNameUpgradeChecker:              "true",
+			types.SettingNameUpgradeCheckerBackend:       string(types.UpgradeCheckerBackendLocalManifest),
+			types.SettingNameUpgradeCheckerLocalManifest: types.UpgradeCheckerLocalManifestConfigMapPrefix + TestUpgradeManifestConfigMapName,
+			types.SettingNameLatestLonghornVersion:       "",
+			types.SettingNameStableLonghornVersions:      "",
+		} {
+			setting, err := lhClient.LonghornV1beta2().Settings(TestNamespace).Create(context.TODO(), newSetting(string(sName), value), metav1.CreateOptions{})
+			c.Assert(err, IsNil)
+			err = settingIndexer.Add(setting)
+			c.Assert(err, IsNil)
+		}
+
+		manifest, err := json.Marshal(tc.manifest)
+		c.Assert(err, IsNil)
+		err = cmIndexer.Add(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      TestUpgradeManifestConfigMapName,
+				Namespace: TestNamespace,
+			},
+			Data: map[string]string{
+				types.UpgradeCheckerLocalManifestConfigMapKey: string(manifest),
+			},
+		})
+		c.Assert(err, IsNil)
+
+		ei := newEngineImage(TestEngineImage, longhorn.EngineImageStateDeployed)
+		ei.Status.RefCount = 1
+		ei.Status.CLIAPIVersion = tc.engineImageCLIAPIVersion
+		err = eiIndexer.Add(ei)
+		c.Assert(err, IsNil)
+
+		err = sc.syncUpgradeChecker()
+		c.Assert(err, IsNil)
+
+		latestVersion, err := lhClient.LonghornV1beta2().Settings(TestNamespace).Get(context.TODO(), string(types.SettingNameLatestLonghornVersion), metav1.GetOptions{})
+		c.Assert(err, IsNil)
+		c.Assert(latestVersion.Value, Equals, tc.expectedLatestVersion)
+		stableVersions, err := lhClient.LonghornV1beta2().Settings(TestNamespace).Get(context.TODO(), string(types.SettingNameStableLonghornVersions), metav1.GetOptions{})
+		c.Assert(err, IsNil)
+		c.Assert(stableVersions.Value, Equals, tc.expectedStableVersions)
+
+		upgradeChecker, err := lhClient.LonghornV1beta2().Settings(TestNamespace).Get(context.TODO(), string(types.SettingNameUpgradeChecker), metav1.GetOptions{})
+		c.Assert(err, IsNil)
+		for conditionType, status := range tc.expectedConditions {
+			condition := types.GetCondition(upgradeChecker.Status.Conditions, conditionType)
+			c.Assert(condition.Status, Equals, status, Commentf("condition %v", conditionType))
+			c.Assert(condition.Reason, Equals, tc.expectedReasons[conditionType], Commentf("condition %v", conditionType))
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -24,6 +25,7 @@ import (
 	"github.com/longhorn/longhorn-manager/datastore"
 	"github.com/longhorn/longhorn-manager/types"
 	"github.com/longhorn/longhorn-manager/util"
+	"github.com/longhorn/longhorn-manager/util/tracing"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
@@ -301,6 +303,12 @@ func (vac *VolumeAttachmentController) reconcile(vaName string) (err error) {
 		return err
 	}
 
+	// Continue the trace of the request changing the attachment tickets
+	ctx, span := tracing.StartSpanFromAnnotations(va, "VolumeAttachmentController.reconcile", attribute.String("volume", vol.Name))
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
 	existingVA := va.DeepCopy()
 	existingVol := vol.DeepCopy()
 	defer func() {
@@ -308,6 +316,7 @@ func (vac *VolumeAttachmentController) reconcile(vaName string) (err error) {
 			return
 		}
 		if !reflect.DeepEqual(existingVol.Spec, vol.Spec) {
+			tracing.InjectIntoAnnotations(ctx, vol)
 			if _, err = vac.ds.UpdateVolume(vol); err != nil {
 				return
 			}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -39,6 +40,7 @@ import (
 	"github.com/longhorn/longhorn-manager/scheduler"
 	"github.com/longhorn/longhorn-manager/types"
 	"github.com/longhorn/longhorn-manager/util"
+	"github.com/longhorn/longhorn-manager/util/tracing"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
@@ -440,6 +442,12 @@ func (c *VolumeController) syncVolume(key string) (err error) {
 		return c.ds.RemoveFinalizerForVolume(volume)
 	}
 
+	// Continue the trace of the request changing the volume spec
+	ctx, span := tracing.StartSpanFromAnnotations(volume, "VolumeController.syncVolume", attribute.String("volume", volume.Name))
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
 	existingVolume := volume.DeepCopy()
 	existingEngines := map[string]*longhorn.Engine{}
 	for k, e := range engines {
@@ -456,6 +464,7 @@ func (c *VolumeController) syncVolume(key string) (err error) {
 		for k, r := range replicas {
 			if existingReplicas[k] == nil ||
 				!reflect.DeepEqual(existingReplicas[k].Spec, r.Spec) {
+				tracing.InjectIntoAnnotations(ctx, r)
 				if _, err := c.ds.UpdateReplica(r); err != nil {
 					lastErr = err
 				}
@@ -466,6 +475,7 @@ func (c *VolumeController) syncVolume(key string) (err error) {
 			for k, e := range engines {
 				if existingEngines[k] == nil ||
 					!reflect.DeepEqual(existingEngines[k].Spec, e.Spec) {
+					tracing.InjectIntoAnnotations(ctx, e)
 					if _, err := c.ds.UpdateEngine(e); err != nil {
 						lastErr = err
 					}
@@ -507,6 +517,8 @@ func (c *VolumeController) syncVolume(key string) (err error) {
 		return err
 	}
 
+	c.syncVolumeIOQoS(volume, engines)
+
 	if err := c.updateRecurringJobs(volume); err != nil {
 		return err
 	}
@@ -1282,6 +1294,13 @@ func (c *VolumeController) syncVolumeSnapshotSetting(v *longhorn.Volume, es map[
 	return nil
 }
 
+// syncVolumeIOQoS propagates the volume IO QoS to the engines, which apply it to the running frontend.
+func (c *VolumeController) syncVolumeIOQoS(v *longhorn.Volume, es map[string]*longhorn.Engine) {
+	for _, e := range es {
+		e.Spec.IOQoS = v.Spec.IOQoS
+	}
+}
+
 // ReconcileVolumeState handles the attaching and detaching of volume
 func (c *VolumeController) ReconcileVolumeState(v *longhorn.Volume, es map[string]*longhorn.Engine, rs map[string]*longhorn.Replica) (err error) {
 	defer func() {
@@ -1330,6 +1349,10 @@ func (c *VolumeController) ReconcileVolumeState(v *longhorn.Volume, es map[strin
 		return err
 	}
 
+	if err := c.reconcileVolumeHealth(v, e, rs); err != nil {
+		return err
+	}
+
 	if err := c.reconcileVolumeSize(v, e, rs); err != nil {
 		return err
 	}
@@ -1960,6 +1983,7 @@ func (c *VolumeController) areVolumeDependentResourcesOpened(e *longhorn.Engine,
 }
 
 func (c *VolumeController) closeVolumeDependentResources(v *longhorn.Volume, e *longhorn.Engine, rs map[string]*longhorn.Replica) {
+	existingRestoreCondition := types.GetCondition(v.Status.Conditions, longhorn.VolumeConditionTypeRestore)
 	v.Status.Conditions = types.SetCondition(v.Status.Conditions,
 		longhorn.VolumeConditionTypeRestore, longhorn.ConditionStatusFalse, "", "")
 
@@ -1967,6 +1991,9 @@ func (c *VolumeController) closeVolumeDependentResources(v *longhorn.Volume, e *
 		v.Status.Robustness = longhorn.VolumeRobustnessUnknown
 	} else {
 		if v.Status.RestoreRequired || v.Status.IsStandby {
+			if existingRestoreCondition.Reason != longhorn.VolumeConditionReasonRestoreFailure && !v.Status.IsStandby {
+				c.observeFinishedRestore(v, e.Spec.RequestedBackupRestore, false)
+			}
 			v.Status.Conditions = types.SetCondition(v.Status.Conditions,
 				longhorn.VolumeConditionTypeRestore, longhorn.ConditionStatusFalse, longhorn.VolumeConditionReasonRestoreFailure, "All replica restore failed and the volume became Faulted")
 		}
@@ -2504,15 +2531,6 @@ func (c *VolumeController) getReplicaCountForAutoBalanceBestEffort(v *longhorn.V
 }
 
 func (c *VolumeController) getReplicasUnderDiskPressure() (map[string]bool, error) {
-	settingDiskPressurePercentage, err := c.ds.GetSettingAsInt(types.SettingNameReplicaAutoBalanceDiskPressurePercentage)
-	if err != nil {
-		return nil, err
-	}
-
-	if settingDiskPressurePercentage == 0 {
-		return nil, nil
-	}
-
 	nodes, err := c.ds.ListNodesRO()
 	if err != nil {
 		return nil, err
@@ -2528,12 +2546,21 @@ func (c *VolumeController) getReplicasUnderDiskPressure() (map[string]bool, erro
 		for diskName, diskStatus := range node.Status.DiskStatus {
 			diskSpec := node.Spec.Disks[diskName]
 
-			diskInfo, err := c.scheduler.GetDiskSchedulingInfo(diskSpec, diskStatus)
+			// The setting can be overridden for the node and the disk
+			diskPressurePercentage, err := c.ds.GetSettingAsIntForDisk(types.SettingNameReplicaAutoBalanceDiskPressurePercentage, node, diskSpec)
+			if err != nil {
+				return nil, err
+			}
+			if diskPressurePercentage == 0 {
+				continue
+			}
+
+			diskInfo, err := c.scheduler.GetDiskSchedulingInfo(node, diskSpec, diskStatus)
 			if err != nil {
 				return nil, err
 			}
 
-			if c.scheduler.IsDiskUnderPressure(settingDiskPressurePercentage, diskInfo) {
+			if c.scheduler.IsDiskUnderPressure(diskPressurePercentage, diskInfo) {
 				for replicaName := range diskStatus.ScheduledReplica {
 					replicasInPressure[replicaName] = true
 				}
@@ -2572,15 +2599,6 @@ func (c *VolumeController) checkReplicaDiskPressuredSchedulableCandidates(volume
 		"replica": replica.Name,
 	})
 
-	diskPressurePercentage, err := c.ds.GetSettingAsInt(types.SettingNameReplicaAutoBalanceDiskPressurePercentage)
-	if err != nil {
-		return err
-	}
-
-	if diskPressurePercentage == 0 {
-		return errors.Errorf("%v setting is 0, skip auto-balance replicas in disk pressure", types.SettingNameReplicaAutoBalanceDiskPressurePercentage)
-	}
-
 	nodes, err := c.ds.ListNodesRO()
 	if err != nil {
 		return err
@@ -2596,6 +2614,10 @@ func (c *VolumeController) checkReplicaDiskPressuredSchedulableCandidates(volume
 		break
 	}
 
+	if nodeCandidate == nil {
+		return errors.Errorf("cannot find node %v of replica in disk pressure", replica.Spec.NodeID)
+	}
+
 	// Known issue: There can be a delay up to 30 seconds for the disk storage
 	// usage to reflect after a replica is removed from the disk. This can
 	// cause additional replica to be rebuilt before the node controller's disk
@@ -2624,7 +2646,16 @@ func (c *VolumeController) checkReplicaDiskPressuredSchedulableCandidates(volume
 			continue
 		}
 
-		diskInfo, err := c.scheduler.GetDiskSchedulingInfo(diskSpec, diskStatus)
+		// Skip the disks not taking part in the auto-balance of replicas in disk pressure
+		diskPressurePercentage, err := c.ds.GetSettingAsIntForDisk(types.SettingNameReplicaAutoBalanceDiskPressurePercentage, nodeCandidate, diskSpec)
+		if err != nil {
+			return err
+		}
+		if diskPressurePercentage == 0 {
+			continue
+		}
+
+		diskInfo, err := c.scheduler.GetDiskSchedulingInfo(nodeCandidate, diskSpec, diskStatus)
 		if err != nil {
 			log.WithError(err).Debugf("Failed to get disk scheduling info for disk %v on node %v", diskName, nodeCandidate.Name)
 			continue
@@ -3470,6 +3501,9 @@ func (c *VolumeController) checkAndFinishVolumeRestore(v *longhorn.Volume, e *lo
 
 	if !isPurging && ((v.Status.Robustness == longhorn.VolumeRobustnessHealthy && allScheduledReplicasIncluded) || (v.Status.Robustness == longhorn.VolumeRobustnessDegraded && degradedVolumeSupported)) {
 		log.Infof("Restore/DR volume finished with the last restored backup %s", e.Status.LastRestoredBackup)
+		if v.Status.RestoreRequired && !v.Status.IsStandby {
+			c.observeFinishedRestore(v, e.Status.LastRestoredBackup, true)
+		}
 		v.Status.IsStandby = false
 		v.Status.RestoreRequired = false
 	}
@@ -3477,6 +3511,21 @@ func (c *VolumeController) checkAndFinishVolumeRestore(v *longhorn.Volume, e *lo
 	return nil
 }
 
+// observeFinishedRestore records the duration and the transferred data size of the restore volume once the restore
+// is finished. The DR volumes keep restoring the incremental backups until activated, hence they are skipped.
+func (c *VolumeController) observeFinishedRestore(v *longhorn.Volume, backupName string, succeeded bool) {
+	transferredBytes := int64(0)
+	if succeeded && backupName != "" {
+		backup, err := c.ds.GetBackupRO(backupName)
+		if err != nil {
+			getLoggerForVolume(c.logger, v).WithError(err).Warnf("Failed to get the restored backup %v", backupName)
+		} else if transferredBytes, err = util.ConvertSize(backup.Status.Size); err != nil {
+			getLoggerForVolume(c.logger, v).WithError(err).Warnf("Failed to parse the size of the restored backup %v", backupName)
+		}
+	}
+	backupLifecycleMetric.ObserveRestore(succeeded, time.Since(v.CreationTimestamp.Time), transferredBytes)
+}
+
 func (c *VolumeController) checkAllScheduledReplicasIncluded(v *longhorn.Volume, e *longhorn.Engine, rs map[string]*longhorn.Replica) (bool, error) {
 	healthReplicaCount := 0
 	hasReplicaNotIncluded := false
@@ -3658,6 +3707,7 @@ func (c *VolumeController) createEngine(v *longhorn.Volume, currentEngineName st
 			RevisionCounterDisabled:   v.Spec.RevisionCounterDisabled,
 			SnapshotMaxCount:          v.Spec.SnapshotMaxCount,
 			SnapshotMaxSize:           v.Spec.SnapshotMaxSize,
+			IOQoS:                     v.Spec.IOQoS,
 		},
 	}
 
@@ -32,6 +32,26 @@ import (
 	. "gopkg.in/check.v1"
 )
 
+// maskVolumeHealthConditions removes the volume health conditions. The health assessment is covered by
+// TestAssessVolumeHealth.
+func maskVolumeHealthConditions(conditions []longhorn.Condition) []longhorn.Condition {
+	healthConditionTypes := map[string]bool{
+		longhorn.VolumeConditionTypeReplicaRedundancy: true,
+		longhorn.VolumeConditionTypeReplicaSpread:     true,
+		longhorn.VolumeConditionTypeBackupRPO:         true,
+		longhorn.VolumeConditionTypeSnapshotCount:     true,
+		longhorn.VolumeConditionTypeDataIntegrity:     true,
+		longhorn.VolumeConditionTypeIOLatency:         true,
+	}
+	ret := []longhorn.Condition{}
+	for _, condition := range conditions {
+		if !healthConditionTypes[condition.Type] {
+			ret = append(ret, condition)
+		}
+	}
+	return ret
+}
+
 func getVolumeLabelSelector(volumeName string) string {
 	return "longhornvolume=" + volumeName
 }
@@ -1521,6 +1541,8 @@ func (s *TestSuite) runTestCases(c *C, testCases map[string]*VolumeTestCase) {
 			condition.LastTransitionTime = ""
 			retV.Status.Conditions[ctype] = condition
 		}
+		retV.Status.Conditions = maskVolumeHealthConditions(retV.Status.Conditions)
+		retV.Status.HealthScore = 0
 		c.Assert(retV.Status, DeepEquals, tc.expectVolume.Status)
 
 		retEs, err := lhClient.LonghornV1beta2().Engines(TestNamespace).List(context.TODO(), metav1.ListOptions{LabelSelector: getVolumeLabelSelector(v.Name)})
@@ -0,0 +1,285 @@
+package controller
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	etypes "github.com/longhorn/longhorn-engine/pkg/types"
+
+	"github.com/longhorn/longhorn-manager/types"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+const (
+	// The weights of the health conditions in the volume health score. They sum up to 100.
+	volumeHealthWeightReplicaRedundancy = 35
+	volumeHealthWeightReplicaSpread     = 15
+	volumeHealthWeightBackupRPO         = 15
+	volumeHealthWeightSnapshotCount     = 10
+	volumeHealthWeightDataIntegrity     = 15
+	volumeHealthWeightIOLatency         = 10
+
+	// The volume doesn't meet the snapshot count objective once the snapshot count reaches this percentage of the
+	// snapshot max count.
+	volumeHealthSnapshotCountPercentage = 90
+)
+
+// volumeHealthInput contains the facts the volume health is assessed from
+type volumeHealthInput struct {
+	desiredReplicaCount int
+	// healthyReplicaNodes are the nodes of the healthy replicas
+	healthyReplicaNodes []string
+	// nodeZones is the zone of each node in the cluster
+	nodeZones map[string]string
+
+	backupRPO    time.Duration
+	lastBackupAt string
+
+	snapshotCount    int
+	snapshotMaxCount int
+
+	// dataIntegrityErrors is the data integrity check failure of each snapshot
+	dataIntegrityErrors map[string]string
+	slowReplicas        []string
+
+	now time.Time
+}
+
+// volumeHealthCheck is the result of one health condition. The ratio is the fraction of the weight the volume
+// earns in the health score.
+type volumeHealthCheck struct {
+	conditionType string
+	weight        int
+	ratio         float64
+	reason        string
+	message       string
+}
+
+func (c volumeHealthCheck) met() bool {
+	return c.reason == ""
+}
+
+// assessVolumeHealth returns the health score from 0 to 100 and the result of each health condition.
+func assessVolumeHealth(in *volumeHealthInput) (int, []volumeHealthCheck) {
+	checks := []volumeHealthCheck{
+		checkVolumeReplicaRedundancy(in),
+		checkVolumeReplicaSpread(in),
+		checkVolumeBackupRPO(in),
+		checkVolumeSnapshotCount(in),
+		checkVolumeDataIntegrity(in),
+		checkVolumeIOLatency(in),
+	}
+
+	score := 0.0
+	for _, check := range checks {
+		score += float64(check.weight) * check.ratio
+	}
+	return int(math.Round(score)), checks
+}
+
+func checkVolumeReplicaRedundancy(in *volumeHealthInput) volumeHealthCheck {
+	check := volumeHealthCheck{
+		conditionType: longhorn.VolumeConditionTypeReplicaRedundancy,
+		weight:        volumeHealthWeightReplicaRedundancy,
+		ratio:         1,
+	}
+	healthyCount := len(in.healthyReplicaNodes)
+	if in.desiredReplicaCount > 0 && healthyCount < in.desiredReplicaCount {
+		check.ratio = float64(healthyCount) / float64(in.desiredReplicaCount)
+		check.reason = longhorn.VolumeConditionReasonInsufficientHealthyReplicas
+		check.message = fmt.Sprintf("%v healthy replicas out of desired %v", healthyCount, in.desiredReplicaCount)
+	}
+	return check
+}
+
+// checkVolumeReplicaSpread checks if the healthy replicas spread over as many nodes and zones as the cluster allows.
+func checkVolumeReplicaSpread(in *volumeHealthInput) volumeHealthCheck {
+	check := volumeHealthCheck{
+		conditionType: longhorn.VolumeConditionTypeReplicaSpread,
+		weight:        volumeHealthWeightReplicaSpread,
+		ratio:         1,
+	}
+
+	usedNodes := map[string]bool{}
+	usedZones := map[string]bool{}
+	for _, node := range in.healthyReplicaNodes {
+		usedNodes[node] = true
+		if zone := in.nodeZones[node]; zone != "" {
+			usedZones[zone] = true
+		}
+	}
+	clusterZones := map[string]bool{}
+	for _, zone := range in.nodeZones {
+		if zone != "" {
+			clusterZones[zone] = true
+		}
+	}
+
+	healthyCount := len(in.healthyReplicaNodes)
+	if len(usedNodes) < healthyCount && len(usedNodes) < len(in.nodeZones) {
+		check.ratio = 0
+		check.reason = longhorn.VolumeConditionReasonReplicasOnSameNode
+		check.message = fmt.Sprintf("%v healthy replicas are on %v nodes", healthyCount, len(usedNodes))
+		return check
+	}
+	if len(usedZones) < healthyCount && len(usedZones) < len(clusterZones) {
+		check.ratio = 0
+		check.reason = longhorn.VolumeConditionReasonReplicasOnSameZone
+		check.message = fmt.Sprintf("%v healthy replicas are in %v zones out of %v", healthyCount, len(usedZones), len(clusterZones))
+	}
+	return check
+}
+
+func checkVolumeBackupRPO(in *volumeHealthInput) volumeHealthCheck {
+	check := volumeHealthCheck{
+		conditionType: longhorn.VolumeConditionTypeBackupRPO,
+		weight:        volumeHealthWeightBackupRPO,
+		ratio:         1,
+	}
+	if in.backupRPO <= 0 {
+		return check
+	}
+
+	if in.lastBackupAt == "" {
+		check.ratio = 0
+		check.reason = longhorn.VolumeConditionReasonBackupRPOExceeded
+		check.message = fmt.Sprintf("volume has no backup within the RPO %v", in.backupRPO)
+		return check
+	}
+	lastBackupAt, err := time.Parse(time.RFC3339, in.lastBackupAt)
+	if err != nil {
+		check.ratio = 0
+		check.reason = longhorn.VolumeConditionReasonBackupRPOExceeded
+		check.message = fmt.Sprintf("failed to parse the last backup time %v: %v", in.lastBackupAt, err)
+		return check
+	}
+	if age := in.now.Sub(lastBackupAt); age > in.backupRPO {
+		check.ratio = 0
+		check.reason = longhorn.VolumeConditionReasonBackupRPOExceeded
+		check.message = fmt.Sprintf("last backup at %v is older than the RPO %v", in.lastBackupAt, in.backupRPO)
+	}
+	return check
+}
+
+func checkVolumeSnapshotCount(in *volumeHealthInput) volumeHealthCheck {
+	check := volumeHealthCheck{
+		conditionType: longhorn.VolumeConditionTypeSnapshotCount,
+		weight:        volumeHealthWeightSnapshotCount,
+		ratio:         1,
+	}
+	if in.snapshotMaxCount > 0 && in.snapshotCount*100 >= in.snapshotMaxCount*volumeHealthSnapshotCountPercentage {
+		check.ratio = 0
+		check.reason = longhorn.VolumeConditionReasonSnapshotCountNearLimit
+		check.message = fmt.Sprintf("snapshot count %v is near the max count %v", in.snapshotCount, in.snapshotMaxCount)
+	}
+	return check
+}
+
+func checkVolumeDataIntegrity(in *volumeHealthInput) volumeHealthCheck {
+	check := volumeHealthCheck{
+		conditionType: longhorn.VolumeConditionTypeDataIntegrity,
+		weight:        volumeHealthWeightDataIntegrity,
+		ratio:         1,
+	}
+	if len(in.dataIntegrityErrors) == 0 {
+		return check
+	}
+
+	snapshots := []string{}
+	for snapshot := range in.dataIntegrityErrors {
+		snapshots = append(snapshots, snapshot)
+	}
+	sort.Strings(snapshots)
+	check.ratio = 0
+	check.reason = longhorn.VolumeConditionReasonDataIntegrityCheckFailed
+	check.message = fmt.Sprintf("data integrity check failed for snapshots %v", strings.Join(snapshots, ", "))
+	return check
+}
+
+func checkVolumeIOLatency(in *volumeHealthInput) volumeHealthCheck {
+	check := volumeHealthCheck{
+		conditionType: longhorn.VolumeConditionTypeIOLatency,
+		weight:        volumeHealthWeightIOLatency,
+		ratio:         1,
+	}
+	if len(in.slowReplicas) == 0 {
+		return check
+	}
+
+	slowReplicas := append([]string{}, in.slowReplicas...)
+	sort.Strings(slowReplicas)
+	check.ratio = 0
+	check.reason = longhorn.VolumeConditionReasonSlowReplica
+	check.message = fmt.Sprintf("slow replicas %v", strings.Join(slowReplicas, ", "))
+	return check
+}
+
+// reconcileVolumeHealth assesses the volume health and records the health conditions and score in the volume status.
+func (c *VolumeController) reconcileVolumeHealth(v *longhorn.Volume, e *longhorn.Engine, rs map[string]*longhorn.Replica) error {
+	nodes, err := c.ds.ListNodesRO()
+	if err != nil {
+		return errors.Wrap(err, "failed to list nodes for assessing volume health")
+	}
+	backupRPO, err := c.ds.GetSettingAsInt(types.SettingNameVolumeHealthBackupRPO)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get %v setting", types.SettingNameVolumeHealthBackupRPO)
+	}
+	snapshots, err := c.ds.ListVolumeSnapshotsRO(v.Name)
+	if err != nil {
+		return errors.Wrap(err, "failed to list snapshots for assessing volume health")
+	}
+
+	in := &volumeHealthInput{
+		desiredReplicaCount: v.Spec.NumberOfReplicas,
+		healthyReplicaNodes: []string{},
+		nodeZones:           map[string]string{},
+		backupRPO:           time.Duration(backupRPO) * time.Minute,
+		lastBackupAt:        v.Status.LastBackupAt,
+		snapshotMaxCount:    v.Spec.SnapshotMaxCount,
+		dataIntegrityErrors: map[string]string{},
+		slowReplicas:        []string{},
+		now:                 time.Now(),
+	}
+	for _, node := range nodes {
+		in.nodeZones[node.Name] = node.Status.Zone
+	}
+	for _, r := range rs {
+		if !isHealthyAndActiveReplica(r) {
+			continue
+		}
+		in.healthyReplicaNodes = append(in.healthyReplicaNodes, r.Spec.NodeID)
+		if types.GetCondition(r.Status.Conditions, longhorn.ReplicaConditionTypeSlow).Status == longhorn.ConditionStatusTrue {
+			in.slowReplicas = append(in.slowReplicas, r.Name)
+		}
+	}
+	if in.snapshotMaxCount == 0 {
+		in.snapshotMaxCount = types.MaxSnapshotNum
+	}
+	for name := range e.Status.Snapshots {
+		if name != etypes.VolumeHeadName {
+			in.snapshotCount++
+		}
+	}
+	for _, snapshot := range snapshots {
+		if snapshot.Status.DataIntegrityError != "" {
+			in.dataIntegrityErrors[snapshot.Name] = snapshot.Status.DataIntegrityError
+		}
+	}
+
+	score, checks := assessVolumeHealth(in)
+	for _, check := range checks {
+		status := longhorn.ConditionStatusTrue
+		if !check.met() {
+			status = longhorn.ConditionStatusFalse
+		}
+		v.Status.Conditions = types.SetCondition(v.Status.Conditions, check.conditionType, status, check.reason, check.message)
+	}
+	v.Status.HealthScore = score
+	return nil
+}
@@ -0,0 +1,176 @@
+package controller
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+func TestAssessVolumeHealth(t *testing.T) {
+	assert := require.New(t)
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	newInput := func() *volumeHealthInput {
+		return &volumeHealthInput{
+			desiredReplicaCount: 3,
+			healthyReplicaNodes: []string{"node-1", "node-2", "node-3"},
+			nodeZones: map[string]string{
+				"node-1": "zone-1",
+				"node-2": "zone-2",
+				"node-3": "zone-3",
+			},
+			backupRPO:           time.Hour,
+			lastBackupAt:        now.Add(-10 * time.Minute).Format(time.RFC3339),
+			snapshotCount:       5,
+			snapshotMaxCount:    250,
+			dataIntegrityErrors: map[string]string{},
+			slowReplicas:        []string{},
+			now:                 now,
+		}
+	}
+
+	type testCase struct {
+		modify func(in *volumeHealthInput)
+		// expectedReasons are the reasons of the unmet conditions keyed by the condition type
+		expectedReasons map[string]string
+		expectedScore   int
+	}
+	tests := map[string]testCase{
+		"healthy": {
+			modify:          func(in *volumeHealthInput) {},
+			expectedReasons: map[string]string{},
+			expectedScore:   100,
+		},
+		"insufficient healthy replicas": {
+			modify: func(in *volumeHealthInput) {
+				in.healthyReplicaNodes = []string{"node-1", "node-2"}
+			},
+			expectedReasons: map[string]string{
+				longhorn.VolumeConditionTypeReplicaRedundancy: longhorn.VolumeConditionReasonInsufficientHealthyReplicas,
+			},
+			expectedScore: 88,
+		},
+		"replicas on the same node": {
+			modify: func(in *volumeHealthInput) {
+				in.healthyReplicaNodes = []string{"node-1", "node-1", "node-2"}
+			},
+			expectedReasons: map[string]string{
+				longhorn.VolumeConditionTypeReplicaSpread: longhorn.VolumeConditionReasonReplicasOnSameNode,
+			},
+			expectedScore: 85,
+		},
+		"replicas on the same node without other nodes": {
+			modify: func(in *volumeHealthInput) {
+				in.healthyReplicaNodes = []string{"node-1", "node-1", "node-1"}
+				in.nodeZones = map[string]string{"node-1": "zone-1"}
+			},
+			expectedReasons: map[string]string{},
+			expectedScore:   100,
+		},
+		"replicas in the same zone": {
+			modify: func(in *volumeHealthInput) {
+				in.nodeZones["node-2"] = "zone-1"
+				in.nodeZones["node-4"] = "zone-4"
+			},
+			expectedReasons: map[string]string{
+				longhorn.VolumeConditionTypeReplicaSpread: longhorn.VolumeConditionReasonReplicasOnSameZone,
+			},
+			expectedScore: 85,
+		},
+		"replicas in the only zone": {
+			modify: func(in *volumeHealthInput) {
+				in.nodeZones = map[string]string{"node-1": "", "node-2": "", "node-3": ""}
+			},
+			expectedReasons: map[string]string{},
+			expectedScore:   100,
+		},
+		"backup older than RPO": {
+			modify: func(in *volumeHealthInput) {
+				in.lastBackupAt = now.Add(-2 * time.Hour).Format(time.RFC3339)
+			},
+			expectedReasons: map[string]string{
+				longhorn.VolumeConditionTypeBackupRPO: longhorn.VolumeConditionReasonBackupRPOExceeded,
+			},
+			expectedScore: 85,
+		},
+		"no backup": {
+			modify: func(in *volumeHealthInput) {
+				in.lastBackupAt = ""
+			},
+			expectedReasons: map[string]string{
+				longhorn.VolumeConditionTypeBackupRPO: longhorn.VolumeConditionReasonBackupRPOExceeded,
+			},
+			expectedScore: 85,
+		},
+		"no backup with RPO disabled": {
+			modify: func(in *volumeHealthInput) {
+				in.backupRPO = 0
+				in.lastBackupAt = ""
+			},
+			expectedReasons: map[string]string{},
+			expectedScore:   100,
+		},
+		"snapshot count near the max count": {
+			modify: func(in *volumeHealthInput) {
+				in.snapshotCount = 225
+			},
+			expectedReasons: map[string]string{
+				longhorn.VolumeConditionTypeSnapshotCount: longhorn.VolumeConditionReasonSnapshotCountNearLimit,
+			},
+			expectedScore: 90,
+		},
+		"data integrity check failed": {
+			modify: func(in *volumeHealthInput) {
+				in.dataIntegrityErrors["snap-1"] = "detected corrupted replicas r1"
+			},
+			expectedReasons: map[string]string{
+				longhorn.VolumeConditionTypeDataIntegrity: longhorn.VolumeConditionReasonDataIntegrityCheckFailed,
+			},
+			expectedScore: 85,
+		},
+		"slow replica": {
+			modify: func(in *volumeHealthInput) {
+				in.slowReplicas = []string{"r1"}
+			},
+			expectedReasons: map[string]string{
+				longhorn.VolumeConditionTypeIOLatency: longhorn.VolumeConditionReasonSlowReplica,
+			},
+			expectedScore: 90,
+		},
+		"multiple objectives missed": {
+			modify: func(in *volumeHealthInput) {
+				in.healthyReplicaNodes = []string{"node-1"}
+				in.lastBackupAt = ""
+				in.slowReplicas = []string{"r1"}
+			},
+			expectedReasons: map[string]string{
+				longhorn.VolumeConditionTypeReplicaRedundancy: longhorn.VolumeConditionReasonInsufficientHealthyReplicas,
+				longhorn.VolumeConditionTypeBackupRPO:         longhorn.VolumeConditionReasonBackupRPOExceeded,
+				longhorn.VolumeConditionTypeIOLatency:         longhorn.VolumeConditionReasonSlowReplica,
+			},
+			expectedScore: 52,
+		},
+	}
+
+	for name, tc := range tests {
+		fmt.Printf("testing %v\n", name)
+		in := newInput()
+		tc.modify(in)
+		score, checks := assessVolumeHealth(in)
+		assert.Equal(tc.expectedScore, score, name)
+		assert.Len(checks, 6, name)
+
+		reasons := map[string]string{}
+		for _, check := range checks {
+			if !check.met() {
+				assert.NotEmpty(check.message, name)
+				reasons[check.conditionType] = check.reason
+			}
+		}
+		assert.Equal(tc.expectedReasons, reasons, name)
+	}
+}
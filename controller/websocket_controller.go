@@ -105,6 +105,10 @@ func NewWebsocketController(
 		return nil, err
 	}
 	wc.cacheSyncs = append(wc.cacheSyncs, ds.BackupBackingImageInformer.HasSynced)
+	if _, err = ds.AlertRuleInformer.AddEventHandler(wc.notifyWatchersHandler("alertRule")); err != nil {
+		return nil, err
+	}
+	wc.cacheSyncs = append(wc.cacheSyncs, ds.AlertRuleInformer.HasSynced)
 
 	return wc, nil
 }
@@ -494,7 +494,7 @@ func (cs *ControllerServer) ControllerPublishVolume(ctx context.Context, req *cs
 
 	attachmentID := generateAttachmentID(volumeID, nodeID)
 
-	return cs.publishVolume(volume, nodeID, attachmentID, func() error {
+	return cs.publishVolume(ctx, volume, nodeID, attachmentID, func() error {
 		checkVolumePublished := func(vol *longhornclient.Volume) bool {
 			isRegularRWXVolume := vol.AccessMode == string(longhorn.AccessModeReadWriteMany) && !vol.Migratable
 			attachment, ok := vol.VolumeAttachment.Attachments[attachmentID]
@@ -528,7 +528,7 @@ func generateAttachmentID(volName, nodeID string) string {
 }
 
 // publishVolume sends the actual attach request to the longhorn api and executes the passed waitForResult func
-func (cs *ControllerServer) publishVolume(volume *longhornclient.Volume, nodeID, attachmentID string, waitForResult func() error) (*csi.ControllerPublishVolumeResponse, error) {
+func (cs *ControllerServer) publishVolume(ctx context.Context, volume *longhornclient.Volume, nodeID, attachmentID string, waitForResult func() error) (*csi.ControllerPublishVolumeResponse, error) {
 	log := cs.log.WithFields(logrus.Fields{"function": "publishVolume"})
 
 	input := &longhornclient.AttachInput{
@@ -539,7 +539,7 @@ func (cs *ControllerServer) publishVolume(volume *longhornclient.Volume, nodeID,
 	}
 
 	log.Infof("Volume %v with accessMode %v requesting publishing with attachInput %+v", volume.Name, volume.AccessMode, input)
-	if _, err := cs.apiClient.Volume.ActionAttach(volume, input); err != nil {
+	if _, err := cs.apiClient.WithContext(ctx).Volume.ActionAttach(volume, input); err != nil {
 		// TODO: JM process the returned error and return the correct error responses for kubernetes
 		//  i.e. FailedPrecondition if the RWO volume is already attached to a different node
 		return nil, status.Error(codes.Internal, err.Error())
@@ -601,7 +601,7 @@ func (cs *ControllerServer) ControllerUnpublishVolume(ctx context.Context, req *
 
 	// TODO: handle cases in which NodeID is empty. Should we detach the volume from all nodes???
 
-	return cs.unpublishVolume(volume, nodeID, attachmentID, func() error {
+	return cs.unpublishVolume(ctx, volume, nodeID, attachmentID, func() error {
 		checkVolumeUnpublished := func(vol *longhornclient.Volume) bool {
 			_, ok := vol.VolumeAttachment.Attachments[attachmentID]
 			return !ok
@@ -615,7 +615,7 @@ func (cs *ControllerServer) ControllerUnpublishVolume(ctx context.Context, req *
 }
 
 // unpublishVolume sends the actual detach request to the longhorn api and executes the passed waitForResult func
-func (cs *ControllerServer) unpublishVolume(volume *longhornclient.Volume, nodeID, attachmentID string, waitForResult func() error) (*csi.ControllerUnpublishVolumeResponse, error) {
+func (cs *ControllerServer) unpublishVolume(ctx context.Context, volume *longhornclient.Volume, nodeID, attachmentID string, waitForResult func() error) (*csi.ControllerUnpublishVolumeResponse, error) {
 	log := cs.log.WithFields(logrus.Fields{"function": "unpublishVolume"})
 
 	log.Infof("Requesting volume %v detachment for node %v with attachmentID %v ", volume.Name, nodeID, attachmentID)
@@ -625,7 +625,7 @@ func (cs *ControllerServer) unpublishVolume(volume *longhornclient.Volume, nodeI
 		// if nodeID == "" means to detach from all nodes
 		ForceDetach: nodeID == "",
 	}
-	_, err := cs.apiClient.Volume.ActionDetach(volume, detachInput)
+	_, err := cs.apiClient.WithContext(ctx).Volume.ActionDetach(volume, detachInput)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -22,6 +22,7 @@ import (
 	utilexec "k8s.io/utils/exec"
 
 	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
 
 	longhornclient "github.com/longhorn/longhorn-manager/client"
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
@@ -249,9 +250,59 @@ func getVolumeOptions(volumeID string, volOptions map[string]string) (*longhornc
 		vol.FreezeFilesystemForSnapshot = freezeFilesystemForSnapshot
 	}
 
+	ioQoS, err := getVolumeIOQoSOptions(volOptions)
+	if err != nil {
+		return nil, err
+	}
+	vol.IOQoS = longhornclient.VolumeIOQoS{
+		MaxReadIOPS:       ioQoS.MaxReadIOPS,
+		MaxWriteIOPS:      ioQoS.MaxWriteIOPS,
+		MaxReadBandwidth:  ioQoS.MaxReadBandwidth,
+		MaxWriteBandwidth: ioQoS.MaxWriteBandwidth,
+		BurstSeconds:      ioQoS.BurstSeconds,
+	}
+
 	return vol, nil
 }
 
+// getVolumeIOQoSOptions parses the IOPS and bandwidth limits from the StorageClass parameters.
+// The bandwidth limits accept the size format, e.g. 100Mi means 100 MiB per second.
+func getVolumeIOQoSOptions(volOptions map[string]string) (*longhorn.VolumeIOQoS, error) {
+	ioQoS := &longhorn.VolumeIOQoS{}
+
+	for option, field := range map[string]*int64{
+		"maxReadIOPS":    &ioQoS.MaxReadIOPS,
+		"maxWriteIOPS":   &ioQoS.MaxWriteIOPS,
+		"ioBurstSeconds": &ioQoS.BurstSeconds,
+	} {
+		if value, ok := volOptions[option]; ok {
+			v, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid parameter %v", option)
+			}
+			*field = v
+		}
+	}
+
+	for option, field := range map[string]*int64{
+		"maxReadBandwidth":  &ioQoS.MaxReadBandwidth,
+		"maxWriteBandwidth": &ioQoS.MaxWriteBandwidth,
+	} {
+		if value, ok := volOptions[option]; ok {
+			v, err := util.ConvertSize(value)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid parameter %v", option)
+			}
+			*field = v
+		}
+	}
+
+	if err := types.ValidateVolumeIOQoS(*ioQoS); err != nil {
+		return nil, errors.Wrap(err, "invalid IO QoS parameters")
+	}
+	return ioQoS, nil
+}
+
 func syncMountPointDirectory(targetPath string) error {
 	d, err := os.OpenFile(targetPath, os.O_SYNC, 0750)
 	if err != nil {
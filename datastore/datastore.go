@@ -88,6 +88,10 @@ type DataStore struct {
 	SystemBackupInformer           cache.SharedInformer
 	systemRestoreLister            lhlisters.SystemRestoreLister
 	SystemRestoreInformer          cache.SharedInformer
+	settingsProfileLister          lhlisters.SettingsProfileLister
+	SettingsProfileInformer        cache.SharedInformer
+	alertRuleLister                lhlisters.AlertRuleLister
+	AlertRuleInformer              cache.SharedInformer
 	lhVolumeAttachmentLister       lhlisters.VolumeAttachmentLister
 	LHVolumeAttachmentInformer     cache.SharedInformer
 
@@ -177,6 +181,10 @@ func NewDataStore(namespace string, lhClient lhclientset.Interface, kubeClient c
 	cacheSyncs = append(cacheSyncs, systemBackupInformer.Informer().HasSynced)
 	systemRestoreInformer := informerFactories.LhInformerFactory.Longhorn().V1beta2().SystemRestores()
 	cacheSyncs = append(cacheSyncs, systemRestoreInformer.Informer().HasSynced)
+	settingsProfileInformer := informerFactories.LhInformerFactory.Longhorn().V1beta2().SettingsProfiles()
+	cacheSyncs = append(cacheSyncs, settingsProfileInformer.Informer().HasSynced)
+	alertRuleInformer := informerFactories.LhInformerFactory.Longhorn().V1beta2().AlertRules()
+	cacheSyncs = append(cacheSyncs, alertRuleInformer.Informer().HasSynced)
 	lhVolumeAttachmentInformer := informerFactories.LhInformerFactory.Longhorn().V1beta2().VolumeAttachments()
 	cacheSyncs = append(cacheSyncs, lhVolumeAttachmentInformer.Informer().HasSynced)
 
@@ -266,6 +274,10 @@ func NewDataStore(namespace string, lhClient lhclientset.Interface, kubeClient c
 		SystemBackupInformer:           systemBackupInformer.Informer(),
 		systemRestoreLister:            systemRestoreInformer.Lister(),
 		SystemRestoreInformer:          systemRestoreInformer.Informer(),
+		settingsProfileLister:          settingsProfileInformer.Lister(),
+		SettingsProfileInformer:        settingsProfileInformer.Informer(),
+		alertRuleLister:                alertRuleInformer.Lister(),
+		AlertRuleInformer:              alertRuleInformer.Informer(),
 		lhVolumeAttachmentLister:       lhVolumeAttachmentInformer.Lister(),
 		LHVolumeAttachmentInformer:     lhVolumeAttachmentInformer.Informer(),
 
@@ -9,6 +9,8 @@ import (
 	"reflect"
 	"regexp"
 	"runtime"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -392,6 +394,125 @@ func (s *DataStore) ValidateSetting(name, value string) (err error) {
 	return nil
 }
 
+// ValidateSettings checks the given setting values together, including the constraints across the settings
+// against the current values of the other settings
+func (s *DataStore) ValidateSettings(values map[types.SettingName]string) error {
+	for sName, value := range values {
+		definition, ok := types.GetSettingDefinition(sName)
+		if !ok {
+			return fmt.Errorf("setting %v is not supported", sName)
+		}
+		if definition.ReadOnly {
+			return fmt.Errorf("setting %v is read-only", sName)
+		}
+		if err := types.ValidateSetting(string(sName), value); err != nil {
+			return err
+		}
+	}
+
+	settings, err := s.ListSettings()
+	if err != nil {
+		return err
+	}
+	merged := map[types.SettingName]string{}
+	for sName, setting := range settings {
+		merged[sName] = setting.Value
+	}
+	for sName, value := range values {
+		merged[sName] = value
+	}
+	return types.ValidateSettingsConsistency(merged)
+}
+
+// UpdateSettings validates and applies the given setting values together. The settings already applied are
+// reverted if any of the settings fails to be applied.
+func (s *DataStore) UpdateSettings(values map[types.SettingName]string) error {
+	if err := s.ValidateSettings(values); err != nil {
+		return err
+	}
+
+	changed := map[types.SettingName]string{}
+	original := map[types.SettingName]string{}
+	for sName, value := range values {
+		setting, err := s.GetSettingWithAutoFillingRO(sName)
+		if err != nil {
+			return err
+		}
+		if setting.Value == value {
+			continue
+		}
+		changed[sName] = value
+		original[sName] = setting.Value
+	}
+
+	applied, err := s.applySettingValues(changed)
+	if err == nil {
+		return nil
+	}
+
+	reverted := map[types.SettingName]string{}
+	for _, sName := range applied {
+		reverted[sName] = original[sName]
+	}
+	if _, errRevert := s.applySettingValues(reverted); errRevert != nil {
+		return errors.Wrapf(err, "failed to revert the applied settings %v: %v", applied, errRevert)
+	}
+	return err
+}
+
+// applySettingValues applies the setting values in rounds, since the validation of a setting may depend on the
+// value of another setting in the same batch. It stops once a round makes no progress, and returns the applied
+// settings.
+func (s *DataStore) applySettingValues(values map[types.SettingName]string) (applied []types.SettingName, err error) {
+	pending := []types.SettingName{}
+	for sName := range values {
+		pending = append(pending, sName)
+	}
+	sort.Slice(pending, func(i, j int) bool { return pending[i] < pending[j] })
+
+	for len(pending) > 0 {
+		failed := []types.SettingName{}
+		err = nil
+		for _, sName := range pending {
+			if errApply := s.applySettingValue(sName, values[sName]); errApply != nil {
+				failed = append(failed, sName)
+				err = errApply
+				continue
+			}
+			applied = append(applied, sName)
+		}
+		if len(failed) == len(pending) {
+			return applied, err
+		}
+		pending = failed
+	}
+	return applied, nil
+}
+
+func (s *DataStore) applySettingValue(sName types.SettingName, value string) error {
+	if err := s.ValidateSetting(string(sName), value); err != nil {
+		return err
+	}
+
+	setting, err := s.GetSettingExact(sName)
+	if err != nil {
+		if !ErrorIsNotFound(err) {
+			return err
+		}
+		_, err = s.CreateSetting(&longhorn.Setting{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: string(sName),
+			},
+			Value: value,
+		})
+		return err
+	}
+
+	setting.Value = value
+	_, err = s.UpdateSetting(setting)
+	return err
+}
+
 func (s *DataStore) ValidateV1DataEngineEnabled(dataEngineEnabled bool) (ims []*longhorn.InstanceManager, err error) {
 	if !dataEngineEnabled {
 		allV1VolumesDetached, _ims, err := s.AreAllEngineInstancesStopped(longhorn.DataEngineTypeV1)
@@ -2611,7 +2732,8 @@ func (s *DataStore) CreateDefaultNode(name string) (*longhorn.Node, error) {
 		if err != nil {
 			return nil, err
 		}
-		storageReservedPercentageForDefaultDisk, err := s.GetSettingAsInt(types.SettingNameStorageReservedPercentageForDefaultDisk)
+		// The Longhorn node is not created yet, so only the Kubernetes node label can override the setting
+		storageReservedPercentageForDefaultDisk, err := s.GetSettingAsIntForNode(types.SettingNameStorageReservedPercentageForDefaultDisk, name)
 		if err != nil {
 			return nil, err
 		}
@@ -3429,6 +3551,73 @@ func (s *DataStore) GetSettingAsInt(settingName types.SettingName) (int64, error
 	return -1, fmt.Errorf("the %v setting value couldn't change to integer, value is %v ", string(settingName), value)
 }
 
+// GetSettingValueForNode returns the setting value overridden for the node by the Longhorn node spec or the
+// Kubernetes node label, or the global value if not overridden
+func (s *DataStore) GetSettingValueForNode(settingName types.SettingName, nodeName string) (string, error) {
+	node, err := s.GetNodeRO(nodeName)
+	if err != nil {
+		if !ErrorIsNotFound(err) {
+			return "", err
+		}
+		node = nil
+	}
+	return s.getSettingValueForNode(settingName, nodeName, node)
+}
+
+// GetSettingAsIntForNode returns the setting value overridden for the node as integer
+func (s *DataStore) GetSettingAsIntForNode(settingName types.SettingName, nodeName string) (int64, error) {
+	value, err := s.GetSettingValueForNode(settingName, nodeName)
+	if err != nil {
+		return -1, err
+	}
+	return strconv.ParseInt(value, 10, 64)
+}
+
+// GetSettingAsIntForDisk returns the setting value overridden for the disk by the disk spec, or the value for the
+// node of the disk if not overridden, as integer
+func (s *DataStore) GetSettingAsIntForDisk(settingName types.SettingName, node *longhorn.Node, disk longhorn.DiskSpec) (int64, error) {
+	value, ok := disk.SettingOverrides[string(settingName)]
+	if !ok || !slices.Contains(types.DiskOverridableSettings, settingName) {
+		var err error
+		value, err = s.getSettingValueForNode(settingName, node.Name, node)
+		if err != nil {
+			return -1, err
+		}
+	}
+	return strconv.ParseInt(value, 10, 64)
+}
+
+func (s *DataStore) getSettingValueForNode(settingName types.SettingName, nodeName string, node *longhorn.Node) (string, error) {
+	if slices.Contains(types.NodeOverridableSettings, settingName) {
+		if node != nil {
+			if value, ok := node.Spec.SettingOverrides[string(settingName)]; ok {
+				return value, nil
+			}
+		}
+
+		kubeNode, err := s.GetKubernetesNodeRO(nodeName)
+		if err != nil && !ErrorIsNotFound(err) {
+			return "", err
+		}
+		if kubeNode != nil {
+			if value, ok := kubeNode.Labels[types.GetNodeSettingOverrideLabelKey(settingName)]; ok {
+				// The labels are not validated by the webhook
+				err := types.ValidateSettingOverrides(map[string]string{string(settingName): value}, types.NodeOverridableSettings)
+				if err == nil {
+					return value, nil
+				}
+				logrus.WithError(err).Warnf("Ignoring the invalid label %v of Kubernetes node %v", types.GetNodeSettingOverrideLabelKey(settingName), nodeName)
+			}
+		}
+	}
+
+	setting, err := s.GetSettingWithAutoFillingRO(settingName)
+	if err != nil {
+		return "", err
+	}
+	return setting.Value, nil
+}
+
 // GetSettingAsBool gets the setting for the given name, returns as boolean
 // Returns error if the definition type is not boolean
 func (s *DataStore) GetSettingAsBool(settingName types.SettingName) (bool, error) {
@@ -4250,6 +4439,29 @@ func (s *DataStore) GetBackupTargetRO(backupTargetName string) (*longhorn.Backup
 	return s.backupTargetLister.BackupTargets(s.namespace).Get(backupTargetName)
 }
 
+// GetBackupBandwidthLimits returns the bandwidth limits of the given backup target and node for transferring data
+// between them. The caps are evaluated by the transfer over time, since they vary with the bandwidth limit windows.
+func (s *DataStore) GetBackupBandwidthLimits(backupTargetName, nodeID string) ([]longhorn.BandwidthLimit, error) {
+	limits := []longhorn.BandwidthLimit{}
+	if backupTargetName != "" {
+		backupTarget, err := s.GetBackupTargetRO(backupTargetName)
+		if err != nil {
+			return nil, err
+		}
+		limits = append(limits, backupTarget.Spec.BandwidthLimit)
+	}
+	if nodeID != "" {
+		node, err := s.GetNodeRO(nodeID)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		if node != nil {
+			limits = append(limits, node.Spec.BackupBandwidthLimit)
+		}
+	}
+	return limits, nil
+}
+
 // GetBackupTarget returns a copy of BackupTarget with the given backup target name in the cluster
 func (s *DataStore) GetBackupTarget(name string) (*longhorn.BackupTarget, error) {
 	resultRO, err := s.GetBackupTargetRO(name)
@@ -4387,6 +4599,8 @@ func getBackupTargetPath(u *url.URL) (backupTargetPath string, err error) {
 		backupTargetPath = strings.ToLower(u.String())
 	case types.BackupStoreTypeCIFS, types.BackupStoreTypeNFS:
 		backupTargetPath = strings.ToLower(strings.TrimRight(u.Host+u.Path, "/"))
+	case types.BackupStoreTypeFile:
+		backupTargetPath = strings.TrimRight(u.Path, "/")
 	default:
 		return "", fmt.Errorf("url %s with the unsupported protocol %v", u.String(), u.Scheme)
 	}
@@ -5016,6 +5230,9 @@ func ValidateRecurringJob(job longhorn.RecurringJobSpec) error {
 			return err
 		}
 	}
+	if job.Task == longhorn.RecurringJobTypeBackupCopy && job.Parameters[types.RecurringJobParameterBackupTarget] == "" {
+		return fmt.Errorf("recurring job task %v requires the parameter %v", job.Task, types.RecurringJobParameterBackupTarget)
+	}
 	if job.Parameters != nil {
 		if err := ValidateRecurringJobParameters(job.Task, job.Parameters); err != nil {
 			return err
@@ -5032,6 +5249,12 @@ func ValidateRecurringJobParameters(task longhorn.RecurringJobType, parameters m
 				return errors.Wrapf(err, "failed to validate recurring job backup task parameters")
 			}
 		}
+	case longhorn.RecurringJobTypeBackupCopy:
+		for key, value := range parameters {
+			if err := validateRecurringJobBackupCopyParameter(key, value); err != nil {
+				return errors.Wrapf(err, "failed to validate recurring job backup-copy task parameters")
+			}
+		}
 	// we don't support any parameters for other tasks currently
 	default:
 		return nil
@@ -5064,9 +5287,23 @@ func validateRecurringJobBackupParameter(key, value string) error {
 	return nil
 }
 
+func validateRecurringJobBackupCopyParameter(key, value string) error {
+	switch key {
+	case types.RecurringJobParameterBackupTarget:
+		if value == "" {
+			return fmt.Errorf("%v cannot be empty", key)
+		}
+	default:
+		return fmt.Errorf("%v:%v is not a valid parameter", key, value)
+	}
+
+	return nil
+}
+
 func isValidRecurringJobTask(task longhorn.RecurringJobType) bool {
 	return task == longhorn.RecurringJobTypeBackup ||
 		task == longhorn.RecurringJobTypeBackupForceCreate ||
+		task == longhorn.RecurringJobTypeBackupCopy ||
 		task == longhorn.RecurringJobTypeFilesystemTrim ||
 		task == longhorn.RecurringJobTypeSnapshot ||
 		task == longhorn.RecurringJobTypeSnapshotForceCreate ||
@@ -5718,6 +5955,139 @@ func (s *DataStore) ListSystemRestores() (map[string]*longhorn.SystemRestore, er
 	return s.listSystemRestores(labels.Everything())
 }
 
+// CreateSettingsProfile creates a Longhorn SettingsProfile resource and verifies creation
+func (s *DataStore) CreateSettingsProfile(settingsProfile *longhorn.SettingsProfile) (*longhorn.SettingsProfile, error) {
+	ret, err := s.lhClient.LonghornV1beta2().SettingsProfiles(s.namespace).Create(context.TODO(), settingsProfile, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if SkipListerCheck {
+		return ret, nil
+	}
+
+	obj, err := verifyCreation(ret.Name, "settings profile", func(name string) (k8sruntime.Object, error) {
+		return s.GetSettingsProfileRO(name)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ret, ok := obj.(*longhorn.SettingsProfile)
+	if !ok {
+		return nil, fmt.Errorf("BUG: datastore: verifyCreation returned wrong type for SettingsProfile")
+	}
+
+	return ret.DeepCopy(), nil
+}
+
+// UpdateSettingsProfile updates Longhorn SettingsProfile and verifies update
+func (s *DataStore) UpdateSettingsProfile(settingsProfile *longhorn.SettingsProfile) (*longhorn.SettingsProfile, error) {
+	obj, err := s.lhClient.LonghornV1beta2().SettingsProfiles(s.namespace).Update(context.TODO(), settingsProfile, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	verifyUpdate(settingsProfile.Name, obj, func(name string) (k8sruntime.Object, error) {
+		return s.GetSettingsProfileRO(name)
+	})
+
+	return obj, nil
+}
+
+// UpdateSettingsProfileStatus updates Longhorn SettingsProfile resource status and verifies update
+func (s *DataStore) UpdateSettingsProfileStatus(settingsProfile *longhorn.SettingsProfile) (*longhorn.SettingsProfile, error) {
+	obj, err := s.lhClient.LonghornV1beta2().SettingsProfiles(s.namespace).UpdateStatus(context.TODO(), settingsProfile, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	verifyUpdate(settingsProfile.Name, obj, func(name string) (k8sruntime.Object, error) {
+		return s.GetSettingsProfileRO(name)
+	})
+
+	return obj, nil
+}
+
+// DeleteSettingsProfile deletes the SettingsProfile. The applied settings are not reverted.
+func (s *DataStore) DeleteSettingsProfile(name string) error {
+	return s.lhClient.LonghornV1beta2().SettingsProfiles(s.namespace).Delete(context.TODO(), name, metav1.DeleteOptions{})
+}
+
+// GetSettingsProfile returns a copy of SettingsProfile with the given obj name
+func (s *DataStore) GetSettingsProfile(name string) (*longhorn.SettingsProfile, error) {
+	resultRO, err := s.GetSettingsProfileRO(name)
+	if err != nil {
+		return nil, err
+	}
+	// Cannot use cached object from lister
+	return resultRO.DeepCopy(), nil
+}
+
+// GetSettingsProfileRO returns the SettingsProfile with the given CR name
+func (s *DataStore) GetSettingsProfileRO(name string) (*longhorn.SettingsProfile, error) {
+	return s.settingsProfileLister.SettingsProfiles(s.namespace).Get(name)
+}
+
+// ListSettingsProfiles returns an object contains all SettingsProfiles
+func (s *DataStore) ListSettingsProfiles() (map[string]*longhorn.SettingsProfile, error) {
+	list, err := s.settingsProfileLister.SettingsProfiles(s.namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	itemMap := map[string]*longhorn.SettingsProfile{}
+	for _, itemRO := range list {
+		// Cannot use cached object from lister
+		itemMap[itemRO.Name] = itemRO.DeepCopy()
+	}
+	return itemMap, nil
+}
+
+// UpdateAlertRuleStatus updates Longhorn AlertRule resource status and verifies update
+func (s *DataStore) UpdateAlertRuleStatus(alertRule *longhorn.AlertRule) (*longhorn.AlertRule, error) {
+	obj, err := s.lhClient.LonghornV1beta2().AlertRules(s.namespace).UpdateStatus(context.TODO(), alertRule, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	verifyUpdate(alertRule.Name, obj, func(name string) (k8sruntime.Object, error) {
+		return s.GetAlertRuleRO(name)
+	})
+
+	return obj, nil
+}
+
+// GetAlertRule returns a copy of AlertRule with the given obj name
+func (s *DataStore) GetAlertRule(name string) (*longhorn.AlertRule, error) {
+	resultRO, err := s.GetAlertRuleRO(name)
+	if err != nil {
+		return nil, err
+	}
+	// Cannot use cached object from lister
+	return resultRO.DeepCopy(), nil
+}
+
+// GetAlertRuleRO returns the AlertRule with the given CR name
+func (s *DataStore) GetAlertRuleRO(name string) (*longhorn.AlertRule, error) {
+	return s.alertRuleLister.AlertRules(s.namespace).Get(name)
+}
+
+// ListAlertRules returns an object contains all AlertRules
+func (s *DataStore) ListAlertRules() (map[string]*longhorn.AlertRule, error) {
+	list, err := s.alertRuleLister.AlertRules(s.namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	itemMap := map[string]*longhorn.AlertRule{}
+	for _, itemRO := range list {
+		// Cannot use cached object from lister
+		itemMap[itemRO.Name] = itemRO.DeepCopy()
+	}
+	return itemMap, nil
+}
+
 // UpdateLHVolumeAttachment updates the given Longhorn VolumeAttachment in the VolumeAttachment CR and verifies update
 func (s *DataStore) UpdateLHVolumeAttachment(va *longhorn.VolumeAttachment) (*longhorn.VolumeAttachment, error) {
 	obj, err := s.lhClient.LonghornV1beta2().VolumeAttachments(s.namespace).Update(context.TODO(), va, metav1.UpdateOptions{})
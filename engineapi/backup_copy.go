@@ -0,0 +1,142 @@
+package engineapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/longhorn/backupstore"
+
+	"github.com/longhorn/longhorn-manager/types"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	bsops "github.com/longhorn/longhorn-manager/util/backupstore"
+)
+
+const (
+	backupStoreCommandErrorTailSize = 1024
+)
+
+// BackupCopy copies the source backup to the backup target as backupName. The copy runs the backupstore command
+// of the manager binary twice, since the backupstore drivers read the credential from the environment variables:
+// the importer with the credential of the backup target locks the backup volume and lists the existing blocks,
+// then the exporter with the credential of the source backup target streams the backup without these blocks to
+// the importer. Each side caps the transfer by the bandwidth limits of its backup target, hence the copy runs at
+// the stricter cap of the restore limits of the source and the backup limits of the destination. The copy is idempotent, hence an interrupted copy can be resumed by calling it again.
+func (btc *BackupTargetClient) BackupCopy(srcBackupURL string, srcCredential map[string]string, srcBandwidthLimits []longhorn.BandwidthLimit,
+	backupName string, labels map[string]string, progress func(int)) (err error) {
+	if isFileBackupTarget(btc.URL) || isFileBackupTarget(srcBackupURL) {
+		return fmt.Errorf("copying backup is not supported by the %v backup target", types.BackupStoreTypeFile)
+	}
+
+	_, volumeName, _, err := backupstore.DecodeBackupURL(srcBackupURL)
+	if err != nil {
+		return err
+	}
+	binary, err := os.Executable()
+	if err != nil {
+		return errors.Wrap(err, "failed to get the manager binary")
+	}
+	envs, err := btc.getEnvs()
+	if err != nil {
+		return err
+	}
+	srcEnvs, err := getBackupStoreEnv(srcBackupURL, srcCredential, srcBandwidthLimits)
+	if err != nil {
+		return err
+	}
+
+	importArgs := []string{bsops.CommandName, bsops.CommandCopyImport,
+		"--" + bsops.FlagVolume, volumeName, "--" + bsops.FlagBackupName, backupName}
+	for k, v := range labels {
+		importArgs = append(importArgs, "--"+bsops.FlagLabel, k+"="+v)
+	}
+	importArgs = append(importArgs, btc.URL)
+
+	importer := exec.Command(binary, importArgs...)
+	importer.Env = append(os.Environ(), envs...)
+	importerStderr := &bytes.Buffer{}
+	importer.Stderr = importerStderr
+	importerStdin, err := importer.StdinPipe()
+	if err != nil {
+		return err
+	}
+	importerStdout, err := importer.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	logrus.Infof("Start copying backup %s to %s as %s", srcBackupURL, btc.URL, backupName)
+	if err := importer.Start(); err != nil {
+		return errors.Wrap(err, "failed to start backup importer")
+	}
+	defer func() {
+		_ = importerStdin.Close()
+		if waitErr := importer.Wait(); waitErr != nil && err == nil {
+			err = errors.Wrapf(waitErr, "failed to import backup: %v", tailString(importerStderr.String(), backupStoreCommandErrorTailSize))
+		}
+		if err == nil {
+			logrus.Infof("Complete copying backup %s to %s as %s", srcBackupURL, btc.URL, backupName)
+		}
+	}()
+
+	decoder := json.NewDecoder(importerStdout)
+	existingBlocks := []string{}
+	if err := decoder.Decode(&existingBlocks); err != nil {
+		return errors.Wrapf(err, "failed to get existing blocks of backup volume %v", volumeName)
+	}
+	skipBlocks, err := json.Marshal(existingBlocks)
+	if err != nil {
+		return err
+	}
+
+	exporter := exec.Command(binary, bsops.CommandName, bsops.CommandCopyExport, srcBackupURL)
+	exporter.Env = append(os.Environ(), srcEnvs...)
+	exporter.Stdin = bytes.NewReader(skipBlocks)
+	exporter.Stdout = importerStdin
+	exporterStderr := &bytes.Buffer{}
+	exporter.Stderr = exporterStderr
+	if err := exporter.Start(); err != nil {
+		return errors.Wrap(err, "failed to start backup exporter")
+	}
+	exportErrCh := make(chan error, 1)
+	go func() {
+		err := exporter.Wait()
+		// The importer fails with an incomplete stream if the exporter fails
+		_ = importerStdin.Close()
+		exportErrCh <- err
+	}()
+
+	for {
+		p := bsops.CopyProgress{}
+		if err := decoder.Decode(&p); err != nil {
+			if err != io.EOF {
+				logrus.WithError(err).Warnf("Failed to decode progress of copying backup %v", backupName)
+			}
+			break
+		}
+		if progress != nil {
+			progress(p.Progress)
+		}
+	}
+
+	if err := <-exportErrCh; err != nil {
+		return errors.Wrapf(err, "failed to export backup %v: %v", srcBackupURL, tailString(exporterStderr.String(), backupStoreCommandErrorTailSize))
+	}
+	return nil
+}
+
+func tailString(s string, size int) string {
+	s = strings.TrimSpace(s)
+	if len(s) > size {
+		return s[len(s)-size:]
+	}
+	return s
+}
@@ -3,6 +3,7 @@ package engineapi
 import (
 	"encoding/json"
 	"fmt"
+	"os"
 	"path/filepath"
 	"sort"
 	"time"
@@ -22,6 +23,7 @@ import (
 	"github.com/longhorn/longhorn-manager/util"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	bsops "github.com/longhorn/longhorn-manager/util/backupstore"
 )
 
 const (
@@ -35,6 +37,8 @@ type BackupTargetClient struct {
 	URL            string
 	Credential     map[string]string
 	ExecuteTimeout time.Duration
+	// BandwidthLimits caps the data transfer of the backupstore command of the manager binary
+	BandwidthLimits []longhorn.BandwidthLimit
 }
 
 // NewBackupTargetClient returns the backup target client
@@ -133,8 +137,31 @@ func getBackupCredentialEnv(backupTarget string, credential map[string]string) (
 	return envs, nil
 }
 
+// getEnvs returns the credential environment variables along with the bandwidth limits if there are any
+func (btc *BackupTargetClient) getEnvs() ([]string, error) {
+	return getBackupStoreEnv(btc.URL, btc.Credential, btc.BandwidthLimits)
+}
+
+func getBackupStoreEnv(backupTarget string, credential map[string]string, bandwidthLimits []longhorn.BandwidthLimit) ([]string, error) {
+	envs, err := getBackupCredentialEnv(backupTarget, credential)
+	if err != nil {
+		return nil, err
+	}
+	if len(bandwidthLimits) > 0 {
+		limits, err := json.Marshal(bandwidthLimits)
+		if err != nil {
+			return nil, err
+		}
+		envs = append(envs, fmt.Sprintf("%s=%s", types.EnvBackupStoreBandwidthLimit, limits))
+	}
+	return envs, nil
+}
+
 func (btc *BackupTargetClient) ExecuteEngineBinary(args ...string) (string, error) {
-	envs, err := getBackupCredentialEnv(btc.URL, btc.Credential)
+	if isFileBackupTarget(btc.URL) {
+		return executeFileBackupStoreCommand(args...)
+	}
+	envs, err := btc.getEnvs()
 	if err != nil {
 		return "", err
 	}
@@ -142,7 +169,10 @@ func (btc *BackupTargetClient) ExecuteEngineBinary(args ...string) (string, erro
 }
 
 func (btc *BackupTargetClient) ExecuteEngineBinaryWithTimeout(timeout time.Duration, args ...string) (string, error) {
-	envs, err := getBackupCredentialEnv(btc.URL, btc.Credential)
+	if isFileBackupTarget(btc.URL) {
+		return executeFileBackupStoreCommand(args...)
+	}
+	envs, err := btc.getEnvs()
 	if err != nil {
 		return "", err
 	}
@@ -150,13 +180,30 @@ func (btc *BackupTargetClient) ExecuteEngineBinaryWithTimeout(timeout time.Durat
 }
 
 func (btc *BackupTargetClient) ExecuteEngineBinaryWithoutTimeout(args ...string) (string, error) {
-	envs, err := getBackupCredentialEnv(btc.URL, btc.Credential)
+	if isFileBackupTarget(btc.URL) {
+		return executeFileBackupStoreCommand(args...)
+	}
+	envs, err := btc.getEnvs()
 	if err != nil {
 		return "", err
 	}
 	return lhexec.NewExecutor().Execute(envs, btc.LonghornEngineBinary(), args, lhtypes.ExecuteNoTimeout)
 }
 
+// ExecuteBackupStoreCommand runs the backupstore command of the manager binary for the operations which are not
+// provided by the engine binary
+func (btc *BackupTargetClient) ExecuteBackupStoreCommand(timeout time.Duration, args ...string) (string, error) {
+	binary, err := os.Executable()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get the manager binary")
+	}
+	envs, err := btc.getEnvs()
+	if err != nil {
+		return "", err
+	}
+	return lhexec.NewExecutor().Execute(envs, binary, append([]string{bsops.CommandName}, args...), timeout)
+}
+
 // parseBackupVolumeNamesList parses a list of backup volume names into a sorted string slice
 func parseBackupVolumeNamesList(output string) ([]string, error) {
 	data := map[string]struct{}{}
@@ -311,6 +358,27 @@ func (btc *BackupTargetClient) BackupDelete(backupURL string, credential map[str
 	return nil
 }
 
+// BackupGarbageCollect scans the backup target for the blocks and config files not referenced by any backup,
+// which are left by the interrupted deletions, and deletes them unless it is a dry run.
+func (btc *BackupTargetClient) BackupGarbageCollect(dryRun bool) (*BackupGarbageCollectResult, error) {
+	args := []string{"backup", "gc"}
+	if dryRun {
+		args = append(args, "--dry-run")
+	}
+	args = append(args, btc.URL)
+
+	output, err := btc.ExecuteEngineBinaryWithoutTimeout(args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error collecting garbage in %v", btc.URL)
+	}
+
+	result := &BackupGarbageCollectResult{}
+	if err := json.Unmarshal([]byte(output), result); err != nil {
+		return nil, errors.Wrapf(err, "error parsing garbage collection result: \n%s", output)
+	}
+	return result, nil
+}
+
 // BackupCleanUpAllMounts clean up all mount points of backup store on the node
 func (btc *BackupTargetClient) BackupCleanUpAllMounts() (err error) {
 	_, err = btc.ExecuteEngineBinary("backup", "cleanup-all-mounts")
@@ -0,0 +1,381 @@
+package engineapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/longhorn/backupstore"
+	"github.com/longhorn/backupstore/backupbackingimage"
+	bsutil "github.com/longhorn/backupstore/util"
+
+	systembackupstore "github.com/longhorn/backupstore/systembackup"
+
+	"github.com/longhorn/longhorn-manager/types"
+)
+
+// The file backup target stores the backups in a local directory of the longhorn-manager, for example
+// file:///var/lib/longhorn-backupstore. The backup target operations of the BackupTargetClient are served
+// in-process by the backupstore library instead of the engine binary, so the backup, restore and DR code
+// paths of the controllers can be exercised without an outside service. It is meant for testing only,
+// since the directory is not shared with the other nodes and the engine cannot access it.
+
+func init() {
+	if err := backupstore.RegisterDriver(types.BackupStoreTypeFile, initFileBackupStoreDriver); err != nil {
+		logrus.WithError(err).Warnf("Failed to register %v backupstore driver", types.BackupStoreTypeFile)
+	}
+}
+
+type fileBackupStoreDriver struct {
+	destURL string
+	path    string
+}
+
+func initFileBackupStoreDriver(destURL string) (backupstore.BackupStoreDriver, error) {
+	u, err := url.Parse(destURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != types.BackupStoreTypeFile {
+		return nil, fmt.Errorf("BUG: invalid %v backup target URL %v", types.BackupStoreTypeFile, destURL)
+	}
+	if u.Host != "" || !filepath.IsAbs(u.Path) {
+		return nil, fmt.Errorf("%v backup target URL %v should be in the format file:///absolute/path", types.BackupStoreTypeFile, destURL)
+	}
+	if err := os.MkdirAll(u.Path, 0755); err != nil {
+		return nil, errors.Wrapf(err, "failed to create backup target directory %v", u.Path)
+	}
+
+	u.RawQuery = ""
+	return &fileBackupStoreDriver{
+		destURL: u.String(),
+		path:    u.Path,
+	}, nil
+}
+
+func (d *fileBackupStoreDriver) localPath(path string) string {
+	return filepath.Join(d.path, path)
+}
+
+func (d *fileBackupStoreDriver) Kind() string {
+	return types.BackupStoreTypeFile
+}
+
+func (d *fileBackupStoreDriver) GetURL() string {
+	return d.destURL
+}
+
+func (d *fileBackupStoreDriver) FileExists(filePath string) bool {
+	_, err := os.Stat(d.localPath(filePath))
+	return err == nil
+}
+
+func (d *fileBackupStoreDriver) FileSize(filePath string) int64 {
+	info, err := os.Stat(d.localPath(filePath))
+	if err != nil {
+		return -1
+	}
+	return info.Size()
+}
+
+func (d *fileBackupStoreDriver) FileTime(filePath string) time.Time {
+	info, err := os.Stat(d.localPath(filePath))
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime().UTC()
+}
+
+func (d *fileBackupStoreDriver) Remove(path string) error {
+	return os.RemoveAll(d.localPath(path))
+}
+
+func (d *fileBackupStoreDriver) Read(src string) (io.ReadCloser, error) {
+	return os.Open(d.localPath(src))
+}
+
+func (d *fileBackupStoreDriver) Write(dst string, rs io.ReadSeeker) error {
+	return writeFileAtomically(d.localPath(dst), rs)
+}
+
+func (d *fileBackupStoreDriver) List(path string) ([]string, error) {
+	entries, err := os.ReadDir(d.localPath(path))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+	names := []string{}
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	return names, nil
+}
+
+func (d *fileBackupStoreDriver) Upload(src, dst string) error {
+	file, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return writeFileAtomically(d.localPath(dst), file)
+}
+
+func (d *fileBackupStoreDriver) Download(src, dst string) error {
+	file, err := os.Open(d.localPath(src))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return writeFileAtomically(dst, file)
+}
+
+// writeFileAtomically writes to a temporary file then renames it, so the readers never see a partial file
+func writeFileAtomically(path string, r io.Reader) (err error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	tmpFile, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = os.Remove(tmpFile.Name())
+		}
+	}()
+
+	if _, err = io.Copy(tmpFile, r); err != nil {
+		_ = tmpFile.Close()
+		return err
+	}
+	if err = tmpFile.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpFile.Name(), path)
+}
+
+// isFileBackupTarget returns true if the backup target URL is served by the file backupstore driver
+func isFileBackupTarget(backupTargetURL string) bool {
+	backupType, err := bsutil.CheckBackupType(backupTargetURL)
+	if err != nil {
+		return false
+	}
+	return backupType == types.BackupStoreTypeFile
+}
+
+// executeFileBackupStoreCommand serves the engine binary backup and system-backup commands in-process
+// for the file backup target. The output is in the same format as the engine binary.
+func executeFileBackupStoreCommand(args ...string) (string, error) {
+	if len(args) < 2 {
+		return "", fmt.Errorf("invalid command %v for the %v backup target", args, types.BackupStoreTypeFile)
+	}
+
+	command, args := args[0]+" "+args[1], args[2:]
+	switch command {
+	case "backup ls":
+		volumeName := ""
+		volumeOnly := false
+		for len(args) > 1 {
+			switch args[0] {
+			case "--volume-only":
+				volumeOnly = true
+				args = args[1:]
+			case "--volume":
+				volumeName = args[1]
+				args = args[2:]
+			default:
+				return "", fmt.Errorf("invalid option %v of command %v", args[0], command)
+			}
+		}
+		if len(args) != 1 {
+			return "", fmt.Errorf("missing backup target URL for command %v", command)
+		}
+		volumeInfos, err := backupstore.List(volumeName, args[0], volumeOnly)
+		if err != nil {
+			return "", err
+		}
+		return marshalFileBackupStoreOutput(volumeInfos)
+	case "backup inspect-volume":
+		if len(args) != 1 {
+			return "", fmt.Errorf("missing backup volume URL for command %v", command)
+		}
+		volumeInfo, err := backupstore.InspectVolume(args[0])
+		if err != nil {
+			return "", err
+		}
+		return marshalFileBackupStoreOutput(volumeInfo)
+	case "backup inspect":
+		if len(args) != 1 {
+			return "", fmt.Errorf("missing backup URL for command %v", command)
+		}
+		backupInfo, err := backupstore.InspectBackup(args[0])
+		if err != nil {
+			return "", err
+		}
+		return marshalFileBackupStoreOutput(backupInfo)
+	case "backup head":
+		if len(args) != 1 {
+			return "", fmt.Errorf("missing config URL for command %v", command)
+		}
+		metadata, err := backupstore.GetConfigMetadata(args[0])
+		if err != nil {
+			return "", err
+		}
+		return marshalFileBackupStoreOutput(metadata)
+	case "backup rm":
+		if len(args) == 3 && args[0] == "--volume" {
+			return "", backupstore.DeleteBackupVolume(args[1], args[2])
+		}
+		if len(args) != 1 {
+			return "", fmt.Errorf("missing backup URL for command %v", command)
+		}
+		return "", backupstore.DeleteDeltaBlockBackup(args[0])
+	case "backup cleanup-all-mounts":
+		// Nothing is mounted for the file backup target
+		return "", nil
+	case "backup inspect-backing-image":
+		if len(args) != 1 {
+			return "", fmt.Errorf("missing backup backing image URL for command %v", command)
+		}
+		backupInfo, err := backupbackingimage.InspectBackupBackingImage(args[0])
+		if err != nil {
+			return "", err
+		}
+		return marshalFileBackupStoreOutput(backupInfo)
+	case "backup ls-backing-image":
+		if len(args) != 1 {
+			return "", fmt.Errorf("missing backup target URL for command %v", command)
+		}
+		driver, err := backupstore.GetBackupStoreDriver(args[0])
+		if err != nil {
+			return "", err
+		}
+		names, err := backupbackingimage.GetAllBackupBackingImageNames(driver)
+		if err != nil {
+			return "", err
+		}
+		return marshalFileBackupStoreOutput(names)
+	case "backup rm-backing-image":
+		if len(args) != 1 {
+			return "", fmt.Errorf("missing backup backing image URL for command %v", command)
+		}
+		return "", backupbackingimage.RemoveBackingImageBackup(args[0])
+	case "system-backup list":
+		if len(args) != 1 {
+			return "", fmt.Errorf("missing backup target URL for command %v", command)
+		}
+		systemBackups, err := systembackupstore.List(args[0])
+		if err != nil {
+			return "", err
+		}
+		return marshalFileBackupStoreOutput(systemBackups)
+	case "system-backup get-config":
+		if len(args) != 1 {
+			return "", fmt.Errorf("missing system backup URL for command %v", command)
+		}
+		cfg, err := loadFileSystemBackupConfig(args[0])
+		if err != nil {
+			return "", err
+		}
+		return marshalFileBackupStoreOutput(cfg)
+	case "system-backup delete":
+		if len(args) != 1 {
+			return "", fmt.Errorf("missing system backup URL for command %v", command)
+		}
+		cfg, err := loadFileSystemBackupConfig(args[0])
+		if err != nil {
+			return "", err
+		}
+		return "", systembackupstore.Delete(cfg)
+	case "system-backup download":
+		if len(args) != 2 {
+			return "", fmt.Errorf("missing system backup URL or download path for command %v", command)
+		}
+		cfg, err := loadFileSystemBackupConfig(args[0])
+		if err != nil {
+			return "", err
+		}
+		return "", systembackupstore.Download(args[1], cfg)
+	case "system-backup upload":
+		return uploadFileSystemBackup(args)
+	}
+	return "", fmt.Errorf("command %v is not supported by the %v backup target", command, types.BackupStoreTypeFile)
+}
+
+func loadFileSystemBackupConfig(systemBackupURL string) (*systembackupstore.Config, error) {
+	backupTargetURL, version, name, err := systembackupstore.ParseSystemBackupURL(systemBackupURL)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := systembackupstore.LoadConfig(name, version, backupTargetURL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot find system backup %v", systemBackupURL)
+	}
+	return cfg, nil
+}
+
+// uploadFileSystemBackup handles "system-backup upload <local file> <system backup URL> [--git-commit <commit>]
+// [--manager-image <image>] [--engine-image <image>]"
+func uploadFileSystemBackup(args []string) (string, error) {
+	if len(args) < 2 {
+		return "", fmt.Errorf("missing local file or system backup URL for system backup upload")
+	}
+	localFile, systemBackupURL := args[0], args[1]
+
+	backupTargetURL, version, name, err := systembackupstore.ParseSystemBackupURL(systemBackupURL)
+	if err != nil {
+		return "", err
+	}
+	checksum, err := bsutil.GetFileChecksum(localFile)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get %v checksum", localFile)
+	}
+	cfg := &systembackupstore.Config{
+		Name:            name,
+		LonghornVersion: version,
+		BackupTargetURL: backupTargetURL,
+		CreatedAt:       time.Now().UTC(),
+		Checksum:        checksum,
+	}
+
+	options := args[2:]
+	for len(options) > 1 {
+		switch options[0] {
+		case "--git-commit":
+			cfg.LonghornGitCommit = options[1]
+		case "--manager-image":
+			cfg.ManagerImage = options[1]
+		case "--engine-image":
+			cfg.EngineImage = options[1]
+		default:
+			return "", fmt.Errorf("invalid option %v of system backup upload", options[0])
+		}
+		options = options[2:]
+	}
+	if len(options) != 0 {
+		return "", fmt.Errorf("invalid options %v of system backup upload", strings.Join(options, " "))
+	}
+
+	if err := systembackupstore.Upload(localFile, cfg); err != nil {
+		return "", err
+	}
+	return systemBackupURL, nil
+}
+
+func marshalFileBackupStoreOutput(v interface{}) (string, error) {
+	output, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(output), nil
+}
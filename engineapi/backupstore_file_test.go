@@ -0,0 +1,70 @@
+package engineapi
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	systembackupstore "github.com/longhorn/backupstore/systembackup"
+)
+
+func TestFileBackupTargetSystemBackup(t *testing.T) {
+	assert := require.New(t)
+
+	backupTargetDir := t.TempDir()
+	btc := NewBackupTargetClient("", "file://"+backupTargetDir, nil, 0)
+
+	backupVolumeNames, err := btc.BackupVolumeNameList()
+	assert.Nil(err)
+	assert.Empty(backupVolumeNames)
+
+	systemBackups, err := btc.ListSystemBackup()
+	assert.Nil(err)
+	assert.Empty(systemBackups)
+
+	localFile := filepath.Join(t.TempDir(), "system-backup.zip")
+	assert.Nil(os.WriteFile(localFile, []byte("system backup content"), 0644))
+
+	_, err = btc.UploadSystemBackup("sb-1", localFile, "v1.8.0", "commit", "manager-image", "engine-image")
+	assert.Nil(err)
+
+	systemBackups, err = btc.ListSystemBackup()
+	assert.Nil(err)
+	assert.Len(systemBackups, 1)
+	assert.Contains(systemBackups, systembackupstore.Name("sb-1"))
+
+	cfg, err := btc.GetSystemBackupConfig("sb-1", "v1.8.0")
+	assert.Nil(err)
+	assert.Equal("sb-1", cfg.Name)
+	assert.Equal("v1.8.0", cfg.LonghornVersion)
+	assert.Equal("manager-image", cfg.ManagerImage)
+	assert.Equal("engine-image", cfg.EngineImage)
+
+	downloadPath := filepath.Join(t.TempDir(), "download.zip")
+	assert.Nil(btc.DownloadSystemBackup("sb-1", "v1.8.0", downloadPath))
+	content, err := os.ReadFile(downloadPath)
+	assert.Nil(err)
+	assert.Equal("system backup content", string(content))
+
+	_, err = btc.ExecuteEngineBinary("system-backup", "delete", "file://"+filepath.Join(backupTargetDir, "backupstore", "system-backups", "v1.8.0", "sb-1"))
+	assert.Nil(err)
+
+	systemBackups, err = btc.ListSystemBackup()
+	assert.Nil(err)
+	assert.Len(systemBackups, 0)
+
+	assert.Nil(btc.BackupCleanUpAllMounts())
+
+	_, err = btc.BackupGarbageCollect(true)
+	assert.NotNil(err)
+}
+
+func TestFileBackupTargetInvalidURL(t *testing.T) {
+	assert := require.New(t)
+
+	btc := NewBackupTargetClient("", "file://relative/path", nil, 0)
+	_, err := btc.BackupVolumeNameList()
+	assert.NotNil(err)
+}
@@ -314,6 +314,11 @@ func (e *EngineBinary) VolumeSnapshotMaxSizeSet(engine *longhorn.Engine) error {
 	return nil
 }
 
+// VolumeIOQoSSet is not supported by engine binary
+func (e *EngineBinary) VolumeIOQoSSet(engine *longhorn.Engine) error {
+	return errors.New(ErrNotImplement)
+}
+
 // ReplicaRebuildVerify calls engine binary
 // TODO: Deprecated, replaced by gRPC proxy
 func (e *EngineBinary) ReplicaRebuildVerify(engine *longhorn.Engine, replicaName, url string) error {
@@ -356,6 +361,10 @@ func (e *EngineBinary) MetricsGet(*longhorn.Engine) (*Metrics, error) {
 	return nil, errors.New(ErrNotImplement)
 }
 
+func (e *EngineBinary) ReplicaMetricsGet(*longhorn.Engine) (map[string]*ReplicaMetrics, error) {
+	return nil, errors.New(ErrNotImplement)
+}
+
 func (e *EngineBinary) RemountReadOnlyVolume(*longhorn.Engine) error {
 	return errors.New(ErrNotImplement)
 }
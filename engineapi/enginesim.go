@@ -2,7 +2,11 @@ package engineapi
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"sort"
+	"strconv"
 	"sync"
 
 	"github.com/pkg/errors"
@@ -12,25 +16,51 @@ import (
 	imapi "github.com/longhorn/longhorn-instance-manager/pkg/api"
 
 	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/util"
+
+	emeta "github.com/longhorn/longhorn-engine/pkg/meta"
+
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
 
+const (
+	// DefaultSimulatorProgressStep is the progress advanced by each status query of the simulated long running
+	// operations, e.g. purge, hash, clone, rebuild, backup and restore.
+	DefaultSimulatorProgressStep = 50
+
+	simulatorVersion = "simulator"
+
+	simulatorFrontendStateUp   = "up"
+	simulatorFrontendStateDown = "down"
+)
+
+// ErrSimulatedTimeout can be injected by SimulateFault to emulate a timed out call.
+var ErrSimulatedTimeout = errors.Wrap(context.DeadlineExceeded, "simulated timeout")
+
 type EngineSimulatorRequest struct {
 	VolumeName     string
 	VolumeSize     int64
 	ControllerAddr string
 	ReplicaAddrs   []string
+
+	// ProgressStep overrides DefaultSimulatorProgressStep if it's positive.
+	ProgressStep int
+	// AsyncRebuild makes the replicas added after the creation start in WO mode. They turn into RW mode once the
+	// rebuilding completes in ReplicaRebuildStatus. Otherwise, the replicas are added in RW mode directly.
+	AsyncRebuild bool
 }
 
 type EngineSimulatorCollection struct {
-	simulators map[string]*EngineSimulator
-	mutex      *sync.Mutex
+	simulators  map[string]*EngineSimulator
+	backupStore *SimulatorBackupStore
+	mutex       *sync.Mutex
 }
 
 func NewEngineSimulatorCollection() *EngineSimulatorCollection {
 	return &EngineSimulatorCollection{
-		simulators: map[string]*EngineSimulator{},
-		mutex:      &sync.Mutex{},
+		simulators:  map[string]*EngineSimulator{},
+		backupStore: NewSimulatorBackupStore(),
+		mutex:       &sync.Mutex{},
 	}
 }
 
@@ -42,18 +72,48 @@ func (c *EngineSimulatorCollection) CreateEngineSimulator(request *EngineSimulat
 		return fmt.Errorf("duplicate simulator with volume name %v already exists", request.VolumeName)
 	}
 	s := &EngineSimulator{
+		collection:     c,
 		volumeName:     request.VolumeName,
 		volumeSize:     request.VolumeSize,
 		controllerAddr: request.ControllerAddr,
 		running:        true,
 		replicas:       map[string]*Replica{},
 		mutex:          &sync.RWMutex{},
+
+		progressStep: request.ProgressStep,
+
+		frontendState: simulatorFrontendStateDown,
+		snapshots: map[string]*simulatorSnapshot{
+			etypes.VolumeHeadName: {
+				info: longhorn.SnapshotInfo{
+					Name:     etypes.VolumeHeadName,
+					Children: map[string]bool{},
+					Created:  util.Now(),
+					Size:     "0",
+					Labels:   map[string]string{},
+				},
+			},
+		},
+		purgeStatus:   map[string]*longhorn.PurgeStatus{},
+		hashTasks:     map[string]map[string]*simulatorHashTask{},
+		corruptions:   map[string]map[string]bool{},
+		cloneStatus:   map[string]*longhorn.SnapshotCloneStatus{},
+		rebuildStatus: map[string]*longhorn.RebuildStatus{},
+		backups:       map[string]*simulatorBackupTask{},
+		restoreStatus: map[string]*longhorn.RestoreStatus{},
+		faults:        map[string]*simulatorFault{},
+
+		replicaMetrics: map[string]ReplicaMetrics{},
+	}
+	if s.progressStep <= 0 {
+		s.progressStep = DefaultSimulatorProgressStep
 	}
 	for _, addr := range request.ReplicaAddrs {
 		if err := s.ReplicaAdd(&longhorn.Engine{}, "", addr, false, false, nil, 30, 0); err != nil {
 			return err
 		}
 	}
+	s.asyncRebuild = request.AsyncRebuild
 	c.simulators[s.volumeName] = s
 	return nil
 }
@@ -72,15 +132,23 @@ func (c *EngineSimulatorCollection) DeleteEngineSimulator(volumeName string) err
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
-	if c.simulators[volumeName] == nil {
+	s := c.simulators[volumeName]
+	if s == nil {
 		return fmt.Errorf("unable to find simulator with volume name %v", volumeName)
 	}
 	// stop the references
-	c.simulators[volumeName].running = false
+	s.mutex.Lock()
+	s.running = false
+	s.mutex.Unlock()
 	delete(c.simulators, volumeName)
 	return nil
 }
 
+// BackupStore returns the in-memory backup store shared by the simulators in the collection.
+func (c *EngineSimulatorCollection) BackupStore() *SimulatorBackupStore {
+	return c.backupStore
+}
+
 func (c *EngineSimulatorCollection) NewEngineClient(request *EngineClientRequest) (EngineClient, error) {
 	engine, err := c.GetEngineSimulator(request.VolumeName)
 	if err != nil {
@@ -89,13 +157,72 @@ func (c *EngineSimulatorCollection) NewEngineClient(request *EngineClientRequest
 	return engine, nil
 }
 
+// EngineSimulator is an in-memory EngineClient of a volume for tests. It models the snapshot chain, the status
+// progression of the long running operations and the backups in the backup store of the collection. The long
+// running operations advance by the progress step each time their status is queried. The faults can be injected
+// by SimulateFault and SimulateStopReplica.
 type EngineSimulator struct {
+	collection *EngineSimulatorCollection
+
 	volumeName     string
 	volumeSize     int64
 	controllerAddr string
 	running        bool
 	replicas       map[string]*Replica
 	mutex          *sync.RWMutex
+
+	progressStep int
+	asyncRebuild bool
+
+	frontendState             string
+	endpoint                  string
+	unmapMarkSnapChainRemoved bool
+	snapshotMaxCount          int
+	snapshotMaxSize           int64
+	ioQoS                     longhorn.VolumeIOQoS
+	metrics                   Metrics
+	replicaMetrics            map[string]ReplicaMetrics
+
+	// snapshots includes the volume head
+	snapshots map[string]*simulatorSnapshot
+	// purgeStatus, cloneStatus, rebuildStatus and restoreStatus are keyed by the replica address
+	purgeStatus   map[string]*longhorn.PurgeStatus
+	cloneStatus   map[string]*longhorn.SnapshotCloneStatus
+	rebuildStatus map[string]*longhorn.RebuildStatus
+	restoreStatus map[string]*longhorn.RestoreStatus
+	// hashTasks and corruptions are keyed by the snapshot name and then the replica address
+	hashTasks   map[string]map[string]*simulatorHashTask
+	corruptions map[string]map[string]bool
+	// backups is keyed by the backup name
+	backups map[string]*simulatorBackupTask
+	// pendingRestore is applied to the volume head once the restoring completes
+	pendingRestore *SimulatorBackup
+
+	// faults is keyed by the name of the EngineClient method
+	faults map[string]*simulatorFault
+}
+
+type simulatorSnapshot struct {
+	info longhorn.SnapshotInfo
+	// extents are the blocks written in the snapshot
+	extents []SnapshotExtent
+}
+
+type simulatorHashTask struct {
+	status   longhorn.HashStatus
+	progress int
+}
+
+type simulatorBackupTask struct {
+	status       longhorn.EngineBackupStatus
+	backupTarget string
+	backup       *SimulatorBackup
+}
+
+type simulatorFault struct {
+	err error
+	// count is the number of the calls left to fail. A negative count fails the calls until the fault is cleared.
+	count int
 }
 
 func (e *EngineSimulator) Name() string {
@@ -114,6 +241,100 @@ func (e *EngineSimulator) Stop(*longhorn.InstanceManager) error {
 	return errors.New(ErrNotImplement)
 }
 
+// SimulateFault makes the next count calls of the EngineClient method operation fail with err. A negative count
+// makes the calls fail until ClearFaults is called. Use ErrSimulatedTimeout to emulate a timeout.
+func (e *EngineSimulator) SimulateFault(operation string, err error, count int) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if count == 0 {
+		delete(e.faults, operation)
+		return
+	}
+	e.faults[operation] = &simulatorFault{
+		err:   err,
+		count: count,
+	}
+}
+
+func (e *EngineSimulator) ClearFaults() {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	e.faults = map[string]*simulatorFault{}
+}
+
+// checkFault returns the error of the operation if the simulator is stopped or a fault is injected.
+// The caller must hold the lock.
+func (e *EngineSimulator) checkFault(operation string) error {
+	if !e.running {
+		return fmt.Errorf("engine simulator of volume %v is not running", e.volumeName)
+	}
+
+	fault, ok := e.faults[operation]
+	if !ok {
+		return nil
+	}
+	if fault.count > 0 {
+		fault.count--
+		if fault.count == 0 {
+			delete(e.faults, operation)
+		}
+	}
+	return errors.Wrapf(fault.err, "simulated fault of %v", operation)
+}
+
+// SimulateWrite records the blocks written to the volume head, which become the data of the next snapshot.
+func (e *EngineSimulator) SimulateWrite(offset, length int64) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if offset < 0 || length <= 0 || offset+length > e.volumeSize {
+		return fmt.Errorf("invalid write at offset %v with length %v for volume size %v", offset, length, e.volumeSize)
+	}
+	head := e.snapshots[etypes.VolumeHeadName]
+	head.extents = mergeSnapshotExtents(append(head.extents, SnapshotExtent{Offset: offset, Length: length}))
+	head.info.Size = strconv.FormatInt(getSnapshotExtentsSize(head.extents), 10)
+	return nil
+}
+
+// SimulateSnapshotCorruption makes the checksum of the snapshot on the replica differ from the others.
+func (e *EngineSimulator) SimulateSnapshotCorruption(snapshotName, addr string) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if e.snapshots[snapshotName] == nil {
+		return fmt.Errorf("unable to find snapshot %v", snapshotName)
+	}
+	if e.replicas[addr] == nil {
+		return fmt.Errorf("unable to find replica %v", addr)
+	}
+	if e.corruptions[snapshotName] == nil {
+		e.corruptions[snapshotName] = map[string]bool{}
+	}
+	e.corruptions[snapshotName][addr] = true
+	return nil
+}
+
+func (e *EngineSimulator) SimulateMetrics(metrics Metrics) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	e.metrics = metrics
+}
+
+// SimulateReplicaMetrics sets the IO statistics of the replica returned by ReplicaMetricsGet.
+func (e *EngineSimulator) SimulateReplicaMetrics(addr string, metrics ReplicaMetrics) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if e.replicas[addr] == nil {
+		return fmt.Errorf("unable to find replica %v", addr)
+	}
+	e.replicaMetrics[addr] = metrics
+	return nil
+}
+
 func (e *EngineSimulator) ReplicaList(*longhorn.Engine) (map[string]*Replica, error) {
 	e.mutex.RLock()
 	defer e.mutex.RUnlock()
@@ -130,6 +351,10 @@ func (e *EngineSimulator) ReplicaAdd(engine *longhorn.Engine, replicaName, url s
 	e.mutex.Lock()
 	defer e.mutex.Unlock()
 
+	if err := e.checkFault("ReplicaAdd"); err != nil {
+		return err
+	}
+
 	for name, replica := range e.replicas {
 		if replica.Mode == longhorn.ReplicaModeERR {
 			return fmt.Errorf("replica %v is in ERR mode, cannot add new replica", name)
@@ -138,9 +363,24 @@ func (e *EngineSimulator) ReplicaAdd(engine *longhorn.Engine, replicaName, url s
 	if e.replicas[url] != nil {
 		return fmt.Errorf("duplicate replica %v already exists", url)
 	}
+
+	rwReplicaAddrs := e.getRWReplicaAddrs()
+	if !e.asyncRebuild || len(rwReplicaAddrs) == 0 {
+		e.replicas[url] = &Replica{
+			URL:  url,
+			Mode: longhorn.ReplicaModeRW,
+		}
+		return nil
+	}
+
 	e.replicas[url] = &Replica{
 		URL:  url,
-		Mode: longhorn.ReplicaModeRW,
+		Mode: longhorn.ReplicaModeWO,
+	}
+	e.rebuildStatus[url] = &longhorn.RebuildStatus{
+		IsRebuilding:       true,
+		State:              ProcessStateInProgress,
+		FromReplicaAddress: rwReplicaAddrs[0],
 	}
 	return nil
 }
@@ -149,13 +389,26 @@ func (e *EngineSimulator) ReplicaRemove(engine *longhorn.Engine, addr, replicaNa
 	e.mutex.Lock()
 	defer e.mutex.Unlock()
 
+	if err := e.checkFault("ReplicaRemove"); err != nil {
+		return err
+	}
+
 	if e.replicas[addr] == nil {
 		return fmt.Errorf("unable to find replica %v", addr)
 	}
 	delete(e.replicas, addr)
+	delete(e.rebuildStatus, addr)
+	delete(e.purgeStatus, addr)
+	delete(e.cloneStatus, addr)
+	delete(e.restoreStatus, addr)
+	delete(e.replicaMetrics, addr)
+	for _, tasks := range e.hashTasks {
+		delete(tasks, addr)
+	}
 	return nil
 }
 
+// SimulateStopReplica turns the replica into ERR mode and fails the operations in progress on it.
 func (e *EngineSimulator) SimulateStopReplica(addr string) error {
 	e.mutex.Lock()
 	defer e.mutex.Unlock()
@@ -164,127 +417,699 @@ func (e *EngineSimulator) SimulateStopReplica(addr string) error {
 		return fmt.Errorf("unable to find replica %v", addr)
 	}
 	e.replicas[addr].Mode = longhorn.ReplicaModeERR
+
+	errMsg := fmt.Sprintf("replica %v is in ERR mode", addr)
+	if status := e.rebuildStatus[addr]; status != nil && status.IsRebuilding {
+		status.IsRebuilding = false
+		status.State = ProcessStateError
+		status.Error = errMsg
+	}
+	for _, status := range e.rebuildStatus {
+		if status.IsRebuilding && status.FromReplicaAddress == addr {
+			status.IsRebuilding = false
+			status.State = ProcessStateError
+			status.Error = errMsg
+		}
+	}
+	if status := e.purgeStatus[addr]; status != nil && status.IsPurging {
+		status.IsPurging = false
+		status.State = ProcessStateError
+		status.Error = errMsg
+	}
+	if status := e.cloneStatus[addr]; status != nil && status.IsCloning {
+		status.IsCloning = false
+		status.State = ProcessStateError
+		status.Error = errMsg
+	}
+	if status := e.restoreStatus[addr]; status != nil && status.IsRestoring {
+		status.IsRestoring = false
+		status.State = ProcessStateError
+		status.Error = errMsg
+	}
+	for _, tasks := range e.hashTasks {
+		if task := tasks[addr]; task != nil && task.status.State == ProcessStateInProgress {
+			task.status.State = ProcessStateError
+			task.status.Error = errMsg
+		}
+	}
+	for _, task := range e.backups {
+		if task.status.ReplicaAddress == addr && task.status.State == ProcessStateInProgress {
+			task.status.State = ProcessStateError
+			task.status.Error = errMsg
+		}
+	}
+	return nil
+}
+
+func (e *EngineSimulator) ReplicaRebuildStatus(*longhorn.Engine) (map[string]*longhorn.RebuildStatus, error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if err := e.checkFault("ReplicaRebuildStatus"); err != nil {
+		return nil, err
+	}
+
+	ret := map[string]*longhorn.RebuildStatus{}
+	for addr, status := range e.rebuildStatus {
+		if status.IsRebuilding {
+			status.Progress = e.advance(status.Progress)
+			if status.Progress == 100 {
+				status.IsRebuilding = false
+				status.State = ProcessStateComplete
+				if replica := e.replicas[addr]; replica != nil && replica.Mode == longhorn.ReplicaModeWO {
+					replica.Mode = longhorn.ReplicaModeRW
+				}
+			}
+		}
+		ret[addr] = status.DeepCopy()
+	}
+	return ret, nil
+}
+
+func (e *EngineSimulator) ReplicaRebuildVerify(engine *longhorn.Engine, replicaName, url string) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if err := e.checkFault("ReplicaRebuildVerify"); err != nil {
+		return err
+	}
+
+	replica := e.replicas[url]
+	if replica == nil {
+		return fmt.Errorf("unable to find replica %v", url)
+	}
+	if status := e.rebuildStatus[url]; status != nil && status.IsRebuilding {
+		return fmt.Errorf("replica %v is still rebuilding", url)
+	}
+	if replica.Mode == longhorn.ReplicaModeERR {
+		return fmt.Errorf("replica %v is in ERR mode", url)
+	}
+	replica.Mode = longhorn.ReplicaModeRW
+	return nil
+}
+
+func (e *EngineSimulator) ReplicaModeUpdate(engine *longhorn.Engine, url, mode string) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if err := e.checkFault("ReplicaModeUpdate"); err != nil {
+		return err
+	}
+
+	replica := e.replicas[url]
+	if replica == nil {
+		return fmt.Errorf("unable to find replica %v", url)
+	}
+	replica.Mode = longhorn.ReplicaMode(mode)
 	return nil
 }
 
 func (e *EngineSimulator) SnapshotCreate(engine *longhorn.Engine, name string, labels map[string]string,
 	freezeFilesystem bool) (string, error) {
-	return "", errors.New(ErrNotImplement)
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if err := e.checkFault("SnapshotCreate"); err != nil {
+		return "", err
+	}
+	if len(e.getRWReplicaAddrs()) == 0 {
+		return "", fmt.Errorf("no RW replica for volume %v to create snapshot", e.volumeName)
+	}
+
+	if name == "" {
+		name = util.UUID()
+	}
+	if e.snapshots[name] != nil {
+		return "", fmt.Errorf("snapshot %v already exists", name)
+	}
+	if e.snapshotMaxCount > 0 && len(e.snapshots)-1 >= e.snapshotMaxCount {
+		return "", fmt.Errorf("snapshot count %v reaches the max count %v", len(e.snapshots)-1, e.snapshotMaxCount)
+	}
+
+	snapshotLabels := map[string]string{}
+	for k, v := range labels {
+		snapshotLabels[k] = v
+	}
+	e.addSnapshotBeforeVolumeHead(name, true, snapshotLabels, e.snapshots[etypes.VolumeHeadName].extents)
+	return name, nil
+}
+
+// addSnapshotBeforeVolumeHead inserts the snapshot between the volume head and its parent, and resets the volume
+// head. The caller must hold the lock.
+func (e *EngineSimulator) addSnapshotBeforeVolumeHead(name string, userCreated bool, labels map[string]string, extents []SnapshotExtent) {
+	head := e.snapshots[etypes.VolumeHeadName]
+	snapshot := &simulatorSnapshot{
+		info: longhorn.SnapshotInfo{
+			Name:        name,
+			Parent:      head.info.Parent,
+			Children:    map[string]bool{etypes.VolumeHeadName: true},
+			UserCreated: userCreated,
+			Created:     util.Now(),
+			Size:        strconv.FormatInt(getSnapshotExtentsSize(extents), 10),
+			Labels:      labels,
+		},
+		extents: extents,
+	}
+	if parent := e.snapshots[head.info.Parent]; parent != nil {
+		delete(parent.info.Children, etypes.VolumeHeadName)
+		parent.info.Children[name] = true
+	}
+	e.snapshots[name] = snapshot
+
+	head.info.Parent = name
+	head.info.Created = util.Now()
+	head.info.Size = "0"
+	head.extents = nil
 }
 
 func (e *EngineSimulator) SnapshotList(engine *longhorn.Engine) (map[string]*longhorn.SnapshotInfo, error) {
-	return nil, errors.New(ErrNotImplement)
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if err := e.checkFault("SnapshotList"); err != nil {
+		return nil, err
+	}
+
+	ret := map[string]*longhorn.SnapshotInfo{}
+	for name, snapshot := range e.snapshots {
+		ret[name] = snapshot.info.DeepCopy()
+	}
+	return ret, nil
 }
 
 func (e *EngineSimulator) SnapshotGet(engine *longhorn.Engine, name string) (*longhorn.SnapshotInfo, error) {
-	return nil, errors.New(ErrNotImplement)
+	snapshots, err := e.SnapshotList(engine)
+	if err != nil {
+		return nil, err
+	}
+	return snapshots[name], nil
 }
 
 func (e *EngineSimulator) SnapshotDelete(engine *longhorn.Engine, name string) error {
-	return errors.New(ErrNotImplement)
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if err := e.checkFault("SnapshotDelete"); err != nil {
+		return err
+	}
+
+	if name == etypes.VolumeHeadName {
+		return fmt.Errorf("invalid operation: cannot remove %v", etypes.VolumeHeadName)
+	}
+	snapshot := e.snapshots[name]
+	if snapshot == nil {
+		return fmt.Errorf("unable to find snapshot %v", name)
+	}
+	snapshot.info.Removed = true
+	return nil
 }
 
 func (e *EngineSimulator) SnapshotRevert(engine *longhorn.Engine, name string) error {
-	return errors.New(ErrNotImplement)
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if err := e.checkFault("SnapshotRevert"); err != nil {
+		return err
+	}
+
+	if e.frontendState == simulatorFrontendStateUp {
+		return fmt.Errorf("cannot revert volume %v to snapshot %v with the frontend up", e.volumeName, name)
+	}
+	if name == etypes.VolumeHeadName {
+		return fmt.Errorf("invalid operation: cannot revert to %v", etypes.VolumeHeadName)
+	}
+	snapshot := e.snapshots[name]
+	if snapshot == nil {
+		return fmt.Errorf("unable to find snapshot %v", name)
+	}
+	if snapshot.info.Removed {
+		return fmt.Errorf("cannot revert to removed snapshot %v", name)
+	}
+
+	head := e.snapshots[etypes.VolumeHeadName]
+	if parent := e.snapshots[head.info.Parent]; parent != nil {
+		delete(parent.info.Children, etypes.VolumeHeadName)
+	}
+	snapshot.info.Children[etypes.VolumeHeadName] = true
+	head.info.Parent = name
+	head.info.Created = util.Now()
+	head.info.Size = "0"
+	head.extents = nil
+	return nil
 }
 
 func (e *EngineSimulator) SnapshotPurge(*longhorn.Engine) error {
-	return errors.New(ErrNotImplement)
-}
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
 
-func (e *EngineSimulator) SnapshotPurgeStatus(*longhorn.Engine) (map[string]*longhorn.PurgeStatus, error) {
-	return nil, errors.New(ErrNotImplement)
-}
+	if err := e.checkFault("SnapshotPurge"); err != nil {
+		return err
+	}
 
-func (e *EngineSimulator) SnapshotBackup(engine *longhorn.Engine, snapshotName, backupName, backupTarget,
-	backingImageName, backingImageChecksum, compressionMethod string, concurrentLimit int, storageClassName string,
-	labels, credential, parameters map[string]string) (string, string, error) {
-	return "", "", errors.New(ErrNotImplement)
+	rwReplicaAddrs := e.getRWReplicaAddrs()
+	if len(rwReplicaAddrs) == 0 {
+		return fmt.Errorf("no RW replica for volume %v to purge snapshots", e.volumeName)
+	}
+	for _, addr := range rwReplicaAddrs {
+		if status := e.purgeStatus[addr]; status != nil && status.IsPurging {
+			return fmt.Errorf("replica %v is purging snapshots", addr)
+		}
+	}
+
+	e.purgeRemovedSnapshots()
+	for _, addr := range rwReplicaAddrs {
+		e.purgeStatus[addr] = &longhorn.PurgeStatus{
+			IsPurging: true,
+			State:     ProcessStateInProgress,
+		}
+	}
+	return nil
 }
 
-func (e *EngineSimulator) SnapshotBackupStatus(engine *longhorn.Engine, backupName, replicaAddress,
-	replicaName string) (*longhorn.EngineBackupStatus, error) {
-	return nil, errors.New(ErrNotImplement)
+// purgeRemovedSnapshots deletes the removed snapshots without children, and coalesces each removed snapshot with
+// a single child into the child. The snapshot whose child is the volume head cannot be coalesced, which is the same
+// as the engine. The caller must hold the lock.
+func (e *EngineSimulator) purgeRemovedSnapshots() {
+	for purged := true; purged; {
+		purged = false
+		for name, snapshot := range e.snapshots {
+			if name == etypes.VolumeHeadName || !snapshot.info.Removed || len(snapshot.info.Children) > 1 {
+				continue
+			}
+
+			parent := e.snapshots[snapshot.info.Parent]
+			if len(snapshot.info.Children) == 0 {
+				if parent != nil {
+					delete(parent.info.Children, name)
+				}
+				delete(e.snapshots, name)
+				purged = true
+				continue
+			}
+
+			var childName string
+			for childName = range snapshot.info.Children {
+			}
+			if childName == etypes.VolumeHeadName {
+				continue
+			}
+			child := e.snapshots[childName]
+			child.extents = mergeSnapshotExtents(append(append([]SnapshotExtent{}, snapshot.extents...), child.extents...))
+			child.info.Size = strconv.FormatInt(getSnapshotExtentsSize(child.extents), 10)
+			child.info.Parent = snapshot.info.Parent
+			if parent != nil {
+				delete(parent.info.Children, name)
+				parent.info.Children[childName] = true
+			}
+			delete(e.snapshots, name)
+			purged = true
+		}
+	}
 }
 
-func (e *EngineSimulator) VersionGet(engine *longhorn.Engine, clientOnly bool) (*EngineVersion, error) {
-	return nil, errors.New(ErrNotImplement)
+func (e *EngineSimulator) SnapshotPurgeStatus(*longhorn.Engine) (map[string]*longhorn.PurgeStatus, error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if err := e.checkFault("SnapshotPurgeStatus"); err != nil {
+		return nil, err
+	}
+
+	ret := map[string]*longhorn.PurgeStatus{}
+	for addr, status := range e.purgeStatus {
+		if status.IsPurging {
+			status.Progress = e.advance(status.Progress)
+			if status.Progress == 100 {
+				status.IsPurging = false
+				status.State = ProcessStateComplete
+			}
+		}
+		ret[addr] = status.DeepCopy()
+	}
+	return ret, nil
 }
 
-func (e *EngineSimulator) VolumeGet(*longhorn.Engine) (*Volume, error) {
-	return nil, errors.New(ErrNotImplement)
+func (e *EngineSimulator) SnapshotHash(engine *longhorn.Engine, snapshotName string, rehash bool) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if err := e.checkFault("SnapshotHash"); err != nil {
+		return err
+	}
+
+	if snapshotName == etypes.VolumeHeadName {
+		return fmt.Errorf("invalid operation: cannot hash %v", etypes.VolumeHeadName)
+	}
+	if e.snapshots[snapshotName] == nil {
+		return fmt.Errorf("unable to find snapshot %v", snapshotName)
+	}
+
+	tasks := e.hashTasks[snapshotName]
+	if tasks == nil {
+		tasks = map[string]*simulatorHashTask{}
+		e.hashTasks[snapshotName] = tasks
+	}
+	for _, addr := range e.getRWReplicaAddrs() {
+		task := tasks[addr]
+		if task != nil && task.status.State == ProcessStateInProgress {
+			continue
+		}
+		if task != nil && task.status.State == ProcessStateComplete && !rehash {
+			continue
+		}
+		tasks[addr] = &simulatorHashTask{
+			status: longhorn.HashStatus{
+				State: ProcessStateInProgress,
+			},
+		}
+	}
+	return nil
 }
 
-func (e *EngineSimulator) VolumeExpand(*longhorn.Engine) error {
-	return errors.New(ErrNotImplement)
+func (e *EngineSimulator) SnapshotHashStatus(engine *longhorn.Engine, snapshotName string) (map[string]*longhorn.HashStatus, error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if err := e.checkFault("SnapshotHashStatus"); err != nil {
+		return nil, err
+	}
+
+	snapshot := e.snapshots[snapshotName]
+	ret := map[string]*longhorn.HashStatus{}
+	for addr, task := range e.hashTasks[snapshotName] {
+		if task.status.State == ProcessStateInProgress {
+			task.progress = e.advance(task.progress)
+			if task.progress == 100 {
+				if snapshot == nil {
+					task.status.State = ProcessStateError
+					task.status.Error = fmt.Sprintf("snapshot %v is purged during hashing", snapshotName)
+				} else {
+					task.status.State = ProcessStateComplete
+					task.status.Checksum = getSimulatedSnapshotChecksum(snapshot, e.corruptions[snapshotName][addr])
+				}
+			}
+		}
+		status := task.status
+		ret[addr] = &status
+	}
+	return ret, nil
 }
 
-func (e *EngineSimulator) BackupRestore(engine *longhorn.Engine, backupTarget, backupName, backupVolume, lastRestored string, credential map[string]string, concurrentLimit int) error {
-	return errors.New(ErrNotImplement)
+func (e *EngineSimulator) SnapshotChangedBlocks(engine *longhorn.Engine, fromSnapshotName, toSnapshotName string) ([]SnapshotExtent, error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if err := e.checkFault("SnapshotChangedBlocks"); err != nil {
+		return nil, err
+	}
+
+	infos := map[string]*longhorn.SnapshotInfo{}
+	for name, snapshot := range e.snapshots {
+		infos[name] = &snapshot.info
+	}
+	if err := ValidateSnapshotChangedBlocksRange(infos, fromSnapshotName, toSnapshotName); err != nil {
+		return nil, err
+	}
+
+	extents := []SnapshotExtent{}
+	for name := toSnapshotName; name != fromSnapshotName; name = e.snapshots[name].info.Parent {
+		extents = append(extents, e.snapshots[name].extents...)
+	}
+	return mergeSnapshotExtents(extents), nil
 }
 
 func (e *EngineSimulator) SnapshotClone(engine *longhorn.Engine, snapshotName, fromEngineAddress, fromVolumeName,
 	fromEngineName string, fileSyncHTTPClientTimeout, grpcTimeoutSeconds int64) error {
-	return errors.New(ErrNotImplement)
+	if e.collection == nil {
+		return fmt.Errorf("engine simulator of volume %v doesn't belong to a collection", e.volumeName)
+	}
+	source, err := e.collection.GetEngineSimulator(fromVolumeName)
+	if err != nil {
+		return err
+	}
+	extents, fromReplicaAddr, err := source.getSnapshotDataForClone(snapshotName)
+	if err != nil {
+		return err
+	}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if err := e.checkFault("SnapshotClone"); err != nil {
+		return err
+	}
+
+	rwReplicaAddrs := e.getRWReplicaAddrs()
+	if len(rwReplicaAddrs) == 0 {
+		return fmt.Errorf("no RW replica for volume %v to clone snapshot", e.volumeName)
+	}
+	for _, addr := range rwReplicaAddrs {
+		if status := e.cloneStatus[addr]; status != nil && status.IsCloning {
+			return fmt.Errorf("replica %v is cloning snapshot %v", addr, status.SnapshotName)
+		}
+	}
+	if e.snapshots[snapshotName] != nil {
+		return fmt.Errorf("snapshot %v already exists", snapshotName)
+	}
+
+	e.addSnapshotBeforeVolumeHead(snapshotName, false, map[string]string{}, extents)
+	for _, addr := range rwReplicaAddrs {
+		e.cloneStatus[addr] = &longhorn.SnapshotCloneStatus{
+			IsCloning:          true,
+			State:              ProcessStateInProgress,
+			FromReplicaAddress: fromReplicaAddr,
+			SnapshotName:       snapshotName,
+		}
+	}
+	return nil
 }
 
-func (e *EngineSimulator) BackupRestoreStatus(*longhorn.Engine) (map[string]*longhorn.RestoreStatus, error) {
-	return nil, errors.New(ErrNotImplement)
+// getSnapshotDataForClone returns the data of the snapshot including its ancestors, and the replica to clone from.
+func (e *EngineSimulator) getSnapshotDataForClone(snapshotName string) ([]SnapshotExtent, string, error) {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+
+	if !e.running {
+		return nil, "", fmt.Errorf("engine simulator of volume %v is not running", e.volumeName)
+	}
+	if snapshotName == etypes.VolumeHeadName || e.snapshots[snapshotName] == nil {
+		return nil, "", fmt.Errorf("unable to find snapshot %v in volume %v", snapshotName, e.volumeName)
+	}
+	rwReplicaAddrs := e.getRWReplicaAddrs()
+	if len(rwReplicaAddrs) == 0 {
+		return nil, "", fmt.Errorf("no RW replica for volume %v to clone from", e.volumeName)
+	}
+
+	extents := []SnapshotExtent{}
+	for name := snapshotName; name != ""; name = e.snapshots[name].info.Parent {
+		extents = append(extents, e.snapshots[name].extents...)
+	}
+	return mergeSnapshotExtents(extents), rwReplicaAddrs[0], nil
 }
 
 func (e *EngineSimulator) SnapshotCloneStatus(*longhorn.Engine) (map[string]*longhorn.SnapshotCloneStatus, error) {
-	return nil, errors.New(ErrNotImplement)
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if err := e.checkFault("SnapshotCloneStatus"); err != nil {
+		return nil, err
+	}
+
+	ret := map[string]*longhorn.SnapshotCloneStatus{}
+	for addr, status := range e.cloneStatus {
+		if status.IsCloning {
+			status.Progress = e.advance(status.Progress)
+			if status.Progress == 100 {
+				status.IsCloning = false
+				status.State = ProcessStateComplete
+			}
+		}
+		ret[addr] = status.DeepCopy()
+	}
+	return ret, nil
 }
 
-func (e *EngineSimulator) ReplicaRebuildStatus(*longhorn.Engine) (map[string]*longhorn.RebuildStatus, error) {
-	return nil, errors.New(ErrNotImplement)
+func (e *EngineSimulator) VersionGet(engine *longhorn.Engine, clientOnly bool) (*EngineVersion, error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if err := e.checkFault("VersionGet"); err != nil {
+		return nil, err
+	}
+
+	details := longhorn.EngineVersionDetails{
+		Version:                 simulatorVersion,
+		CLIAPIVersion:           emeta.CLIAPIVersion,
+		CLIAPIMinVersion:        emeta.CLIAPIMinVersion,
+		ControllerAPIVersion:    emeta.ControllerAPIVersion,
+		ControllerAPIMinVersion: emeta.ControllerAPIMinVersion,
+		DataFormatVersion:       emeta.DataFormatVersion,
+		DataFormatMinVersion:    emeta.DataFormatMinVersion,
+	}
+	version := &EngineVersion{
+		ClientVersion: details.DeepCopy(),
+	}
+	if !clientOnly {
+		version.ServerVersion = details.DeepCopy()
+	}
+	return version, nil
 }
 
-func (e *EngineSimulator) VolumeFrontendStart(*longhorn.Engine) error {
-	return errors.New(ErrNotImplement)
+func (e *EngineSimulator) VolumeGet(engine *longhorn.Engine) (*Volume, error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if err := e.checkFault("VolumeGet"); err != nil {
+		return nil, err
+	}
+
+	return &Volume{
+		Name:                      e.volumeName,
+		Size:                      e.volumeSize,
+		ReplicaCount:              len(e.replicas),
+		Endpoint:                  e.endpoint,
+		Frontend:                  string(engine.Spec.Frontend),
+		FrontendState:             e.frontendState,
+		UnmapMarkSnapChainRemoved: e.unmapMarkSnapChainRemoved,
+		SnapshotMaxCount:          e.snapshotMaxCount,
+		SnapshotMaxSize:           e.snapshotMaxSize,
+	}, nil
 }
-func (e *EngineSimulator) VolumeFrontendShutdown(*longhorn.Engine) error {
-	return errors.New(ErrNotImplement)
+
+func (e *EngineSimulator) VolumeExpand(engine *longhorn.Engine) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if err := e.checkFault("VolumeExpand"); err != nil {
+		return err
+	}
+
+	if engine.Spec.VolumeSize < e.volumeSize {
+		return fmt.Errorf("cannot shrink volume %v from %v to %v", e.volumeName, e.volumeSize, engine.Spec.VolumeSize)
+	}
+	e.volumeSize = engine.Spec.VolumeSize
+	return nil
 }
 
-func (e *EngineSimulator) VolumeUnmapMarkSnapChainRemovedSet(*longhorn.Engine) error {
-	return errors.New(ErrNotImplement)
+func (e *EngineSimulator) VolumeFrontendStart(engine *longhorn.Engine) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if err := e.checkFault("VolumeFrontendStart"); err != nil {
+		return err
+	}
+
+	e.frontendState = simulatorFrontendStateUp
+	e.endpoint = "/dev/longhorn/" + e.volumeName
+	return nil
 }
 
-func (e *EngineSimulator) VolumeSnapshotMaxCountSet(*longhorn.Engine) error {
-	return errors.New(ErrNotImplement)
+func (e *EngineSimulator) VolumeFrontendShutdown(*longhorn.Engine) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if err := e.checkFault("VolumeFrontendShutdown"); err != nil {
+		return err
+	}
+
+	e.frontendState = simulatorFrontendStateDown
+	e.endpoint = ""
+	return nil
 }
 
-func (e *EngineSimulator) VolumeSnapshotMaxSizeSet(*longhorn.Engine) error {
-	return errors.New(ErrNotImplement)
+func (e *EngineSimulator) VolumeUnmapMarkSnapChainRemovedSet(engine *longhorn.Engine) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if err := e.checkFault("VolumeUnmapMarkSnapChainRemovedSet"); err != nil {
+		return err
+	}
+
+	e.unmapMarkSnapChainRemoved = engine.Spec.UnmapMarkSnapChainRemovedEnabled
+	return nil
 }
 
-func (e *EngineSimulator) ReplicaRebuildVerify(engine *longhorn.Engine, replicaName, url string) error {
-	return errors.New(ErrNotImplement)
+func (e *EngineSimulator) VolumeSnapshotMaxCountSet(engine *longhorn.Engine) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if err := e.checkFault("VolumeSnapshotMaxCountSet"); err != nil {
+		return err
+	}
+
+	e.snapshotMaxCount = engine.Spec.SnapshotMaxCount
+	return nil
 }
 
-func (e *EngineSimulator) SnapshotHash(engine *longhorn.Engine, snapshotName string, rehash bool) error {
-	return errors.New(ErrNotImplement)
+func (e *EngineSimulator) VolumeSnapshotMaxSizeSet(engine *longhorn.Engine) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if err := e.checkFault("VolumeSnapshotMaxSizeSet"); err != nil {
+		return err
+	}
+
+	e.snapshotMaxSize = engine.Spec.SnapshotMaxSize
+	return nil
 }
 
-func (e *EngineSimulator) SnapshotHashStatus(engine *longhorn.Engine, snapshotName string) (map[string]*longhorn.HashStatus, error) {
-	return nil, errors.New(ErrNotImplement)
+func (e *EngineSimulator) VolumeIOQoSSet(engine *longhorn.Engine) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if err := e.checkFault("VolumeIOQoSSet"); err != nil {
+		return err
+	}
+
+	e.ioQoS = engine.Spec.IOQoS
+	return nil
 }
 
-func (e *EngineSimulator) ReplicaModeUpdate(engine *longhorn.Engine, url, mode string) error {
-	return errors.New(ErrNotImplement)
+// IOQoS returns the IO QoS applied to the simulator.
+func (e *EngineSimulator) IOQoS() longhorn.VolumeIOQoS {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+
+	return e.ioQoS
 }
 
 func (e *EngineSimulator) MetricsGet(*longhorn.Engine) (*Metrics, error) {
-	return nil, errors.New(ErrNotImplement)
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if err := e.checkFault("MetricsGet"); err != nil {
+		return nil, err
+	}
+
+	metrics := e.metrics
+	return &metrics, nil
+}
+
+func (e *EngineSimulator) ReplicaMetricsGet(*longhorn.Engine) (map[string]*ReplicaMetrics, error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if err := e.checkFault("ReplicaMetricsGet"); err != nil {
+		return nil, err
+	}
+
+	ret := map[string]*ReplicaMetrics{}
+	for addr := range e.replicas {
+		metrics := e.replicaMetrics[addr]
+		ret[addr] = &metrics
+	}
+	return ret, nil
 }
 
 func (e *EngineSimulator) CleanupBackupMountPoints() error {
-	return errors.New(ErrNotImplement)
+	return nil
 }
 
 func (e *EngineSimulator) RemountReadOnlyVolume(*longhorn.Engine) error {
-	return errors.New(ErrNotImplement)
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	return e.checkFault("RemountReadOnlyVolume")
 }
 
 func (e *EngineSimulator) SPDKBackingImageCreate(name, backingImageUUID, diskUUID, checksum, fromAddress, srcDiskUUID string, size uint64) (*imapi.BackingImage, error) {
@@ -306,3 +1131,61 @@ func (e *EngineSimulator) SPDKBackingImageList() (map[string]longhorn.BackingIma
 func (e *EngineSimulator) SPDKBackingImageWatch(ctx context.Context) (*imapi.BackingImageStream, error) {
 	return nil, errors.New(ErrNotImplement)
 }
+
+// getRWReplicaAddrs returns the sorted addresses of the RW replicas. The caller must hold the lock.
+func (e *EngineSimulator) getRWReplicaAddrs() []string {
+	addrs := []string{}
+	for addr, replica := range e.replicas {
+		if replica.Mode == longhorn.ReplicaModeRW {
+			addrs = append(addrs, addr)
+		}
+	}
+	sort.Strings(addrs)
+	return addrs
+}
+
+func (e *EngineSimulator) advance(progress int) int {
+	progress += e.progressStep
+	if progress > 100 {
+		progress = 100
+	}
+	return progress
+}
+
+// mergeSnapshotExtents sorts the extents and merges the overlapping or adjacent ones.
+func mergeSnapshotExtents(extents []SnapshotExtent) []SnapshotExtent {
+	sorted := append([]SnapshotExtent{}, extents...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Offset < sorted[j].Offset })
+
+	merged := []SnapshotExtent{}
+	for _, extent := range sorted {
+		if last := len(merged) - 1; last >= 0 && extent.Offset <= merged[last].Offset+merged[last].Length {
+			if end := extent.Offset + extent.Length; end > merged[last].Offset+merged[last].Length {
+				merged[last].Length = end - merged[last].Offset
+			}
+			continue
+		}
+		merged = append(merged, extent)
+	}
+	return merged
+}
+
+func getSnapshotExtentsSize(extents []SnapshotExtent) int64 {
+	size := int64(0)
+	for _, extent := range extents {
+		size += extent.Length
+	}
+	return size
+}
+
+func getSimulatedSnapshotChecksum(snapshot *simulatorSnapshot, corrupted bool) string {
+	h := sha256.New()
+	h.Write([]byte(snapshot.info.Name))
+	for _, extent := range snapshot.extents {
+		h.Write([]byte(fmt.Sprintf("%d-%d", extent.Offset, extent.Length)))
+	}
+	if corrupted {
+		h.Write([]byte("corrupted"))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,258 @@
+package engineapi
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/longhorn/backupstore"
+
+	etypes "github.com/longhorn/longhorn-engine/pkg/types"
+
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// SimulatorBackup is a backup committed to the SimulatorBackupStore.
+type SimulatorBackup struct {
+	Name         string
+	URL          string
+	VolumeName   string
+	SnapshotName string
+	BackupTarget string
+	Size         int64
+	Created      string
+	Labels       map[string]string
+	// Extents are the blocks of the snapshot including its ancestors
+	Extents []SnapshotExtent
+}
+
+// SimulatorBackupStore is an in-memory backup store keyed by the backup URL. It's shared by the simulators of an
+// EngineSimulatorCollection so that a backup of one volume can be restored to another.
+type SimulatorBackupStore struct {
+	backups map[string]*SimulatorBackup
+	mutex   *sync.RWMutex
+}
+
+func NewSimulatorBackupStore() *SimulatorBackupStore {
+	return &SimulatorBackupStore{
+		backups: map[string]*SimulatorBackup{},
+		mutex:   &sync.RWMutex{},
+	}
+}
+
+func (s *SimulatorBackupStore) put(backup *SimulatorBackup) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.backups[backup.URL] = backup
+}
+
+// Get returns the backup of the URL, or nil if it doesn't exist.
+func (s *SimulatorBackupStore) Get(backupURL string) *SimulatorBackup {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	backup := s.backups[backupURL]
+	if backup == nil {
+		return nil
+	}
+	return backup.copy()
+}
+
+// List returns the backups of the volume in the backup target sorted by name.
+func (s *SimulatorBackupStore) List(backupTarget, volumeName string) []*SimulatorBackup {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	backups := []*SimulatorBackup{}
+	for _, backup := range s.backups {
+		if backup.BackupTarget == backupTarget && backup.VolumeName == volumeName {
+			backups = append(backups, backup.copy())
+		}
+	}
+	sort.Slice(backups, func(i, j int) bool { return backups[i].Name < backups[j].Name })
+	return backups
+}
+
+func (s *SimulatorBackupStore) Delete(backupURL string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.backups[backupURL] == nil {
+		return fmt.Errorf("unable to find backup %v", backupURL)
+	}
+	delete(s.backups, backupURL)
+	return nil
+}
+
+func (b *SimulatorBackup) copy() *SimulatorBackup {
+	backup := *b
+	backup.Labels = map[string]string{}
+	for k, v := range b.Labels {
+		backup.Labels[k] = v
+	}
+	backup.Extents = append([]SnapshotExtent{}, b.Extents...)
+	return &backup
+}
+
+func (e *EngineSimulator) SnapshotBackup(engine *longhorn.Engine, snapshotName, backupName, backupTarget,
+	backingImageName, backingImageChecksum, compressionMethod string, concurrentLimit int, storageClassName string,
+	labels, credential, parameters map[string]string) (string, string, error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if err := e.checkFault("SnapshotBackup"); err != nil {
+		return "", "", err
+	}
+
+	if snapshotName == etypes.VolumeHeadName {
+		return "", "", fmt.Errorf("invalid operation: cannot backup %v", etypes.VolumeHeadName)
+	}
+	snapshot := e.snapshots[snapshotName]
+	if snapshot == nil {
+		return "", "", fmt.Errorf("could not find snapshot '%s' to backup, volume '%s'", snapshotName, e.volumeName)
+	}
+	rwReplicaAddrs := e.getRWReplicaAddrs()
+	if len(rwReplicaAddrs) == 0 {
+		return "", "", fmt.Errorf("no RW replica for volume %v to backup", e.volumeName)
+	}
+	if backupName == "" {
+		backupName = "backup-" + util.RandomID()
+	}
+	if task := e.backups[backupName]; task != nil && task.status.State == ProcessStateInProgress {
+		return "", "", fmt.Errorf("backup %v is in progress", backupName)
+	}
+
+	extents := []SnapshotExtent{}
+	for name := snapshotName; name != ""; name = e.snapshots[name].info.Parent {
+		extents = append(extents, e.snapshots[name].extents...)
+	}
+	extents = mergeSnapshotExtents(extents)
+
+	backupLabels := map[string]string{}
+	for k, v := range labels {
+		backupLabels[k] = v
+	}
+	replicaAddr := rwReplicaAddrs[0]
+	e.backups[backupName] = &simulatorBackupTask{
+		status: longhorn.EngineBackupStatus{
+			SnapshotName:   snapshotName,
+			State:          ProcessStateInProgress,
+			ReplicaAddress: replicaAddr,
+		},
+		backupTarget: backupTarget,
+		backup: &SimulatorBackup{
+			Name:         backupName,
+			URL:          backupstore.EncodeBackupURL(backupName, e.volumeName, backupTarget),
+			VolumeName:   e.volumeName,
+			SnapshotName: snapshotName,
+			BackupTarget: backupTarget,
+			Size:         getSnapshotExtentsSize(extents),
+			Labels:       backupLabels,
+			Extents:      extents,
+		},
+	}
+	return backupName, replicaAddr, nil
+}
+
+func (e *EngineSimulator) SnapshotBackupStatus(engine *longhorn.Engine, backupName, replicaAddress,
+	replicaName string) (*longhorn.EngineBackupStatus, error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if err := e.checkFault("SnapshotBackupStatus"); err != nil {
+		return nil, err
+	}
+
+	task := e.backups[backupName]
+	if task == nil {
+		return nil, fmt.Errorf("unable to find backup %v", backupName)
+	}
+	if replicaAddress != "" && replicaAddress != task.status.ReplicaAddress {
+		return nil, fmt.Errorf("backup %v is not created by replica %v", backupName, replicaAddress)
+	}
+
+	if task.status.State == ProcessStateInProgress {
+		task.status.Progress = e.advance(task.status.Progress)
+		if task.status.Progress == 100 {
+			task.status.State = ProcessStateComplete
+			task.status.BackupURL = task.backup.URL
+			task.backup.Created = util.Now()
+			e.collection.backupStore.put(task.backup.copy())
+		}
+	}
+	return task.status.DeepCopy(), nil
+}
+
+func (e *EngineSimulator) BackupRestore(engine *longhorn.Engine, backupTarget, backupName, backupVolume,
+	lastRestored string, credential map[string]string, concurrentLimit int) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if err := e.checkFault("BackupRestore"); err != nil {
+		return err
+	}
+
+	backupURL := backupstore.EncodeBackupURL(backupName, backupVolume, backupTarget)
+	backup := e.collection.backupStore.Get(backupURL)
+	if backup == nil {
+		return fmt.Errorf("unable to find backup %v", backupURL)
+	}
+	rwReplicaAddrs := e.getRWReplicaAddrs()
+	if len(rwReplicaAddrs) == 0 {
+		return fmt.Errorf("no RW replica for volume %v to restore", e.volumeName)
+	}
+	for _, addr := range rwReplicaAddrs {
+		if status := e.restoreStatus[addr]; status != nil && status.IsRestoring {
+			return fmt.Errorf("replica %v is restoring backup %v", addr, status.CurrentRestoringBackup)
+		}
+	}
+
+	for _, addr := range rwReplicaAddrs {
+		e.restoreStatus[addr] = &longhorn.RestoreStatus{
+			IsRestoring:            true,
+			LastRestored:           lastRestored,
+			CurrentRestoringBackup: backupName,
+			State:                  ProcessStateInProgress,
+			BackupURL:              backupURL,
+		}
+	}
+	e.pendingRestore = backup
+	return nil
+}
+
+func (e *EngineSimulator) BackupRestoreStatus(*longhorn.Engine) (map[string]*longhorn.RestoreStatus, error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if err := e.checkFault("BackupRestoreStatus"); err != nil {
+		return nil, err
+	}
+
+	restored := false
+	ret := map[string]*longhorn.RestoreStatus{}
+	for addr, status := range e.restoreStatus {
+		if status.IsRestoring {
+			status.Progress = e.advance(status.Progress)
+			if status.Progress == 100 {
+				status.IsRestoring = false
+				status.State = ProcessStateComplete
+				status.LastRestored = status.CurrentRestoringBackup
+				status.CurrentRestoringBackup = ""
+				restored = true
+			}
+		}
+		ret[addr] = status.DeepCopy()
+	}
+
+	if restored && e.pendingRestore != nil {
+		head := e.snapshots[etypes.VolumeHeadName]
+		head.extents = mergeSnapshotExtents(e.pendingRestore.Extents)
+		head.info.Size = strconv.FormatInt(getSnapshotExtentsSize(head.extents), 10)
+		e.pendingRestore = nil
+	}
+	return ret, nil
+}
@@ -1,8 +1,14 @@
 package engineapi
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
+	"github.com/pkg/errors"
+
+	etypes "github.com/longhorn/longhorn-engine/pkg/types"
+
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 
 	. "gopkg.in/check.v1"
@@ -74,3 +80,304 @@ func (s *TestSuite) TestBasic(c *C) {
 	err = coll.DeleteEngineSimulator(VolumeName)
 	c.Assert(err, IsNil)
 }
+
+func newTestEngineSimulator(c *C, coll *EngineSimulatorCollection, volumeName string, asyncRebuild bool) *EngineSimulator {
+	err := coll.CreateEngineSimulator(&EngineSimulatorRequest{
+		VolumeName:     volumeName,
+		VolumeSize:     VolumeSize,
+		ControllerAddr: "ip-controller-" + volumeName,
+		ReplicaAddrs: []string{
+			"ip-replica1-" + volumeName, "ip-replica2-" + volumeName,
+		},
+		AsyncRebuild: asyncRebuild,
+	})
+	c.Assert(err, IsNil)
+
+	sim, err := coll.GetEngineSimulator(volumeName)
+	c.Assert(err, IsNil)
+	return sim
+}
+
+func (s *TestSuite) TestSnapshotChainAndPurge(c *C) {
+	coll := NewEngineSimulatorCollection()
+	sim := newTestEngineSimulator(c, coll, VolumeName, false)
+	e := &longhorn.Engine{}
+
+	c.Assert(sim.SimulateWrite(0, 4096), IsNil)
+	_, err := sim.SnapshotCreate(e, "snap-1", nil, false)
+	c.Assert(err, IsNil)
+	c.Assert(sim.SimulateWrite(4096, 4096), IsNil)
+	_, err = sim.SnapshotCreate(e, "snap-2", nil, false)
+	c.Assert(err, IsNil)
+	c.Assert(sim.SimulateWrite(8192, 4096), IsNil)
+	_, err = sim.SnapshotCreate(e, "snap-3", nil, false)
+	c.Assert(err, IsNil)
+	_, err = sim.SnapshotCreate(e, "snap-3", nil, false)
+	c.Assert(err, NotNil)
+
+	snapshots, err := sim.SnapshotList(e)
+	c.Assert(err, IsNil)
+	c.Assert(snapshots, HasLen, 4)
+	c.Assert(snapshots["snap-2"].Parent, Equals, "snap-1")
+	c.Assert(snapshots["snap-3"].Parent, Equals, "snap-2")
+	c.Assert(snapshots[etypes.VolumeHeadName].Parent, Equals, "snap-3")
+	c.Assert(snapshots["snap-2"].Size, Equals, "4096")
+
+	extents, err := sim.SnapshotChangedBlocks(e, "snap-1", "snap-3")
+	c.Assert(err, IsNil)
+	c.Assert(extents, DeepEquals, []SnapshotExtent{{Offset: 4096, Length: 8192}})
+
+	c.Assert(sim.SnapshotDelete(e, etypes.VolumeHeadName), NotNil)
+	c.Assert(sim.SnapshotDelete(e, "snap-2"), IsNil)
+	c.Assert(sim.SnapshotDelete(e, "snap-3"), IsNil)
+	c.Assert(sim.SnapshotPurge(e), IsNil)
+	c.Assert(sim.SnapshotPurge(e), NotNil)
+
+	// snap-2 is coalesced into snap-3, while snap-3 is kept since its child is the volume head
+	snapshots, err = sim.SnapshotList(e)
+	c.Assert(err, IsNil)
+	c.Assert(snapshots, HasLen, 3)
+	c.Assert(snapshots["snap-3"].Parent, Equals, "snap-1")
+	c.Assert(snapshots["snap-3"].Size, Equals, "8192")
+	c.Assert(snapshots["snap-1"].Children, DeepEquals, map[string]bool{"snap-3": true})
+
+	status, err := sim.SnapshotPurgeStatus(e)
+	c.Assert(err, IsNil)
+	c.Assert(status, HasLen, 2)
+	c.Assert(status["ip-replica1-"+VolumeName].IsPurging, Equals, true)
+	c.Assert(status["ip-replica1-"+VolumeName].Progress, Equals, DefaultSimulatorProgressStep)
+	status, err = sim.SnapshotPurgeStatus(e)
+	c.Assert(err, IsNil)
+	c.Assert(status["ip-replica1-"+VolumeName].IsPurging, Equals, false)
+	c.Assert(status["ip-replica1-"+VolumeName].State, Equals, ProcessStateComplete)
+
+	c.Assert(sim.VolumeFrontendStart(e), IsNil)
+	c.Assert(sim.SnapshotRevert(e, "snap-1"), NotNil)
+	c.Assert(sim.VolumeFrontendShutdown(e), IsNil)
+	c.Assert(sim.SnapshotRevert(e, "snap-1"), IsNil)
+	snapshots, err = sim.SnapshotList(e)
+	c.Assert(err, IsNil)
+	c.Assert(snapshots[etypes.VolumeHeadName].Parent, Equals, "snap-1")
+	c.Assert(snapshots["snap-1"].Children, DeepEquals, map[string]bool{"snap-3": true, etypes.VolumeHeadName: true})
+}
+
+func (s *TestSuite) TestSnapshotHash(c *C) {
+	coll := NewEngineSimulatorCollection()
+	sim := newTestEngineSimulator(c, coll, VolumeName, false)
+	e := &longhorn.Engine{}
+
+	c.Assert(sim.SimulateWrite(0, 4096), IsNil)
+	_, err := sim.SnapshotCreate(e, "snap-1", nil, false)
+	c.Assert(err, IsNil)
+	c.Assert(sim.SimulateSnapshotCorruption("snap-1", "ip-replica2-"+VolumeName), IsNil)
+
+	c.Assert(sim.SnapshotHash(e, etypes.VolumeHeadName, false), NotNil)
+	c.Assert(sim.SnapshotHash(e, "snap-1", false), IsNil)
+
+	status, err := sim.SnapshotHashStatus(e, "snap-1")
+	c.Assert(err, IsNil)
+	c.Assert(status, HasLen, 2)
+	c.Assert(status["ip-replica1-"+VolumeName].State, Equals, ProcessStateInProgress)
+
+	status, err = sim.SnapshotHashStatus(e, "snap-1")
+	c.Assert(err, IsNil)
+	c.Assert(status["ip-replica1-"+VolumeName].State, Equals, ProcessStateComplete)
+	c.Assert(status["ip-replica2-"+VolumeName].State, Equals, ProcessStateComplete)
+	c.Assert(status["ip-replica1-"+VolumeName].Checksum, Not(Equals), "")
+	c.Assert(status["ip-replica1-"+VolumeName].Checksum, Not(Equals), status["ip-replica2-"+VolumeName].Checksum)
+
+	// The completed checksum is reused unless rehashing
+	c.Assert(sim.SnapshotHash(e, "snap-1", false), IsNil)
+	status, err = sim.SnapshotHashStatus(e, "snap-1")
+	c.Assert(err, IsNil)
+	c.Assert(status["ip-replica1-"+VolumeName].State, Equals, ProcessStateComplete)
+	c.Assert(sim.SnapshotHash(e, "snap-1", true), IsNil)
+	status, err = sim.SnapshotHashStatus(e, "snap-1")
+	c.Assert(err, IsNil)
+	c.Assert(status["ip-replica1-"+VolumeName].State, Equals, ProcessStateInProgress)
+}
+
+func (s *TestSuite) TestReplicaRebuild(c *C) {
+	coll := NewEngineSimulatorCollection()
+	sim := newTestEngineSimulator(c, coll, VolumeName, true)
+	e := &longhorn.Engine{}
+
+	replicas, err := sim.ReplicaList(e)
+	c.Assert(err, IsNil)
+	c.Assert(replicas["ip-replica1-"+VolumeName].Mode, Equals, longhorn.ReplicaModeRW)
+	c.Assert(replicas["ip-replica2-"+VolumeName].Mode, Equals, longhorn.ReplicaModeRW)
+
+	c.Assert(sim.ReplicaAdd(e, "", Replica3Addr, false, false, nil, 30, 0), IsNil)
+	replicas, err = sim.ReplicaList(e)
+	c.Assert(err, IsNil)
+	c.Assert(replicas[Replica3Addr].Mode, Equals, longhorn.ReplicaModeWO)
+	c.Assert(sim.ReplicaRebuildVerify(e, "", Replica3Addr), NotNil)
+
+	status, err := sim.ReplicaRebuildStatus(e)
+	c.Assert(err, IsNil)
+	c.Assert(status[Replica3Addr].IsRebuilding, Equals, true)
+	c.Assert(status[Replica3Addr].FromReplicaAddress, Equals, "ip-replica1-"+VolumeName)
+	status, err = sim.ReplicaRebuildStatus(e)
+	c.Assert(err, IsNil)
+	c.Assert(status[Replica3Addr].IsRebuilding, Equals, false)
+	c.Assert(status[Replica3Addr].State, Equals, ProcessStateComplete)
+	c.Assert(sim.ReplicaRebuildVerify(e, "", Replica3Addr), IsNil)
+
+	replicas, err = sim.ReplicaList(e)
+	c.Assert(err, IsNil)
+	c.Assert(replicas[Replica3Addr].Mode, Equals, longhorn.ReplicaModeRW)
+
+	// Stopping the source replica fails the rebuilding
+	c.Assert(sim.ReplicaRemove(e, Replica3Addr, ""), IsNil)
+	c.Assert(sim.ReplicaAdd(e, "", Replica3Addr, false, false, nil, 30, 0), IsNil)
+	c.Assert(sim.SimulateStopReplica("ip-replica1-"+VolumeName), IsNil)
+	status, err = sim.ReplicaRebuildStatus(e)
+	c.Assert(err, IsNil)
+	c.Assert(status[Replica3Addr].State, Equals, ProcessStateError)
+	c.Assert(status[Replica3Addr].Error, Not(Equals), "")
+}
+
+func (s *TestSuite) TestBackupAndRestore(c *C) {
+	coll := NewEngineSimulatorCollection()
+	sim := newTestEngineSimulator(c, coll, VolumeName, false)
+	restoreSim := newTestEngineSimulator(c, coll, "restore-vol", false)
+	e := &longhorn.Engine{}
+	backupTarget := "s3://backupbucket@us-east-1/"
+
+	c.Assert(sim.SimulateWrite(0, 4096), IsNil)
+	_, err := sim.SnapshotCreate(e, "snap-1", nil, false)
+	c.Assert(err, IsNil)
+	c.Assert(sim.SimulateWrite(8192, 4096), IsNil)
+	_, err = sim.SnapshotCreate(e, "snap-2", nil, false)
+	c.Assert(err, IsNil)
+
+	_, _, err = sim.SnapshotBackup(e, "nonexistent", "backup-1", backupTarget, "", "", "", 1, "", nil, nil, nil)
+	c.Assert(err, NotNil)
+	backupName, replicaAddr, err := sim.SnapshotBackup(e, "snap-2", "backup-1", backupTarget, "", "", "", 1, "",
+		map[string]string{"foo": "bar"}, nil, nil)
+	c.Assert(err, IsNil)
+	c.Assert(backupName, Equals, "backup-1")
+	c.Assert(replicaAddr, Equals, "ip-replica1-"+VolumeName)
+
+	status, err := sim.SnapshotBackupStatus(e, backupName, replicaAddr, "")
+	c.Assert(err, IsNil)
+	c.Assert(status.State, Equals, ProcessStateInProgress)
+	c.Assert(coll.BackupStore().List(backupTarget, VolumeName), HasLen, 0)
+
+	status, err = sim.SnapshotBackupStatus(e, backupName, replicaAddr, "")
+	c.Assert(err, IsNil)
+	c.Assert(status.State, Equals, ProcessStateComplete)
+	c.Assert(status.BackupURL, Not(Equals), "")
+	backups := coll.BackupStore().List(backupTarget, VolumeName)
+	c.Assert(backups, HasLen, 1)
+	c.Assert(backups[0].URL, Equals, status.BackupURL)
+	c.Assert(backups[0].Size, Equals, int64(8192))
+	c.Assert(backups[0].Labels, DeepEquals, map[string]string{"foo": "bar"})
+
+	c.Assert(restoreSim.BackupRestore(e, backupTarget, "nonexistent", VolumeName, "", nil, 1), NotNil)
+	c.Assert(restoreSim.BackupRestore(e, backupTarget, backupName, VolumeName, "", nil, 1), IsNil)
+	restoreStatus, err := restoreSim.BackupRestoreStatus(e)
+	c.Assert(err, IsNil)
+	c.Assert(restoreStatus, HasLen, 2)
+	c.Assert(restoreStatus["ip-replica1-restore-vol"].IsRestoring, Equals, true)
+	c.Assert(restoreStatus["ip-replica1-restore-vol"].CurrentRestoringBackup, Equals, backupName)
+	restoreStatus, err = restoreSim.BackupRestoreStatus(e)
+	c.Assert(err, IsNil)
+	c.Assert(restoreStatus["ip-replica1-restore-vol"].IsRestoring, Equals, false)
+	c.Assert(restoreStatus["ip-replica1-restore-vol"].LastRestored, Equals, backupName)
+
+	snapshots, err := restoreSim.SnapshotList(e)
+	c.Assert(err, IsNil)
+	c.Assert(snapshots[etypes.VolumeHeadName].Size, Equals, "8192")
+
+	c.Assert(coll.BackupStore().Delete(backups[0].URL), IsNil)
+	c.Assert(coll.BackupStore().Get(backups[0].URL), IsNil)
+}
+
+func (s *TestSuite) TestSnapshotClone(c *C) {
+	coll := NewEngineSimulatorCollection()
+	sim := newTestEngineSimulator(c, coll, VolumeName, false)
+	cloneSim := newTestEngineSimulator(c, coll, "clone-vol", false)
+	e := &longhorn.Engine{}
+
+	c.Assert(sim.SimulateWrite(0, 4096), IsNil)
+	_, err := sim.SnapshotCreate(e, "snap-1", nil, false)
+	c.Assert(err, IsNil)
+	c.Assert(sim.SimulateWrite(4096, 4096), IsNil)
+	_, err = sim.SnapshotCreate(e, "snap-2", nil, false)
+	c.Assert(err, IsNil)
+
+	c.Assert(cloneSim.SnapshotClone(e, "nonexistent", ControllerAddr, VolumeName, "", 30, 0), NotNil)
+	c.Assert(cloneSim.SnapshotClone(e, "snap-2", ControllerAddr, VolumeName, "", 30, 0), IsNil)
+
+	status, err := cloneSim.SnapshotCloneStatus(e)
+	c.Assert(err, IsNil)
+	c.Assert(status["ip-replica1-clone-vol"].IsCloning, Equals, true)
+	c.Assert(status["ip-replica1-clone-vol"].FromReplicaAddress, Equals, "ip-replica1-"+VolumeName)
+	status, err = cloneSim.SnapshotCloneStatus(e)
+	c.Assert(err, IsNil)
+	c.Assert(status["ip-replica1-clone-vol"].State, Equals, ProcessStateComplete)
+
+	snapshot, err := cloneSim.SnapshotGet(e, "snap-2")
+	c.Assert(err, IsNil)
+	c.Assert(snapshot.Size, Equals, "8192")
+	c.Assert(snapshot.UserCreated, Equals, false)
+}
+
+func (s *TestSuite) TestFaults(c *C) {
+	coll := NewEngineSimulatorCollection()
+	sim := newTestEngineSimulator(c, coll, VolumeName, false)
+	e := &longhorn.Engine{}
+
+	sim.SimulateFault("SnapshotCreate", ErrSimulatedTimeout, 1)
+	_, err := sim.SnapshotCreate(e, "snap-1", nil, false)
+	c.Assert(err, NotNil)
+	c.Assert(errors.Is(err, context.DeadlineExceeded), Equals, true)
+	_, err = sim.SnapshotCreate(e, "snap-1", nil, false)
+	c.Assert(err, IsNil)
+
+	sim.SimulateFault("SnapshotList", fmt.Errorf("replica error"), -1)
+	for i := 0; i < 3; i++ {
+		_, err = sim.SnapshotList(e)
+		c.Assert(err, ErrorMatches, ".*replica error")
+	}
+	sim.ClearFaults()
+	_, err = sim.SnapshotList(e)
+	c.Assert(err, IsNil)
+
+	e.Spec.SnapshotMaxCount = 1
+	c.Assert(sim.VolumeSnapshotMaxCountSet(e), IsNil)
+	_, err = sim.SnapshotCreate(e, "snap-2", nil, false)
+	c.Assert(err, NotNil)
+
+	c.Assert(sim.SimulateStopReplica("ip-replica1-"+VolumeName), IsNil)
+	c.Assert(sim.ReplicaAdd(e, "", Replica3Addr, false, false, nil, 30, 0), NotNil)
+
+	c.Assert(coll.DeleteEngineSimulator(VolumeName), IsNil)
+	_, err = sim.SnapshotList(e)
+	c.Assert(err, NotNil)
+}
+
+func (s *TestSuite) TestReplicaMetrics(c *C) {
+	coll := NewEngineSimulatorCollection()
+	sim := newTestEngineSimulator(c, coll, VolumeName, false)
+	e := &longhorn.Engine{}
+
+	c.Assert(sim.SimulateReplicaMetrics("nonexistent", ReplicaMetrics{}), NotNil)
+	c.Assert(sim.SimulateReplicaMetrics("ip-replica1-"+VolumeName, ReplicaMetrics{
+		ReadLatency:     1000,
+		WriteLatency:    2000,
+		WriteErrorCount: 3,
+	}), IsNil)
+
+	metrics, err := sim.ReplicaMetricsGet(e)
+	c.Assert(err, IsNil)
+	c.Assert(metrics, HasLen, 2)
+	c.Assert(*metrics["ip-replica1-"+VolumeName], Equals, ReplicaMetrics{ReadLatency: 1000, WriteLatency: 2000, WriteErrorCount: 3})
+	c.Assert(*metrics["ip-replica2-"+VolumeName], Equals, ReplicaMetrics{})
+
+	c.Assert(sim.ReplicaRemove(e, "ip-replica1-"+VolumeName, ""), IsNil)
+	metrics, err = sim.ReplicaMetricsGet(e)
+	c.Assert(err, IsNil)
+	c.Assert(metrics, HasLen, 1)
+}
@@ -0,0 +1,26 @@
+package engineapi
+
+import (
+	"time"
+)
+
+// GRPCRequestLatencyMetric observes the latency of the gRPC requests to the instance managers.
+type GRPCRequestLatencyMetric interface {
+	Observe(instanceManagerName, method string, latency time.Duration)
+}
+
+type noopGRPCRequestLatencyMetric struct{}
+
+func (noopGRPCRequestLatencyMetric) Observe(string, string, time.Duration) {}
+
+var grpcRequestLatency GRPCRequestLatencyMetric = noopGRPCRequestLatencyMetric{}
+
+// RegisterGRPCMetrics sets the metric observing the gRPC requests to the instance managers. It should be called
+// during initialization before any request is sent.
+func RegisterGRPCMetrics(latency GRPCRequestLatencyMetric) {
+	grpcRequestLatency = latency
+}
+
+func observeGRPCRequest(instanceManagerName, method string, start time.Time) {
+	grpcRequestLatency.Observe(instanceManagerName, method, time.Since(start))
+}
@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"path/filepath"
 	"strconv"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/multierr"
 
 	lhlonghorn "github.com/longhorn/go-common-libs/longhorn"
@@ -17,6 +19,7 @@ import (
 	imutil "github.com/longhorn/longhorn-instance-manager/pkg/util"
 
 	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util/tracing"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
@@ -44,14 +47,28 @@ const (
 )
 
 type InstanceManagerClient struct {
-	ip            string
-	apiMinVersion int
-	apiVersion    int
+	instanceManagerName string
+	ip                  string
+	apiMinVersion       int
+	apiVersion          int
 
 	// TODO: After eliminating all old instance manager pods, this process manager client can be removed.
 	// The gRPC client supports backward compatibility.
 	instanceServiceGrpcClient *imclient.InstanceServiceClient
 	processManagerGrpcClient  *imclient.ProcessManagerClient
+
+	// traceCtx carries the trace the gRPC requests are recorded in
+	traceCtx context.Context
+}
+
+// SetTraceContext records the following gRPC requests as spans of the trace carried by ctx.
+func (c *InstanceManagerClient) SetTraceContext(ctx context.Context) {
+	c.traceCtx = ctx
+}
+
+func (c *InstanceManagerClient) observeRequest(method string, start time.Time) {
+	observeGRPCRequest(c.instanceManagerName, method, start)
+	tracing.RecordSpan(c.traceCtx, "InstanceManagerClient."+method, start, attribute.String("instance_manager", c.instanceManagerName))
 }
 
 func (c *InstanceManagerClient) GetAPIVersion() int {
@@ -218,6 +235,7 @@ func NewInstanceManagerClient(im *longhorn.InstanceManager, allowUnknown bool) (
 		}
 
 		return &InstanceManagerClient{
+			instanceManagerName:      im.Name,
 			ip:                       im.Status.IP,
 			apiMinVersion:            im.Status.APIMinVersion,
 			apiVersion:               im.Status.APIVersion,
@@ -264,6 +282,7 @@ func NewInstanceManagerClient(im *longhorn.InstanceManager, allowUnknown bool) (
 	// This way we don't need the per call compatibility check, ref: `CheckInstanceManagerCompatibility`
 
 	return &InstanceManagerClient{
+		instanceManagerName:       im.Name,
 		ip:                        im.Status.IP,
 		apiMinVersion:             im.Status.APIMinVersion,
 		apiVersion:                im.Status.APIVersion,
@@ -463,6 +482,7 @@ type EngineInstanceCreateRequest struct {
 
 // EngineInstanceCreate creates a new engine instance
 func (c *InstanceManagerClient) EngineInstanceCreate(req *EngineInstanceCreateRequest) (*longhorn.InstanceProcess, error) {
+	defer c.observeRequest("EngineInstanceCreate", time.Now())
 	if err := CheckInstanceManagerCompatibility(c.apiMinVersion, c.apiVersion); err != nil {
 		return nil, err
 	}
@@ -537,6 +557,7 @@ type ReplicaInstanceCreateRequest struct {
 
 // ReplicaInstanceCreate creates a new replica instance
 func (c *InstanceManagerClient) ReplicaInstanceCreate(req *ReplicaInstanceCreateRequest) (*longhorn.InstanceProcess, error) {
+	defer c.observeRequest("ReplicaInstanceCreate", time.Now())
 	if err := CheckInstanceManagerCompatibility(c.apiMinVersion, c.apiVersion); err != nil {
 		return nil, err
 	}
@@ -588,6 +609,7 @@ func (c *InstanceManagerClient) ReplicaInstanceCreate(req *ReplicaInstanceCreate
 
 // InstanceDelete deletes the instance
 func (c *InstanceManagerClient) InstanceDelete(dataEngine longhorn.DataEngineType, name, kind, diskUUID string, cleanupRequired bool) (err error) {
+	defer c.observeRequest("InstanceDelete", time.Now())
 	if c.GetAPIVersion() < 4 {
 		/* Fall back to the old way of deleting process */
 		_, err = c.processManagerGrpcClient.ProcessDelete(name)
@@ -600,6 +622,7 @@ func (c *InstanceManagerClient) InstanceDelete(dataEngine longhorn.DataEngineTyp
 
 // InstanceGet returns the instance process
 func (c *InstanceManagerClient) InstanceGet(dataEngine longhorn.DataEngineType, name, kind string) (*longhorn.InstanceProcess, error) {
+	defer c.observeRequest("InstanceGet", time.Now())
 	if err := CheckInstanceManagerCompatibility(c.apiMinVersion, c.apiVersion); err != nil {
 		return nil, err
 	}
@@ -622,6 +645,7 @@ func (c *InstanceManagerClient) InstanceGet(dataEngine longhorn.DataEngineType,
 
 // InstanceGetBinary returns the binary name of the instance
 func (c *InstanceManagerClient) InstanceGetBinary(dataEngine longhorn.DataEngineType, name, kind, diskUUID string) (string, error) {
+	defer c.observeRequest("InstanceGetBinary", time.Now())
 	if err := CheckInstanceManagerCompatibility(c.apiMinVersion, c.apiVersion); err != nil {
 		return "", err
 	}
@@ -648,6 +672,7 @@ func (c *InstanceManagerClient) InstanceGetBinary(dataEngine longhorn.DataEngine
 
 // InstanceLog returns a grpc stream that will be closed when the passed context is cancelled or the underlying grpc client is closed
 func (c *InstanceManagerClient) InstanceLog(ctx context.Context, dataEngine longhorn.DataEngineType, name, kind string) (*imapi.LogStream, error) {
+	defer c.observeRequest("InstanceLog", time.Now())
 	if err := CheckInstanceManagerCompatibility(c.apiMinVersion, c.apiVersion); err != nil {
 		return nil, err
 	}
@@ -662,6 +687,7 @@ func (c *InstanceManagerClient) InstanceLog(ctx context.Context, dataEngine long
 
 // InstanceWatch returns a grpc stream that will be closed when the passed context is cancelled or the underlying grpc client is closed
 func (c *InstanceManagerClient) InstanceWatch(ctx context.Context) (interface{}, error) {
+	defer c.observeRequest("InstanceWatch", time.Now())
 	if err := CheckInstanceManagerCompatibility(c.apiMinVersion, c.apiVersion); err != nil {
 		return nil, err
 	}
@@ -676,6 +702,7 @@ func (c *InstanceManagerClient) InstanceWatch(ctx context.Context) (interface{},
 
 // InstanceList returns a map of instance name to instance process
 func (c *InstanceManagerClient) InstanceList() (map[string]longhorn.InstanceProcess, error) {
+	defer c.observeRequest("InstanceList", time.Now())
 	if err := CheckInstanceManagerCompatibility(c.apiMinVersion, c.apiVersion); err != nil {
 		return nil, err
 	}
@@ -717,6 +744,7 @@ type EngineInstanceUpgradeRequest struct {
 
 // EngineInstanceUpgrade upgrades the engine process
 func (c *InstanceManagerClient) EngineInstanceUpgrade(req *EngineInstanceUpgradeRequest) (*longhorn.InstanceProcess, error) {
+	defer c.observeRequest("EngineInstanceUpgrade", time.Now())
 	engine := req.Engine
 	switch engine.Spec.DataEngine {
 	case longhorn.DataEngineTypeV1:
@@ -793,6 +821,7 @@ func (c *InstanceManagerClient) engineInstanceUpgrade(req *EngineInstanceUpgrade
 
 // VersionGet returns the version of the instance manager
 func (c *InstanceManagerClient) VersionGet() (int, int, int, int, error) {
+	defer c.observeRequest("VersionGet", time.Now())
 	var err error
 	var output *immeta.VersionOutput
 
@@ -810,6 +839,7 @@ func (c *InstanceManagerClient) VersionGet() (int, int, int, int, error) {
 }
 
 func (c *InstanceManagerClient) LogSetLevel(dataEngine longhorn.DataEngineType, component, level string) error {
+	defer c.observeRequest("LogSetLevel", time.Now())
 	if err := CheckInstanceManagerCompatibility(c.apiMinVersion, c.apiVersion); err != nil {
 		return err
 	}
@@ -822,6 +852,7 @@ func (c *InstanceManagerClient) LogSetLevel(dataEngine longhorn.DataEngineType,
 }
 
 func (c *InstanceManagerClient) LogSetFlags(dataEngine longhorn.DataEngineType, component, flags string) error {
+	defer c.observeRequest("LogSetFlags", time.Now())
 	if err := CheckInstanceManagerCompatibility(c.apiMinVersion, c.apiVersion); err != nil {
 		return err
 	}
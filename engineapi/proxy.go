@@ -2,9 +2,11 @@ package engineapi
 
 import (
 	"path/filepath"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 	"golang.org/x/net/context"
 
 	imclient "github.com/longhorn/longhorn-instance-manager/pkg/client"
@@ -13,6 +15,7 @@ import (
 	"github.com/longhorn/longhorn-manager/datastore"
 	"github.com/longhorn/longhorn-manager/types"
 	"github.com/longhorn/longhorn-manager/util"
+	"github.com/longhorn/longhorn-manager/util/tracing"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
@@ -144,19 +147,24 @@ func NewEngineClientProxy(im *longhorn.InstanceManager, logger logrus.FieldLogge
 	proxyConnCounter.IncreaseCount()
 
 	return &Proxy{
-		logger:           logger,
-		grpcClient:       proxyClient,
-		proxyConnCounter: proxyConnCounter,
-		ds:               ds,
+		logger:              logger,
+		grpcClient:          proxyClient,
+		proxyConnCounter:    proxyConnCounter,
+		ds:                  ds,
+		instanceManagerName: im.Name,
 	}, nil
 }
 
 type Proxy struct {
-	logger     logrus.FieldLogger
-	grpcClient *imclient.ProxyClient
-	ds         *datastore.DataStore
+	logger              logrus.FieldLogger
+	grpcClient          *imclient.ProxyClient
+	ds                  *datastore.DataStore
+	instanceManagerName string
 
 	proxyConnCounter util.Counter
+
+	// traceCtx carries the trace the gRPC requests are recorded in
+	traceCtx context.Context
 }
 
 type EngineClientProxy interface {
@@ -181,6 +189,16 @@ func (p *Proxy) Close() {
 	p.proxyConnCounter.DecreaseCount()
 }
 
+// SetTraceContext records the following gRPC requests as spans of the trace carried by ctx.
+func (p *Proxy) SetTraceContext(ctx context.Context) {
+	p.traceCtx = ctx
+}
+
+func (p *Proxy) observeRequest(method string, start time.Time) {
+	observeGRPCRequest(p.instanceManagerName, method, start)
+	tracing.RecordSpan(p.traceCtx, "Proxy."+method, start, attribute.String("instance_manager", p.instanceManagerName))
+}
+
 func (p *Proxy) DirectToURL(e *longhorn.Engine) string {
 	if e == nil {
 		p.logger.Debug("BUG: cannot get engine client proxy re-direct URL with nil engine object")
@@ -200,6 +218,7 @@ func (p *Proxy) VersionGet(e *longhorn.Engine, clientOnly bool) (version *Engine
 		}, nil
 	}
 
+	defer p.observeRequest("VersionGet", time.Now())
 	recvServerVersion, err := p.grpcClient.ServerVersionGet(p.DirectToURL(e))
 	if err != nil {
 		return nil, err
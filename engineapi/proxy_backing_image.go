@@ -2,6 +2,7 @@ package engineapi
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -11,18 +12,22 @@ import (
 )
 
 func (p *Proxy) SPDKBackingImageCreate(name, backingImageUUID, diskUUID, checksum, fromAddress, srcDiskUUID string, size uint64) (*imapi.BackingImage, error) {
+	defer p.observeRequest("SPDKBackingImageCreate", time.Now())
 	return p.grpcClient.SPDKBackingImageCreate(name, backingImageUUID, diskUUID, checksum, fromAddress, srcDiskUUID, size)
 }
 
 func (p *Proxy) SPDKBackingImageDelete(name, diskUUID string) error {
+	defer p.observeRequest("SPDKBackingImageDelete", time.Now())
 	return p.grpcClient.SPDKBackingImageDelete(name, diskUUID)
 }
 
 func (p *Proxy) SPDKBackingImageGet(name, diskUUID string) (*imapi.BackingImage, error) {
+	defer p.observeRequest("SPDKBackingImageGet", time.Now())
 	return p.grpcClient.SPDKBackingImageGet(name, diskUUID)
 }
 
 func (p *Proxy) SPDKBackingImageList() (map[string]longhorn.BackingImageV2CopyInfo, error) {
+	defer p.observeRequest("SPDKBackingImageList", time.Now())
 	result := map[string]longhorn.BackingImageV2CopyInfo{}
 
 	v2BackingImages, err := p.grpcClient.SPDKBackingImageList()
@@ -38,6 +43,7 @@ func (p *Proxy) SPDKBackingImageList() (map[string]longhorn.BackingImageV2CopyIn
 }
 
 func (p *Proxy) SPDKBackingImageWatch(ctx context.Context) (*imapi.BackingImageStream, error) {
+	defer p.observeRequest("SPDKBackingImageWatch", time.Now())
 	return p.grpcClient.SPDKBackingImageWatch(ctx)
 }
 
@@ -2,6 +2,7 @@ package engineapi
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -15,6 +16,7 @@ import (
 func (p *Proxy) SnapshotBackup(e *longhorn.Engine, snapshotName, backupName, backupTarget,
 	backingImageName, backingImageChecksum, compressionMethod string, concurrentLimit int, storageClassName string,
 	labels, credential, parameters map[string]string) (string, string, error) {
+	defer p.observeRequest("SnapshotBackup", time.Now())
 	if snapshotName == etypes.VolumeHeadName {
 		return "", "", fmt.Errorf("invalid operation: cannot backup %v", etypes.VolumeHeadName)
 	}
@@ -51,6 +53,7 @@ func (p *Proxy) SnapshotBackup(e *longhorn.Engine, snapshotName, backupName, bac
 
 func (p *Proxy) SnapshotBackupStatus(e *longhorn.Engine, backupName, replicaAddress,
 	replicaName string) (status *longhorn.EngineBackupStatus, err error) {
+	defer p.observeRequest("SnapshotBackupStatus", time.Now())
 	recv, err := p.grpcClient.SnapshotBackupStatus(string(e.Spec.DataEngine), e.Name, e.Spec.VolumeName,
 		p.DirectToURL(e), backupName, replicaAddress, replicaName)
 	if err != nil {
@@ -62,6 +65,7 @@ func (p *Proxy) SnapshotBackupStatus(e *longhorn.Engine, backupName, replicaAddr
 
 func (p *Proxy) BackupRestore(e *longhorn.Engine, backupTarget, backupName, backupVolumeName, lastRestored string,
 	credential map[string]string, concurrentLimit int) error {
+	defer p.observeRequest("BackupRestore", time.Now())
 	backupURL := backupstore.EncodeBackupURL(backupName, backupVolumeName, backupTarget)
 
 	// get environment variables if backup for s3
@@ -75,6 +79,7 @@ func (p *Proxy) BackupRestore(e *longhorn.Engine, backupTarget, backupName, back
 }
 
 func (p *Proxy) BackupRestoreStatus(e *longhorn.Engine) (status map[string]*longhorn.RestoreStatus, err error) {
+	defer p.observeRequest("BackupRestoreStatus", time.Now())
 	recv, err := p.grpcClient.BackupRestoreStatus(string(e.Spec.DataEngine), e.Name, e.Spec.VolumeName,
 		p.DirectToURL(e))
 	if err != nil {
@@ -89,5 +94,6 @@ func (p *Proxy) BackupRestoreStatus(e *longhorn.Engine) (status map[string]*long
 }
 
 func (p *Proxy) CleanupBackupMountPoints() (err error) {
+	defer p.observeRequest("CleanupBackupMountPoints", time.Now())
 	return p.grpcClient.CleanupBackupMountPoints()
 }
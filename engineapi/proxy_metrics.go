@@ -1,13 +1,25 @@
 package engineapi
 
 import (
+	"time"
+
+	"github.com/pkg/errors"
+
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
 
 func (p *Proxy) MetricsGet(e *longhorn.Engine) (*Metrics, error) {
+	defer p.observeRequest("MetricsGet", time.Now())
 	metrics, err := p.grpcClient.MetricsGet(string(e.Spec.DataEngine), e.Name, e.Spec.VolumeName, p.DirectToURL(e))
 	if err != nil {
 		return nil, err
 	}
 	return (*Metrics)(metrics), err
 }
+
+// ReplicaMetricsGet returns the IO statistics of each replica keyed by the replica URL.
+// The instance manager proxy does not serve the per-replica statistics of the engine yet.
+func (p *Proxy) ReplicaMetricsGet(e *longhorn.Engine) (map[string]*ReplicaMetrics, error) {
+	return nil, errors.Wrapf(errors.New(ErrNotImplement), "failed to get replica metrics of volume %v: instance manager proxy does not support it",
+		e.Spec.VolumeName)
+}
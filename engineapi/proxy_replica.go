@@ -1,22 +1,27 @@
 package engineapi
 
 import (
+	"time"
+
 	etypes "github.com/longhorn/longhorn-engine/pkg/types"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
 
 func (p *Proxy) ReplicaAdd(e *longhorn.Engine, replicaName, replicaAddress string, restore, fastSync bool, localSync *etypes.FileLocalSync, replicaFileSyncHTTPClientTimeout, grpcTimeoutSeconds int64) (err error) {
+	defer p.observeRequest("ReplicaAdd", time.Now())
 	return p.grpcClient.ReplicaAdd(string(e.Spec.DataEngine), e.Name, e.Spec.VolumeName, p.DirectToURL(e),
 		replicaName, replicaAddress, restore, e.Spec.VolumeSize, e.Status.CurrentSize,
 		int(replicaFileSyncHTTPClientTimeout), fastSync, localSync, grpcTimeoutSeconds)
 }
 
 func (p *Proxy) ReplicaRemove(e *longhorn.Engine, address, replicaName string) (err error) {
+	defer p.observeRequest("ReplicaRemove", time.Now())
 	return p.grpcClient.ReplicaRemove(string(e.Spec.DataEngine), p.DirectToURL(e), e.Name, address, replicaName)
 }
 
 func (p *Proxy) ReplicaList(e *longhorn.Engine) (replicas map[string]*Replica, err error) {
+	defer p.observeRequest("ReplicaList", time.Now())
 	resp, err := p.grpcClient.ReplicaList(string(e.Spec.DataEngine), e.Name, e.Spec.VolumeName,
 		p.DirectToURL(e))
 	if err != nil {
@@ -38,6 +43,7 @@ func (p *Proxy) ReplicaList(e *longhorn.Engine) (replicas map[string]*Replica, e
 }
 
 func (p *Proxy) ReplicaRebuildStatus(e *longhorn.Engine) (status map[string]*longhorn.RebuildStatus, err error) {
+	defer p.observeRequest("ReplicaRebuildStatus", time.Now())
 	recv, err := p.grpcClient.ReplicaRebuildingStatus(string(e.Spec.DataEngine), e.Name, e.Spec.VolumeName,
 		p.DirectToURL(e))
 	if err != nil {
@@ -52,6 +58,7 @@ func (p *Proxy) ReplicaRebuildStatus(e *longhorn.Engine) (status map[string]*lon
 }
 
 func (p *Proxy) ReplicaRebuildVerify(e *longhorn.Engine, replicaName, url string) (err error) {
+	defer p.observeRequest("ReplicaRebuildVerify", time.Now())
 	if err := ValidateReplicaURL(url); err != nil {
 		return err
 	}
@@ -60,6 +67,7 @@ func (p *Proxy) ReplicaRebuildVerify(e *longhorn.Engine, replicaName, url string
 }
 
 func (p *Proxy) ReplicaModeUpdate(e *longhorn.Engine, url, mode string) (err error) {
+	defer p.observeRequest("ReplicaModeUpdate", time.Now())
 	if err := ValidateReplicaURL(url); err != nil {
 		return err
 	}
@@ -1,6 +1,8 @@
 package engineapi
 
 import (
+	"time"
+
 	"github.com/pkg/errors"
 
 	"github.com/longhorn/longhorn-manager/util"
@@ -10,11 +12,13 @@ import (
 
 func (p *Proxy) SnapshotCreate(e *longhorn.Engine, name string, labels map[string]string,
 	freezeFilesystem bool) (string, error) {
+	defer p.observeRequest("SnapshotCreate", time.Now())
 	return p.grpcClient.VolumeSnapshot(string(e.Spec.DataEngine), e.Name, e.Spec.VolumeName, p.DirectToURL(e),
 		name, labels, freezeFilesystem)
 }
 
 func (p *Proxy) SnapshotList(e *longhorn.Engine) (snapshots map[string]*longhorn.SnapshotInfo, err error) {
+	defer p.observeRequest("SnapshotList", time.Now())
 	recv, err := p.grpcClient.SnapshotList(string(e.Spec.DataEngine), e.Name, e.Spec.VolumeName,
 		p.DirectToURL(e))
 	if err != nil {
@@ -39,11 +43,13 @@ func (p *Proxy) SnapshotGet(e *longhorn.Engine, name string) (snapshot *longhorn
 
 func (p *Proxy) SnapshotClone(e *longhorn.Engine, snapshotName, fromEngineAddress, fromVolumeName, fromEngineName string,
 	fileSyncHTTPClientTimeout, grpcTimeoutSeconds int64) (err error) {
+	defer p.observeRequest("SnapshotClone", time.Now())
 	return p.grpcClient.SnapshotClone(string(e.Spec.DataEngine), e.Name, e.Spec.VolumeName, p.DirectToURL(e),
 		snapshotName, fromEngineAddress, fromVolumeName, fromEngineName, int(fileSyncHTTPClientTimeout), grpcTimeoutSeconds)
 }
 
 func (p *Proxy) SnapshotCloneStatus(e *longhorn.Engine) (status map[string]*longhorn.SnapshotCloneStatus, err error) {
+	defer p.observeRequest("SnapshotCloneStatus", time.Now())
 	recv, err := p.grpcClient.SnapshotCloneStatus(string(e.Spec.DataEngine), e.Name, e.Spec.VolumeName,
 		p.DirectToURL(e))
 	if err != nil {
@@ -58,11 +64,13 @@ func (p *Proxy) SnapshotCloneStatus(e *longhorn.Engine) (status map[string]*long
 }
 
 func (p *Proxy) SnapshotRevert(e *longhorn.Engine, snapshotName string) (err error) {
+	defer p.observeRequest("SnapshotRevert", time.Now())
 	return p.grpcClient.SnapshotRevert(string(e.Spec.DataEngine), e.Name, e.Spec.VolumeName, p.DirectToURL(e),
 		snapshotName)
 }
 
 func (p *Proxy) SnapshotPurge(e *longhorn.Engine) (err error) {
+	defer p.observeRequest("SnapshotPurge", time.Now())
 	v, err := p.ds.GetVolumeRO(e.Spec.VolumeName)
 	if err != nil {
 		return errors.Wrapf(err, "failed to get volume %v before purging snapshots", e.Spec.VolumeName)
@@ -77,6 +85,7 @@ func (p *Proxy) SnapshotPurge(e *longhorn.Engine) (err error) {
 }
 
 func (p *Proxy) SnapshotPurgeStatus(e *longhorn.Engine) (status map[string]*longhorn.PurgeStatus, err error) {
+	defer p.observeRequest("SnapshotPurgeStatus", time.Now())
 	recv, err := p.grpcClient.SnapshotPurgeStatus(string(e.Spec.DataEngine), e.Name, e.Spec.VolumeName,
 		p.DirectToURL(e))
 	if err != nil {
@@ -91,16 +100,19 @@ func (p *Proxy) SnapshotPurgeStatus(e *longhorn.Engine) (status map[string]*long
 }
 
 func (p *Proxy) SnapshotDelete(e *longhorn.Engine, name string) (err error) {
+	defer p.observeRequest("SnapshotDelete", time.Now())
 	return p.grpcClient.SnapshotRemove(string(e.Spec.DataEngine), e.Name, e.Spec.VolumeName, p.DirectToURL(e),
 		[]string{name})
 }
 
 func (p *Proxy) SnapshotHash(e *longhorn.Engine, snapshotName string, rehash bool) error {
+	defer p.observeRequest("SnapshotHash", time.Now())
 	return p.grpcClient.SnapshotHash(string(e.Spec.DataEngine), e.Name, e.Spec.VolumeName, p.DirectToURL(e),
 		snapshotName, rehash)
 }
 
 func (p *Proxy) SnapshotHashStatus(e *longhorn.Engine, snapshotName string) (status map[string]*longhorn.HashStatus, err error) {
+	defer p.observeRequest("SnapshotHashStatus", time.Now())
 	recv, err := p.grpcClient.SnapshotHashStatus(string(e.Spec.DataEngine), e.Name, e.Spec.VolumeName,
 		p.DirectToURL(e), snapshotName)
 	if err != nil {
@@ -113,3 +125,10 @@ func (p *Proxy) SnapshotHashStatus(e *longhorn.Engine, snapshotName string) (sta
 	}
 	return status, nil
 }
+
+// SnapshotChangedBlocks returns the extents changed from fromSnapshotName to toSnapshotName.
+// The instance manager proxy does not serve the changed extents of the replica snapshot chain yet.
+func (p *Proxy) SnapshotChangedBlocks(e *longhorn.Engine, fromSnapshotName, toSnapshotName string) ([]SnapshotExtent, error) {
+	return nil, errors.Wrapf(errors.New(ErrNotImplement), "failed to get changed blocks from snapshot %v to %v of volume %v: instance manager proxy does not support it",
+		fromSnapshotName, toSnapshotName, e.Spec.VolumeName)
+}
@@ -2,11 +2,15 @@ package engineapi
 
 import (
 	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
 
 func (p *Proxy) VolumeGet(e *longhorn.Engine) (volume *Volume, err error) {
+	defer p.observeRequest("VolumeGet", time.Now())
 	recv, err := p.grpcClient.VolumeGet(string(e.Spec.DataEngine), e.Name, e.Spec.VolumeName, p.DirectToURL(e))
 	if err != nil {
 		return nil, err
@@ -16,11 +20,13 @@ func (p *Proxy) VolumeGet(e *longhorn.Engine) (volume *Volume, err error) {
 }
 
 func (p *Proxy) VolumeExpand(e *longhorn.Engine) (err error) {
+	defer p.observeRequest("VolumeExpand", time.Now())
 	return p.grpcClient.VolumeExpand(string(e.Spec.DataEngine), e.Name, e.Spec.VolumeName, p.DirectToURL(e),
 		e.Spec.VolumeSize)
 }
 
 func (p *Proxy) VolumeFrontendStart(e *longhorn.Engine) (err error) {
+	defer p.observeRequest("VolumeFrontendStart", time.Now())
 	frontendName, err := GetEngineInstanceFrontend(e.Spec.DataEngine, e.Spec.Frontend)
 	if err != nil {
 		return err
@@ -35,25 +41,37 @@ func (p *Proxy) VolumeFrontendStart(e *longhorn.Engine) (err error) {
 }
 
 func (p *Proxy) VolumeFrontendShutdown(e *longhorn.Engine) (err error) {
+	defer p.observeRequest("VolumeFrontendShutdown", time.Now())
 	return p.grpcClient.VolumeFrontendShutdown(string(e.Spec.DataEngine), e.Name, e.Spec.VolumeName,
 		p.DirectToURL(e))
 }
 
 func (p *Proxy) VolumeUnmapMarkSnapChainRemovedSet(e *longhorn.Engine) error {
+	defer p.observeRequest("VolumeUnmapMarkSnapChainRemovedSet", time.Now())
 	return p.grpcClient.VolumeUnmapMarkSnapChainRemovedSet(string(e.Spec.DataEngine), e.Name, e.Spec.VolumeName,
 		p.DirectToURL(e), e.Spec.UnmapMarkSnapChainRemovedEnabled)
 }
 
 func (p *Proxy) VolumeSnapshotMaxCountSet(e *longhorn.Engine) error {
+	defer p.observeRequest("VolumeSnapshotMaxCountSet", time.Now())
 	return p.grpcClient.VolumeSnapshotMaxCountSet(string(e.Spec.DataEngine), e.Name, e.Spec.VolumeName,
 		p.DirectToURL(e), e.Spec.SnapshotMaxCount)
 }
 
 func (p *Proxy) VolumeSnapshotMaxSizeSet(e *longhorn.Engine) error {
+	defer p.observeRequest("VolumeSnapshotMaxSizeSet", time.Now())
 	return p.grpcClient.VolumeSnapshotMaxSizeSet(string(e.Spec.DataEngine), e.Name, e.Spec.VolumeName,
 		p.DirectToURL(e), e.Spec.SnapshotMaxSize)
 }
 
+// VolumeIOQoSSet applies the IOPS and bandwidth limits in the engine spec.
+// The instance manager proxy does not serve the IO throttling of the engine frontend yet.
+func (p *Proxy) VolumeIOQoSSet(e *longhorn.Engine) error {
+	return errors.Wrapf(errors.New(ErrNotImplement), "failed to set IO QoS %+v of volume %v: instance manager proxy does not support it",
+		e.Spec.IOQoS, e.Spec.VolumeName)
+}
+
 func (p *Proxy) RemountReadOnlyVolume(e *longhorn.Engine) error {
+	defer p.observeRequest("RemountReadOnlyVolume", time.Now())
 	return p.grpcClient.RemountReadOnlyVolume(e.Spec.VolumeName)
 }
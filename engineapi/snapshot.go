@@ -178,3 +178,49 @@ func (e *EngineBinary) SnapshotHashStatus(engine *longhorn.Engine, snapshotName
 
 	return data, nil
 }
+
+// SnapshotChangedBlocks calls engine binary
+// TODO: Deprecated, replaced by gRPC proxy
+func (e *EngineBinary) SnapshotChangedBlocks(engine *longhorn.Engine, fromSnapshotName, toSnapshotName string) ([]SnapshotExtent, error) {
+	return nil, errors.New(ErrNotImplement)
+}
+
+// ValidateSnapshotChangedBlocksRange checks the changed blocks can be computed from fromSnapshotName to
+// toSnapshotName. The fromSnapshotName must be an ancestor of toSnapshotName in the snapshot tree, and toSnapshotName
+// can be the volume head.
+func ValidateSnapshotChangedBlocksRange(snapshots map[string]*longhorn.SnapshotInfo, fromSnapshotName, toSnapshotName string) error {
+	from, ok := snapshots[fromSnapshotName]
+	if !ok || from == nil {
+		return fmt.Errorf("cannot find snapshot %v", fromSnapshotName)
+	}
+	if from.Removed {
+		return fmt.Errorf("snapshot %v is removed", fromSnapshotName)
+	}
+	if fromSnapshotName == etypes.VolumeHeadName {
+		return fmt.Errorf("snapshot %v cannot be the start of the range", etypes.VolumeHeadName)
+	}
+
+	to, ok := snapshots[toSnapshotName]
+	if !ok || to == nil {
+		return fmt.Errorf("cannot find snapshot %v", toSnapshotName)
+	}
+	if to.Removed {
+		return fmt.Errorf("snapshot %v is removed", toSnapshotName)
+	}
+	if fromSnapshotName == toSnapshotName {
+		return fmt.Errorf("snapshot %v cannot be compared with itself", fromSnapshotName)
+	}
+
+	// Walk up from the newer snapshot. The depth is bounded to tolerate a malformed tree.
+	for parent, depth := to.Parent, 0; parent != "" && depth <= len(snapshots); depth++ {
+		if parent == fromSnapshotName {
+			return nil
+		}
+		snapshot, ok := snapshots[parent]
+		if !ok || snapshot == nil {
+			break
+		}
+		parent = snapshot.Parent
+	}
+	return fmt.Errorf("snapshot %v is not an ancestor of %v", fromSnapshotName, toSnapshotName)
+}
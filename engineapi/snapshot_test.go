@@ -0,0 +1,40 @@
+package engineapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	etypes "github.com/longhorn/longhorn-engine/pkg/types"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+func TestValidateSnapshotChangedBlocksRange(t *testing.T) {
+	assert := require.New(t)
+
+	// snap-1 <- snap-2 <- volume-head
+	//        <- snap-3 (removed)
+	snapshots := map[string]*longhorn.SnapshotInfo{
+		"snap-1":              {Name: "snap-1"},
+		"snap-2":              {Name: "snap-2", Parent: "snap-1"},
+		"snap-3":              {Name: "snap-3", Parent: "snap-1", Removed: true},
+		etypes.VolumeHeadName: {Name: etypes.VolumeHeadName, Parent: "snap-2"},
+	}
+
+	assert.Nil(ValidateSnapshotChangedBlocksRange(snapshots, "snap-1", "snap-2"))
+	assert.Nil(ValidateSnapshotChangedBlocksRange(snapshots, "snap-1", etypes.VolumeHeadName))
+	assert.Nil(ValidateSnapshotChangedBlocksRange(snapshots, "snap-2", etypes.VolumeHeadName))
+
+	assert.NotNil(ValidateSnapshotChangedBlocksRange(snapshots, "snap-2", "snap-1"))
+	assert.NotNil(ValidateSnapshotChangedBlocksRange(snapshots, "snap-2", "snap-2"))
+	assert.NotNil(ValidateSnapshotChangedBlocksRange(snapshots, "snap-1", "snap-3"))
+	assert.NotNil(ValidateSnapshotChangedBlocksRange(snapshots, "snap-1", "nonexistent"))
+	assert.NotNil(ValidateSnapshotChangedBlocksRange(snapshots, "nonexistent", "snap-2"))
+	assert.NotNil(ValidateSnapshotChangedBlocksRange(snapshots, etypes.VolumeHeadName, "snap-2"))
+
+	// A cycle in a malformed tree must not hang the validation
+	snapshots["snap-1"].Parent = "snap-2"
+	assert.NotNil(ValidateSnapshotChangedBlocksRange(snapshots, "nonexistent-parent", "snap-2"))
+	assert.NotNil(ValidateSnapshotChangedBlocksRange(snapshots, "snap-3", "snap-2"))
+}
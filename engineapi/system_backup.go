@@ -11,6 +11,7 @@ import (
 	"github.com/longhorn/longhorn-manager/types"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	bsops "github.com/longhorn/longhorn-manager/util/backupstore"
 )
 
 type SystemBackupOperationInterface interface {
@@ -37,16 +38,16 @@ func (btc *BackupTargetClient) DeleteSystemBackup(systemBackup *longhorn.SystemB
 	return output, nil
 }
 
-// DownloadSystemBackup downloads system backup from the backup target
+// DownloadSystemBackup downloads system backup from the backup target within the restore bandwidth limits
 func (btc *BackupTargetClient) DownloadSystemBackup(name, version, downloadPath string) error {
 	systemBackupURL, err := systembackupstore.GetSystemBackupURL(name, version, btc.URL)
 	if err != nil {
 		return err
 	}
 
-	_, err = btc.ExecuteEngineBinaryWithTimeout(
+	_, err = btc.ExecuteBackupStoreCommand(
 		datastore.SystemRestoreTimeout,
-		"system-backup", "download", systemBackupURL, downloadPath,
+		bsops.CommandSystemBackupDownload, systemBackupURL, downloadPath,
 	)
 	if err != nil {
 		return errors.Wrapf(err, "error downloading system backup %v", systemBackupURL)
@@ -94,19 +95,19 @@ func (btc *BackupTargetClient) ListSystemBackup() (systembackupstore.SystemBacku
 	return systemBackups, nil
 }
 
-// UploadSystemBackup uploads system backup to the backup target
+// UploadSystemBackup uploads system backup to the backup target within the backup bandwidth limits
 func (btc *BackupTargetClient) UploadSystemBackup(name, localFile, longhornVersion, longhornGitCommit, managerImage, engineImage string) (string, error) {
 	systemBackupURL, err := systembackupstore.GetSystemBackupURL(name, longhornVersion, btc.URL)
 	if err != nil {
 		return "", err
 	}
 
-	output, err := btc.ExecuteEngineBinaryWithTimeout(
+	output, err := btc.ExecuteBackupStoreCommand(
 		datastore.SystemBackupTimeout,
-		"system-backup", "upload", localFile, systemBackupURL,
-		"--git-commit", longhornGitCommit,
-		"--manager-image", managerImage,
-		"--engine-image", engineImage,
+		bsops.CommandSystemBackupUpload, localFile, systemBackupURL,
+		"--"+bsops.FlagGitCommit, longhornGitCommit,
+		"--"+bsops.FlagManagerImage, managerImage,
+		"--"+bsops.FlagEngineImage, engineImage,
 	)
 	if err != nil {
 		return "", errors.Wrapf(err, "error uploading system backup from %v to %v", localFile, systemBackupURL)
@@ -66,6 +66,12 @@ type Controller struct {
 	NodeID string
 }
 
+// SnapshotExtent is a range of the volume data changed between two snapshots.
+type SnapshotExtent struct {
+	Offset int64 `json:"offset"`
+	Length int64 `json:"length"`
+}
+
 type Metrics struct {
 	ReadThroughput  uint64
 	WriteThroughput uint64
@@ -75,6 +81,15 @@ type Metrics struct {
 	WriteIOPS       uint64
 }
 
+// ReplicaMetrics is the IO statistics of a replica observed by the engine.
+type ReplicaMetrics struct {
+	ReadLatency       uint64 // in nanoseconds
+	WriteLatency      uint64 // in nanoseconds
+	ReadErrorCount    uint64
+	WriteErrorCount   uint64
+	RebuildThroughput uint64 // in bytes per second
+}
+
 type EngineClient interface {
 	VersionGet(engine *longhorn.Engine, clientOnly bool) (*EngineVersion, error)
 
@@ -86,6 +101,7 @@ type EngineClient interface {
 	VolumeUnmapMarkSnapChainRemovedSet(engine *longhorn.Engine) error
 	VolumeSnapshotMaxCountSet(engine *longhorn.Engine) error
 	VolumeSnapshotMaxSizeSet(engine *longhorn.Engine) error
+	VolumeIOQoSSet(engine *longhorn.Engine) error
 
 	ReplicaList(*longhorn.Engine) (map[string]*Replica, error)
 	ReplicaAdd(engine *longhorn.Engine, replicaName, url string, isRestoreVolume, fastSync bool, localSync *etypes.FileLocalSync, replicaFileSyncHTTPClientTimeout, grpcTimeoutSeconds int64) error
@@ -107,6 +123,7 @@ type EngineClient interface {
 	SnapshotClone(engine *longhorn.Engine, snapshotName, fromEngineAddress, fromVolumeName, fromEngineName string, fileSyncHTTPClientTimeout, grpcTimeoutSeconds int64) error
 	SnapshotHash(engine *longhorn.Engine, snapshotName string, rehash bool) error
 	SnapshotHashStatus(engine *longhorn.Engine, snapshotName string) (map[string]*longhorn.HashStatus, error)
+	SnapshotChangedBlocks(engine *longhorn.Engine, fromSnapshotName, toSnapshotName string) ([]SnapshotExtent, error)
 
 	BackupRestore(engine *longhorn.Engine, backupTarget, backupName, backupVolume, lastRestored string, credential map[string]string, concurrentLimit int) error
 	BackupRestoreStatus(engine *longhorn.Engine) (map[string]*longhorn.RestoreStatus, error)
@@ -120,6 +137,7 @@ type EngineClient interface {
 	CleanupBackupMountPoints() error
 
 	MetricsGet(engine *longhorn.Engine) (*Metrics, error)
+	ReplicaMetricsGet(engine *longhorn.Engine) (map[string]*ReplicaMetrics, error)
 	RemountReadOnlyVolume(engine *longhorn.Engine) error
 }
 
@@ -151,12 +169,21 @@ type Volume struct {
 }
 
 type BackupTarget struct {
-	Name             string `json:"name"`
-	BackupTargetURL  string `json:"backupTargetURL"`
-	CredentialSecret string `json:"credentialSecret"`
-	PollInterval     string `json:"pollInterval"`
-	Available        bool   `json:"available"`
-	Message          string `json:"message"`
+	Name             string                  `json:"name"`
+	BackupTargetURL  string                  `json:"backupTargetURL"`
+	CredentialSecret string                  `json:"credentialSecret"`
+	PollInterval     string                  `json:"pollInterval"`
+	BandwidthLimit   longhorn.BandwidthLimit `json:"bandwidthLimit"`
+	Available        bool                    `json:"available"`
+	Message          string                  `json:"message"`
+
+	LastSyncedAt      string                                        `json:"lastSyncedAt"`
+	LastListLatency   string                                        `json:"lastListLatency"`
+	BackupVolumeCount int                                           `json:"backupVolumeCount"`
+	TotalStoredBytes  int64                                         `json:"totalStoredBytes"`
+	TotalLogicalBytes int64                                         `json:"totalLogicalBytes"`
+	VolumeStorage     map[string]longhorn.BackupTargetVolumeStorage `json:"volumeStorage"`
+	GarbageCollection longhorn.BackupTargetGarbageCollectionStatus  `json:"garbageCollection"`
 }
 
 type BackupVolume struct {
@@ -201,6 +228,12 @@ type ConfigMetadata struct {
 	ModificationTime time.Time `json:"modificationTime"`
 }
 
+type BackupGarbageCollectResult struct {
+	OrphanBlockCount int64    `json:"orphanBlockCount"`
+	OrphanConfigs    []string `json:"orphanConfigs"`
+	ReclaimableBytes int64    `json:"reclaimableBytes"`
+}
+
 type BackupCreateInfo struct {
 	BackupID       string
 	ReplicaAddress string
@@ -0,0 +1,147 @@
+package v1beta2
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+type AlertRuleType string
+
+const (
+	// AlertRuleTypeVolumeDegraded fires for the volumes staying degraded.
+	AlertRuleTypeVolumeDegraded = AlertRuleType("VolumeDegraded")
+	// AlertRuleTypeDiskUsage fires for the disks whose usage percentage is above the threshold.
+	AlertRuleTypeDiskUsage = AlertRuleType("DiskUsage")
+	// AlertRuleTypeBackupAge fires for the volumes whose last backup is older than the threshold in minutes.
+	AlertRuleTypeBackupAge = AlertRuleType("BackupAge")
+	// AlertRuleTypeNodeDown fires for the nodes staying not ready.
+	AlertRuleTypeNodeDown = AlertRuleType("NodeDown")
+)
+
+type AlertSeverity string
+
+const (
+	AlertSeverityInfo     = AlertSeverity("info")
+	AlertSeverityWarning  = AlertSeverity("warning")
+	AlertSeverityCritical = AlertSeverity("critical")
+)
+
+type AlertState string
+
+const (
+	// AlertStatePending means the condition holds but not for the duration required by the rule yet.
+	AlertStatePending = AlertState("pending")
+	AlertStateFiring  = AlertState("firing")
+	// AlertStateResolved is only used in the notifications, the resolved alerts are removed from the status.
+	AlertStateResolved = AlertState("resolved")
+)
+
+const (
+	AlertRuleConditionTypeNotified = "Notified"
+
+	AlertRuleConditionReasonNotificationFailed = "NotificationFailed"
+)
+
+// AlertReceiver is a webhook receiving the alert notifications as JSON via HTTP POST.
+type AlertReceiver struct {
+	// +optional
+	Name string `json:"name"`
+	// The webhook URL.
+	URL string `json:"url"`
+	// Send a notification when the alerts are resolved as well.
+	// +optional
+	SendResolved bool `json:"sendResolved"`
+}
+
+// Alert is an object matching the condition of an AlertRule.
+type Alert struct {
+	// The kind of the object, for example volume, disk or node.
+	// +optional
+	ObjectKind string `json:"objectKind"`
+	// The name of the object. The disks are named as <node>/<disk>.
+	// +optional
+	ObjectName string `json:"objectName"`
+	// +optional
+	State AlertState `json:"state"`
+	// +optional
+	Message string `json:"message"`
+	// The time the condition is first observed.
+	// +optional
+	// +nullable
+	StartsAt metav1.Time `json:"startsAt"`
+	// The time the alert starts firing.
+	// +optional
+	// +nullable
+	FiredAt metav1.Time `json:"firedAt"`
+	// Whether the firing alert is delivered to all receivers. The delivery is retried in the next evaluation
+	// otherwise.
+	// +optional
+	Notified bool `json:"notified"`
+}
+
+// AlertRuleSpec defines the desired state of the Longhorn AlertRule
+type AlertRuleSpec struct {
+	// The condition evaluated by the rule. Available values: VolumeDegraded, DiskUsage, BackupAge, NodeDown.
+	// +kubebuilder:validation:Enum=VolumeDegraded;DiskUsage;BackupAge;NodeDown
+	Type AlertRuleType `json:"type"`
+	// +optional
+	// +kubebuilder:validation:Enum=info;warning;critical
+	Severity AlertSeverity `json:"severity"`
+	// The disk usage percentage for DiskUsage, or the maximum backup age in minutes (RPO) for BackupAge. Ignored by
+	// the other types.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Threshold int64 `json:"threshold"`
+	// The minutes the condition has to hold before the alert fires. The alert fires in the first evaluation if it
+	// is 0.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	ForMinutes int64 `json:"forMinutes"`
+	// +optional
+	// +nullable
+	Receivers []AlertReceiver `json:"receivers"`
+	// Stop evaluating the rule. The active alerts are cleared without notifications.
+	// +optional
+	Disabled bool `json:"disabled"`
+}
+
+// AlertRuleStatus defines the observed state of the Longhorn AlertRule
+type AlertRuleStatus struct {
+	// The node ID of the responsible controller to evaluate this AlertRule.
+	// +optional
+	OwnerID string `json:"ownerID"`
+	// The pending and firing alerts.
+	// +optional
+	// +nullable
+	Alerts []Alert `json:"alerts"`
+	// +optional
+	// +nullable
+	Conditions []Condition `json:"conditions"`
+	// +optional
+	// +nullable
+	LastEvaluatedAt metav1.Time `json:"lastEvaluatedAt"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName=lhar
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.type`,description="The condition evaluated by the rule"
+// +kubebuilder:printcolumn:name="Severity",type=string,JSONPath=`.spec.severity`,description="The severity of the alerts"
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// AlertRule is where Longhorn stores an alerting rule evaluated by the manager
+type AlertRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AlertRuleSpec   `json:"spec,omitempty"`
+	Status AlertRuleStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AlertRuleList is a list of AlertRules
+type AlertRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AlertRule `json:"items"`
+}
@@ -51,6 +51,10 @@ type BackupSpec struct {
 	// Can be "full" or "incremental"
 	// +optional
 	BackupMode BackupMode `json:"backupMode"`
+	// The name of the backup in another backup target which this backup is copied from.
+	// The blocks already existing in the destination backup volume are not transferred again.
+	// +optional
+	SourceBackupName string `json:"sourceBackupName"`
 }
 
 // BackupStatus defines the observed state of the Longhorn backup
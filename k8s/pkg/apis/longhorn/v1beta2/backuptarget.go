@@ -8,6 +8,14 @@ const (
 	BackupTargetConditionReasonUnavailable = "Unavailable"
 )
 
+type BackupTargetGarbageCollectionState string
+
+const (
+	BackupTargetGarbageCollectionStateInProgress = BackupTargetGarbageCollectionState("InProgress")
+	BackupTargetGarbageCollectionStateCompleted  = BackupTargetGarbageCollectionState("Completed")
+	BackupTargetGarbageCollectionStateError      = BackupTargetGarbageCollectionState("Error")
+)
+
 // BackupTargetSpec defines the desired state of the Longhorn backup target
 type BackupTargetSpec struct {
 	// The backup target URL.
@@ -23,6 +31,51 @@ type BackupTargetSpec struct {
 	// +optional
 	// +nullable
 	SyncRequestedAt metav1.Time `json:"syncRequestedAt"`
+	// The throughput caps of the backup upload to and the restore download from the backup target.
+	// The caps apply to the transfers run by Longhorn manager: backup copies, system backup uploads and system restore downloads.
+	// +optional
+	BandwidthLimit BandwidthLimit `json:"bandwidthLimit"`
+	// The time to request the garbage collection of the data not referenced by any backup in the remote backup target.
+	// +optional
+	// +nullable
+	GarbageCollectionRequestedAt metav1.Time `json:"garbageCollectionRequestedAt"`
+	// Only report the reclaimable data without deleting it in the requested garbage collection.
+	// +optional
+	GarbageCollectionDryRun bool `json:"garbageCollectionDryRun"`
+}
+
+// BandwidthLimit defines the throughput caps of the backup upload and the restore download run by Longhorn manager
+type BandwidthLimit struct {
+	// The maximum backup upload rate in bytes per second. 0 means unlimited.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	BackupBytesPerSecond int64 `json:"backupBytesPerSecond"`
+	// The maximum restore download rate in bytes per second. 0 means unlimited.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	RestoreBytesPerSecond int64 `json:"restoreBytesPerSecond"`
+	// The time-of-day windows overriding the above caps. The first window covering the current time is applied.
+	// +optional
+	Windows []BandwidthLimitWindow `json:"windows,omitempty"`
+}
+
+// BandwidthLimitWindow defines the throughput caps applied during a time-of-day window
+type BandwidthLimitWindow struct {
+	// The start time of the window in the format "HH:MM" in UTC.
+	// +optional
+	Start string `json:"start"`
+	// The end time of the window in the format "HH:MM" in UTC.
+	// The window wraps around midnight if the end time is earlier than the start time.
+	// +optional
+	End string `json:"end"`
+	// The maximum backup upload rate in bytes per second during the window. 0 means unlimited.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	BackupBytesPerSecond int64 `json:"backupBytesPerSecond"`
+	// The maximum restore download rate in bytes per second during the window. 0 means unlimited.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	RestoreBytesPerSecond int64 `json:"restoreBytesPerSecond"`
 }
 
 // BackupTargetStatus defines the observed state of the Longhorn backup target
@@ -41,6 +94,64 @@ type BackupTargetStatus struct {
 	// +optional
 	// +nullable
 	LastSyncedAt metav1.Time `json:"lastSyncedAt"`
+	// The time taken to list the backup volumes in the remote backup target during the last successful synchronization.
+	// +optional
+	LastListLatency metav1.Duration `json:"lastListLatency"`
+	// The number of the backup volumes in the remote backup target.
+	// +optional
+	BackupVolumeCount int `json:"backupVolumeCount"`
+	// The total size in bytes of the deduplicated data stored in the remote backup target.
+	// +optional
+	TotalStoredBytes int64 `json:"totalStoredBytes"`
+	// The total logical size in bytes of all backups in the remote backup target.
+	// +optional
+	TotalLogicalBytes int64 `json:"totalLogicalBytes"`
+	// The storage usage of each backup volume in the remote backup target, keyed by the volume name.
+	// +optional
+	// +nullable
+	VolumeStorage map[string]BackupTargetVolumeStorage `json:"volumeStorage"`
+	// The result of the last requested garbage collection.
+	// +optional
+	GarbageCollection BackupTargetGarbageCollectionStatus `json:"garbageCollection"`
+}
+
+// BackupTargetGarbageCollectionStatus records the result of a garbage collection in the backup target.
+type BackupTargetGarbageCollectionStatus struct {
+	// The request time of the garbage collection this status is for.
+	// +optional
+	// +nullable
+	RequestedAt metav1.Time `json:"requestedAt"`
+	// Whether the garbage collection only reports the reclaimable data without deleting it.
+	// +optional
+	DryRun bool `json:"dryRun"`
+	// +optional
+	// +kubebuilder:validation:Enum=InProgress;Completed;Error
+	State BackupTargetGarbageCollectionState `json:"state"`
+	// +optional
+	Error string `json:"error"`
+	// The number of the blocks not referenced by any backup.
+	// +optional
+	OrphanBlockCount int64 `json:"orphanBlockCount"`
+	// The config files not referenced by any backup, relative to the backup target URL.
+	// +optional
+	// +nullable
+	OrphanConfigs []string `json:"orphanConfigs"`
+	// The size in bytes of the orphan blocks and config files, which is deleted if it is not a dry run.
+	// +optional
+	ReclaimableBytes int64 `json:"reclaimableBytes"`
+	// +optional
+	// +nullable
+	CompletedAt metav1.Time `json:"completedAt"`
+}
+
+// BackupTargetVolumeStorage records the storage usage of a backup volume in the backup target.
+type BackupTargetVolumeStorage struct {
+	// The size in bytes of the deduplicated data blocks stored for the backup volume.
+	// +optional
+	StoredBytes int64 `json:"storedBytes"`
+	// The sum of the sizes in bytes of all backups of the backup volume.
+	// +optional
+	LogicalBytes int64 `json:"logicalBytes"`
 }
 
 // +genclient
@@ -143,6 +143,8 @@ type EngineSpec struct {
 	// +kubebuilder:validation:Type=string
 	// +optional
 	SnapshotMaxSize int64 `json:"snapshotMaxSize,string"`
+	// +optional
+	IOQoS VolumeIOQoS `json:"ioQoS"`
 }
 
 // EngineStatus defines the observed state of the Longhorn engine
@@ -199,6 +201,8 @@ type EngineStatus struct {
 	// +kubebuilder:validation:Type=string
 	// +optional
 	SnapshotMaxSize int64 `json:"snapshotMaxSize,string"`
+	// +optional
+	IOQoS VolumeIOQoS `json:"ioQoS"`
 }
 
 // +genclient
@@ -102,6 +102,9 @@ type DiskSpec struct {
 	StorageReserved int64 `json:"storageReserved"`
 	// +optional
 	Tags []string `json:"tags"`
+	// The setting names mapped to the values overriding the node and global values for the disk.
+	// +optional
+	SettingOverrides map[string]string `json:"settingOverrides,omitempty"`
 }
 
 type DiskStatus struct {
@@ -150,6 +153,12 @@ type NodeSpec struct {
 	Tags []string `json:"tags"`
 	// +optional
 	InstanceManagerCPURequest int `json:"instanceManagerCPURequest"`
+	// The throughput caps of the backup upload and the restore download run by Longhorn manager on the node.
+	// +optional
+	BackupBandwidthLimit BandwidthLimit `json:"backupBandwidthLimit"`
+	// The setting names mapped to the values overriding the global values for the node.
+	// +optional
+	SettingOverrides map[string]string `json:"settingOverrides,omitempty"`
 }
 
 // NodeStatus defines the observed state of the Longhorn node
@@ -2,7 +2,7 @@ package v1beta2
 
 import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
-// +kubebuilder:validation:Enum=snapshot;snapshot-force-create;snapshot-cleanup;snapshot-delete;backup;backup-force-create;filesystem-trim;system-backup
+// +kubebuilder:validation:Enum=snapshot;snapshot-force-create;snapshot-cleanup;snapshot-delete;backup;backup-force-create;backup-copy;filesystem-trim;system-backup
 type RecurringJobType string
 
 const (
@@ -12,6 +12,7 @@ const (
 	RecurringJobTypeSnapshotDelete      = RecurringJobType("snapshot-delete")       // periodically remove and purge all kinds of snapshots that exceed the retention count
 	RecurringJobTypeBackup              = RecurringJobType("backup")                // periodically create snapshots then do backups
 	RecurringJobTypeBackupForceCreate   = RecurringJobType("backup-force-create")   // periodically create snapshots then do backups even if old snapshots cleanup failed
+	RecurringJobTypeBackupCopy          = RecurringJobType("backup-copy")           // periodically copy the last backups to another backup target
 	RecurringJobTypeFilesystemTrim      = RecurringJobType("filesystem-trim")       // periodically trim filesystem to reclaim disk space
 	RecurringJobTypeSystemBackup        = RecurringJobType("system-backup")         // periodically create system backups
 
@@ -39,7 +40,7 @@ type RecurringJobSpec struct {
 	// +optional
 	Groups []string `json:"groups,omitempty"`
 	// The recurring job task.
-	// Can be "snapshot", "snapshot-force-create", "snapshot-cleanup", "snapshot-delete", "backup", "backup-force-create", "filesystem-trim" or "system-backup".
+	// Can be "snapshot", "snapshot-force-create", "snapshot-cleanup", "snapshot-delete", "backup", "backup-force-create", "backup-copy", "filesystem-trim" or "system-backup".
 	// +optional
 	Task RecurringJobType `json:"task"`
 	// The cron setting.
@@ -55,7 +56,7 @@ type RecurringJobSpec struct {
 	// +optional
 	Labels map[string]string `json:"labels,omitempty"`
 	// The parameters of the snapshot/backup.
-	// Support parameters: "full-backup-interval", "volume-backup-policy".
+	// Support parameters: "full-backup-interval", "volume-backup-policy", "backup-target".
 	// +optional
 	Parameters map[string]string `json:"parameters,omitempty"`
 }
@@ -68,6 +69,12 @@ type RecurringJobStatus struct {
 	// The number of jobs that have been triggered.
 	// +optional
 	ExecutionCount int `json:"executionCount"`
+	// The number of jobs that have finished successfully.
+	// +optional
+	SucceededCount int `json:"succeededCount"`
+	// The number of jobs that have failed, including the jobs with any failed volume.
+	// +optional
+	FailedCount int `json:"failedCount"`
 }
 
 // +genclient
@@ -76,7 +83,7 @@ type RecurringJobStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Groups",type=string,JSONPath=`.spec.groups`,description="Sets groupings to the jobs. When set to \"default\" group will be added to the volume label when no other job label exist in volume"
-// +kubebuilder:printcolumn:name="Task",type=string,JSONPath=`.spec.task`,description="Should be one of \"snapshot\", \"snapshot-force-create\", \"snapshot-cleanup\", \"snapshot-delete\", \"backup\", \"backup-force-create\", \"filesystem-trim\" or \"system-backup\""
+// +kubebuilder:printcolumn:name="Task",type=string,JSONPath=`.spec.task`,description="Should be one of \"snapshot\", \"snapshot-force-create\", \"snapshot-cleanup\", \"snapshot-delete\", \"backup\", \"backup-force-create\", \"backup-copy\", \"filesystem-trim\" or \"system-backup\""
 // +kubebuilder:printcolumn:name="Cron",type=string,JSONPath=`.spec.cron`,description="The cron expression represents recurring job scheduling"
 // +kubebuilder:printcolumn:name="Retain",type=integer,JSONPath=`.spec.retain`,description="The number of snapshots/backups to keep for the volume"
 // +kubebuilder:printcolumn:name="Concurrency",type=integer,JSONPath=`.spec.concurrency`,description="The concurrent job to run by each cron job"
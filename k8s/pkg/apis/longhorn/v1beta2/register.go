@@ -27,6 +27,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&BackingImageDataSourceList{},
 		&BackingImageManager{},
 		&BackingImageManagerList{},
+		&AlertRule{},
+		&AlertRuleList{},
 		&Backup{},
 		&BackupList{},
 		&BackupBackingImage{},
@@ -51,6 +53,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ReplicaList{},
 		&Setting{},
 		&SettingList{},
+		&SettingsProfile{},
+		&SettingsProfileList{},
 		&ShareManager{},
 		&ShareManagerList{},
 		&Snapshot{},
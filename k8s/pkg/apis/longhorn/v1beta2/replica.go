@@ -11,12 +11,15 @@ const (
 
 const (
 	ReplicaConditionTypeRebuildFailed                = "RebuildFailed"
+	ReplicaConditionTypeSlow                         = "Slow"
 	ReplicaConditionTypeWaitForBackingImage          = "WaitForBackingImage"
 	ReplicaConditionReasonWaitForBackingImageFailed  = "GetBackingImageFailed"
 	ReplicaConditionReasonWaitForBackingImageWaiting = "Waiting"
 
 	ReplicaConditionReasonRebuildFailedDisconnection = "Disconnection"
 	ReplicaConditionReasonRebuildFailedGeneral       = "General"
+
+	ReplicaConditionReasonSlowHighLatency = "HighLatency"
 )
 
 // ReplicaSpec defines the desired state of the Longhorn replica
@@ -86,12 +89,36 @@ type ReplicaSpec struct {
 	SnapshotMaxSize int64 `json:"snapshotMaxSize,string"`
 }
 
+// ReplicaIOMetrics is the IO statistics of the replica observed by the engine it connects to
+type ReplicaIOMetrics struct {
+	// The average read latency in nanoseconds
+	// +optional
+	ReadLatency int64 `json:"readLatency"`
+	// The average write latency in nanoseconds
+	// +optional
+	WriteLatency int64 `json:"writeLatency"`
+	// The number of the failed reads since the replica connects to the engine
+	// +optional
+	ReadErrorCount int64 `json:"readErrorCount"`
+	// The number of the failed writes since the replica connects to the engine
+	// +optional
+	WriteErrorCount int64 `json:"writeErrorCount"`
+	// The rebuild throughput in bytes per second. It's 0 if the replica is not rebuilding
+	// +optional
+	RebuildThroughput int64 `json:"rebuildThroughput"`
+	// +optional
+	LastUpdatedAt string `json:"lastUpdatedAt"`
+}
+
 // ReplicaStatus defines the observed state of the Longhorn replica
 type ReplicaStatus struct {
 	InstanceStatus `json:""`
 	// Deprecated: Replaced by field `spec.evictionRequested`.
 	// +optional
 	EvictionRequested bool `json:"evictionRequested"`
+	// +optional
+	// +nullable
+	IOMetrics *ReplicaIOMetrics `json:"ioMetrics,omitempty"`
 }
 
 // +genclient
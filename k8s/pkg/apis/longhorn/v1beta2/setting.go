@@ -2,6 +2,15 @@ package v1beta2
 
 import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+const (
+	SettingConditionTypeAdvisory              = "Advisory"
+	SettingConditionTypeUpgradePathCompatible = "UpgradePathCompatible"
+
+	SettingConditionReasonKnownIssues              = "KnownIssues"
+	SettingConditionReasonUnsupportedUpgradePath   = "UnsupportedUpgradePath"
+	SettingConditionReasonIncompatibleEngineImages = "IncompatibleEngineImages"
+)
+
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:resource:shortName=lhs
@@ -27,6 +36,32 @@ type Setting struct {
 type SettingStatus struct {
 	// The setting is applied.
 	Applied bool `json:"applied"`
+	// The recent value changes of the setting, from the oldest to the newest.
+	// +optional
+	// +nullable
+	History []SettingHistoryEntry `json:"history"`
+	// The conditions of the setting. The upgrade checker setting reports the advisories for the current version and
+	// the upgrade path compatibility here.
+	// +optional
+	// +nullable
+	Conditions []Condition `json:"conditions"`
+}
+
+// SettingHistoryEntry is a value change of the setting
+type SettingHistoryEntry struct {
+	// The revision of the setting after the change.
+	Revision int64 `json:"revision"`
+	// The value before the change.
+	OldValue string `json:"oldValue"`
+	// The value after the change.
+	NewValue string `json:"newValue"`
+	// The user who made the change.
+	// +optional
+	Actor string `json:"actor"`
+	// The time of the change.
+	// +optional
+	// +nullable
+	Timestamp metav1.Time `json:"timestamp"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
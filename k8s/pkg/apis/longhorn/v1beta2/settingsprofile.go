@@ -0,0 +1,85 @@
+package v1beta2
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+type SettingsProfileState string
+
+const (
+	SettingsProfileStateNone    = SettingsProfileState("")
+	SettingsProfileStateApplied = SettingsProfileState("Applied")
+	SettingsProfileStateDrifted = SettingsProfileState("Drifted")
+	SettingsProfileStateError   = SettingsProfileState("Error")
+
+	SettingsProfileConditionTypeApplied = "Applied"
+
+	SettingsProfileConditionReasonApplyFailed = "ApplyFailed"
+)
+
+// SettingsProfileDrift is a setting whose value is modified outside the profile after the profile is applied.
+type SettingsProfileDrift struct {
+	// +optional
+	Name string `json:"name"`
+	// The value in the profile.
+	// +optional
+	ExpectedValue string `json:"expectedValue"`
+	// The current value of the setting.
+	// +optional
+	ActualValue string `json:"actualValue"`
+}
+
+// SettingsProfileSpec defines the desired state of the Longhorn SettingsProfile
+type SettingsProfileSpec struct {
+	// The setting names mapped to the values. The settings are validated and applied together.
+	// +optional
+	Settings map[string]string `json:"settings"`
+}
+
+// SettingsProfileStatus defines the observed state of the Longhorn SettingsProfile
+type SettingsProfileStatus struct {
+	// The node ID of the responsible controller to reconcile this SettingsProfile.
+	// +optional
+	OwnerID string `json:"ownerID"`
+	// The settings profile state.
+	// +optional
+	State SettingsProfileState `json:"state,omitempty"`
+	// +optional
+	// +nullable
+	Conditions []Condition `json:"conditions"`
+	// The generation of the profile spec last applied to the settings.
+	// +optional
+	AppliedGeneration int64 `json:"appliedGeneration"`
+	// The time the profile is last applied.
+	// +optional
+	// +nullable
+	LastAppliedAt metav1.Time `json:"lastAppliedAt"`
+	// The settings modified outside the profile after the profile is applied.
+	// +optional
+	// +nullable
+	DriftedSettings []SettingsProfileDrift `json:"driftedSettings"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName=lhsp
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`,description="The settings profile state"
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// SettingsProfile is where Longhorn stores a set of settings applied together
+type SettingsProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SettingsProfileSpec   `json:"spec,omitempty"`
+	Status SettingsProfileStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SettingsProfileList is a list of SettingsProfiles
+type SettingsProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SettingsProfile `json:"items"`
+}
@@ -55,6 +55,9 @@ type SnapshotStatus struct {
 	ReadyToUse bool `json:"readyToUse"`
 	// +optional
 	Checksum string `json:"checksum"`
+	// DataIntegrityError is the failure of the last data integrity check of the snapshot
+	// +optional
+	DataIntegrityError string `json:"dataIntegrityError,omitempty"`
 }
 
 // +genclient
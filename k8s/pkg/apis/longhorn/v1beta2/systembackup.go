@@ -38,6 +38,21 @@ type SystemBackupSpec struct {
 	// +optional
 	// +nullable
 	VolumeBackupPolicy SystemBackupCreateVolumeBackupPolicy `json:"volumeBackupPolicy"`
+	// The scope limiting the resources in the system backup.
+	// +optional
+	Scope SystemBackupScope `json:"scope"`
+	// The base system backup of an incremental system backup.
+	// Only the resources changed since the base system backup are stored, and the restore reassembles the chain.
+	// +optional
+	BaseSystemBackup string `json:"baseSystemBackup"`
+}
+
+// SystemBackupScope defines the resources to include in the system backup
+type SystemBackupScope struct {
+	// Only back up the PersistentVolumeClaims in the namespaces, with their PersistentVolumes, Volumes and BackingImages.
+	// All resources are backed up if empty.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
 }
 
 // SystemBackupStatus defines the observed state of the Longhorn SystemBackup
@@ -26,10 +26,83 @@ const (
 	SystemRestoreConditionMessageUnpackFailed = "failed to unpack system backup from file"
 )
 
+type SystemRestoreResourceAction string
+
+const (
+	SystemRestoreResourceActionCreate = SystemRestoreResourceAction("Create")
+	SystemRestoreResourceActionUpdate = SystemRestoreResourceAction("Update")
+	SystemRestoreResourceActionSkip   = SystemRestoreResourceAction("Skip")
+)
+
+// SystemRestoreResourceFilter selects the resources in the system backup to restore.
+type SystemRestoreResourceFilter struct {
+	// The resource kinds, for example Volume, Setting or PersistentVolumeClaim.
+	// +optional
+	Kinds []string `json:"kinds,omitempty"`
+	// The namespaces of the namespaced resources. Cluster-scoped resources are not affected.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+	// The label selector of the volumes. The PersistentVolumes and PersistentVolumeClaims of the volumes follow the volumes.
+	// +optional
+	// +nullable
+	VolumeSelector *metav1.LabelSelector `json:"volumeSelector,omitempty"`
+}
+
+// SystemRestoreRemap maps the resources in the system backup to the cluster restoring it.
+type SystemRestoreRemap struct {
+	// The node names in the system backup mapped to the node names in this cluster.
+	// +optional
+	NodeNames map[string]string `json:"nodeNames,omitempty"`
+	// The StorageClass names in the system backup mapped to the StorageClass names in this cluster.
+	// +optional
+	StorageClassNames map[string]string `json:"storageClassNames,omitempty"`
+	// The namespaces of the PersistentVolumeClaims in the system backup mapped to the namespaces in this cluster.
+	// +optional
+	Namespaces map[string]string `json:"namespaces,omitempty"`
+	// The BackupTarget names in the system backup mapped to the overrides of their spec.
+	// +optional
+	BackupTargets map[string]SystemRestoreBackupTargetOverride `json:"backupTargets,omitempty"`
+}
+
+// SystemRestoreBackupTargetOverride overrides the spec of a BackupTarget in the system backup. Empty fields are not overridden.
+type SystemRestoreBackupTargetOverride struct {
+	// +optional
+	BackupTargetURL string `json:"backupTargetURL"`
+	// +optional
+	CredentialSecret string `json:"credentialSecret"`
+}
+
+// SystemRestoreResourceChange is the change of a resource reported by the system restore dry run.
+type SystemRestoreResourceChange struct {
+	// +optional
+	Kind string `json:"kind"`
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// +optional
+	Name string `json:"name"`
+	// +optional
+	Action SystemRestoreResourceAction `json:"action"`
+	// The reason why the resource is skipped.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
 // SystemRestoreSpec defines the desired state of the Longhorn SystemRestore
 type SystemRestoreSpec struct {
 	// The system backup name in the object store.
 	SystemBackup string `json:"systemBackup"`
+	// Only restore the resources matching the filter. Restore all resources if empty.
+	// +optional
+	Include SystemRestoreResourceFilter `json:"include"`
+	// Do not restore the resources matching the filter.
+	// +optional
+	Exclude SystemRestoreResourceFilter `json:"exclude"`
+	// The remapping rules applied to the resources before rolling them out.
+	// +optional
+	Remap SystemRestoreRemap `json:"remap"`
+	// Report the changes in the status instead of applying them.
+	// +optional
+	DryRun bool `json:"dryRun"`
 }
 
 // SystemRestoreStatus defines the observed state of the Longhorn SystemRestore
@@ -46,6 +119,10 @@ type SystemRestoreStatus struct {
 	// +optional
 	// +nullable
 	Conditions []Condition `json:"conditions"`
+	// The changes the system restore would apply, reported in dry run.
+	// +optional
+	// +nullable
+	DryRunReport []SystemRestoreResourceChange `json:"dryRunReport"`
 }
 
 // +genclient
@@ -107,6 +107,14 @@ const (
 	VolumeConditionTypeRestore             = "Restore"
 	VolumeConditionTypeTooManySnapshots    = "TooManySnapshots"
 	VolumeConditionTypeWaitForBackingImage = "WaitForBackingImage"
+
+	// The health conditions are true if the volume meets the corresponding objective
+	VolumeConditionTypeReplicaRedundancy = "ReplicaRedundancy"
+	VolumeConditionTypeReplicaSpread     = "ReplicaSpread"
+	VolumeConditionTypeBackupRPO         = "BackupRPO"
+	VolumeConditionTypeSnapshotCount     = "SnapshotCount"
+	VolumeConditionTypeDataIntegrity     = "DataIntegrity"
+	VolumeConditionTypeIOLatency         = "IOLatency"
 )
 
 const (
@@ -117,6 +125,14 @@ const (
 	VolumeConditionReasonTooManySnapshots              = "TooManySnapshots"
 	VolumeConditionReasonWaitForBackingImageFailed     = "GetBackingImageFailed"
 	VolumeConditionReasonWaitForBackingImageWaiting    = "Waiting"
+
+	VolumeConditionReasonInsufficientHealthyReplicas = "InsufficientHealthyReplicas"
+	VolumeConditionReasonReplicasOnSameNode          = "ReplicasOnSameNode"
+	VolumeConditionReasonReplicasOnSameZone          = "ReplicasOnSameZone"
+	VolumeConditionReasonBackupRPOExceeded           = "BackupRPOExceeded"
+	VolumeConditionReasonSnapshotCountNearLimit      = "SnapshotCountNearLimit"
+	VolumeConditionReasonDataIntegrityCheckFailed    = "DataIntegrityCheckFailed"
+	VolumeConditionReasonSlowReplica                 = "SlowReplica"
 )
 
 type SnapshotDataIntegrity string
@@ -181,6 +197,28 @@ const (
 	DataEngineTypeAll = DataEngineType("all")
 )
 
+// VolumeIOQoS caps the IO of a volume. A zero limit means unlimited.
+type VolumeIOQoS struct {
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxReadIOPS int64 `json:"maxReadIOPS"`
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxWriteIOPS int64 `json:"maxWriteIOPS"`
+	// The read bandwidth limit in bytes per second.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxReadBandwidth int64 `json:"maxReadBandwidth"`
+	// The write bandwidth limit in bytes per second.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxWriteBandwidth int64 `json:"maxWriteBandwidth"`
+	// The number of seconds the volume can exceed the limits by consuming the quota left unused before.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	BurstSeconds int64 `json:"burstSeconds"`
+}
+
 type KubernetesStatus struct {
 	// +optional
 	PVName string `json:"pvName"`
@@ -290,6 +328,9 @@ type VolumeSpec struct {
 	// The backup target name that the volume will be backed up to or is synced.
 	// +optional
 	BackupTargetName string `json:"backupTargetName"`
+	// The IOPS and bandwidth limits of the volume.
+	// +optional
+	IOQoS VolumeIOQoS `json:"ioQoS"`
 }
 
 // VolumeStatus defines the observed state of the Longhorn volume
@@ -338,6 +379,9 @@ type VolumeStatus struct {
 	ShareEndpoint string `json:"shareEndpoint"`
 	// +optional
 	ShareState ShareManagerState `json:"shareState"`
+	// HealthScore is the assessment of the volume health from 0 to 100 combining the health conditions
+	// +optional
+	HealthScore int `json:"healthScore"`
 }
 
 // +genclient
@@ -22,9 +22,155 @@ limitations under the License.
 package v1beta2
 
 import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Alert) DeepCopyInto(out *Alert) {
+	*out = *in
+	in.StartsAt.DeepCopyInto(&out.StartsAt)
+	in.FiredAt.DeepCopyInto(&out.FiredAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Alert.
+func (in *Alert) DeepCopy() *Alert {
+	if in == nil {
+		return nil
+	}
+	out := new(Alert)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertReceiver) DeepCopyInto(out *AlertReceiver) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertReceiver.
+func (in *AlertReceiver) DeepCopy() *AlertReceiver {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertReceiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertRule) DeepCopyInto(out *AlertRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertRule.
+func (in *AlertRule) DeepCopy() *AlertRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AlertRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertRuleList) DeepCopyInto(out *AlertRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AlertRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertRuleList.
+func (in *AlertRuleList) DeepCopy() *AlertRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AlertRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertRuleSpec) DeepCopyInto(out *AlertRuleSpec) {
+	*out = *in
+	if in.Receivers != nil {
+		in, out := &in.Receivers, &out.Receivers
+		*out = make([]AlertReceiver, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertRuleSpec.
+func (in *AlertRuleSpec) DeepCopy() *AlertRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertRuleStatus) DeepCopyInto(out *AlertRuleStatus) {
+	*out = *in
+	if in.Alerts != nil {
+		in, out := &in.Alerts, &out.Alerts
+		*out = make([]Alert, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		copy(*out, *in)
+	}
+	in.LastEvaluatedAt.DeepCopyInto(&out.LastEvaluatedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertRuleStatus.
+func (in *AlertRuleStatus) DeepCopy() *AlertRuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AttachmentTicket) DeepCopyInto(out *AttachmentTicket) {
 	*out = *in
@@ -761,6 +907,29 @@ func (in *BackupTarget) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupTargetGarbageCollectionStatus) DeepCopyInto(out *BackupTargetGarbageCollectionStatus) {
+	*out = *in
+	in.RequestedAt.DeepCopyInto(&out.RequestedAt)
+	if in.OrphanConfigs != nil {
+		in, out := &in.OrphanConfigs, &out.OrphanConfigs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.CompletedAt.DeepCopyInto(&out.CompletedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupTargetGarbageCollectionStatus.
+func (in *BackupTargetGarbageCollectionStatus) DeepCopy() *BackupTargetGarbageCollectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupTargetGarbageCollectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupTargetList) DeepCopyInto(out *BackupTargetList) {
 	*out = *in
@@ -799,6 +968,8 @@ func (in *BackupTargetSpec) DeepCopyInto(out *BackupTargetSpec) {
 	*out = *in
 	out.PollInterval = in.PollInterval
 	in.SyncRequestedAt.DeepCopyInto(&out.SyncRequestedAt)
+	in.BandwidthLimit.DeepCopyInto(&out.BandwidthLimit)
+	in.GarbageCollectionRequestedAt.DeepCopyInto(&out.GarbageCollectionRequestedAt)
 	return
 }
 
@@ -821,6 +992,15 @@ func (in *BackupTargetStatus) DeepCopyInto(out *BackupTargetStatus) {
 		copy(*out, *in)
 	}
 	in.LastSyncedAt.DeepCopyInto(&out.LastSyncedAt)
+	out.LastListLatency = in.LastListLatency
+	if in.VolumeStorage != nil {
+		in, out := &in.VolumeStorage, &out.VolumeStorage
+		*out = make(map[string]BackupTargetVolumeStorage, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.GarbageCollection.DeepCopyInto(&out.GarbageCollection)
 	return
 }
 
@@ -834,6 +1014,22 @@ func (in *BackupTargetStatus) DeepCopy() *BackupTargetStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupTargetVolumeStorage) DeepCopyInto(out *BackupTargetVolumeStorage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupTargetVolumeStorage.
+func (in *BackupTargetVolumeStorage) DeepCopy() *BackupTargetVolumeStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupTargetVolumeStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupVolume) DeepCopyInto(out *BackupVolume) {
 	*out = *in
@@ -944,6 +1140,43 @@ func (in *BackupVolumeStatus) DeepCopy() *BackupVolumeStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BandwidthLimit) DeepCopyInto(out *BandwidthLimit) {
+	*out = *in
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]BandwidthLimitWindow, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BandwidthLimit.
+func (in *BandwidthLimit) DeepCopy() *BandwidthLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(BandwidthLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BandwidthLimitWindow) DeepCopyInto(out *BandwidthLimitWindow) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BandwidthLimitWindow.
+func (in *BandwidthLimitWindow) DeepCopy() *BandwidthLimitWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(BandwidthLimitWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Condition) DeepCopyInto(out *Condition) {
 	*out = *in
@@ -1002,6 +1235,13 @@ func (in *DiskSpec) DeepCopyInto(out *DiskSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.SettingOverrides != nil {
+		in, out := &in.SettingOverrides, &out.SettingOverrides
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -1251,6 +1491,7 @@ func (in *EngineSpec) DeepCopyInto(out *EngineSpec) {
 			(*out)[key] = val
 		}
 	}
+	out.IOQoS = in.IOQoS
 	return
 }
 
@@ -1379,6 +1620,7 @@ func (in *EngineStatus) DeepCopyInto(out *EngineStatus) {
 			(*out)[key] = outVal
 		}
 	}
+	out.IOQoS = in.IOQoS
 	return
 }
 
@@ -1738,6 +1980,14 @@ func (in *NodeSpec) DeepCopyInto(out *NodeSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	in.BackupBandwidthLimit.DeepCopyInto(&out.BackupBandwidthLimit)
+	if in.SettingOverrides != nil {
+		in, out := &in.SettingOverrides, &out.SettingOverrides
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -2065,6 +2315,22 @@ func (in *Replica) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicaIOMetrics) DeepCopyInto(out *ReplicaIOMetrics) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicaIOMetrics.
+func (in *ReplicaIOMetrics) DeepCopy() *ReplicaIOMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicaIOMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReplicaList) DeepCopyInto(out *ReplicaList) {
 	*out = *in
@@ -2119,6 +2385,11 @@ func (in *ReplicaSpec) DeepCopy() *ReplicaSpec {
 func (in *ReplicaStatus) DeepCopyInto(out *ReplicaStatus) {
 	*out = *in
 	in.InstanceStatus.DeepCopyInto(&out.InstanceStatus)
+	if in.IOMetrics != nil {
+		in, out := &in.IOMetrics, &out.IOMetrics
+		*out = new(ReplicaIOMetrics)
+		**out = **in
+	}
 	return
 }
 
@@ -2153,7 +2424,7 @@ func (in *Setting) DeepCopyInto(out *Setting) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -2175,6 +2446,23 @@ func (in *Setting) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SettingHistoryEntry) DeepCopyInto(out *SettingHistoryEntry) {
+	*out = *in
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SettingHistoryEntry.
+func (in *SettingHistoryEntry) DeepCopy() *SettingHistoryEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(SettingHistoryEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SettingList) DeepCopyInto(out *SettingList) {
 	*out = *in
@@ -2211,6 +2499,18 @@ func (in *SettingList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SettingStatus) DeepCopyInto(out *SettingStatus) {
 	*out = *in
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]SettingHistoryEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2224,6 +2524,133 @@ func (in *SettingStatus) DeepCopy() *SettingStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SettingsProfile) DeepCopyInto(out *SettingsProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SettingsProfile.
+func (in *SettingsProfile) DeepCopy() *SettingsProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(SettingsProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SettingsProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SettingsProfileDrift) DeepCopyInto(out *SettingsProfileDrift) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SettingsProfileDrift.
+func (in *SettingsProfileDrift) DeepCopy() *SettingsProfileDrift {
+	if in == nil {
+		return nil
+	}
+	out := new(SettingsProfileDrift)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SettingsProfileList) DeepCopyInto(out *SettingsProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SettingsProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SettingsProfileList.
+func (in *SettingsProfileList) DeepCopy() *SettingsProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(SettingsProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SettingsProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SettingsProfileSpec) DeepCopyInto(out *SettingsProfileSpec) {
+	*out = *in
+	if in.Settings != nil {
+		in, out := &in.Settings, &out.Settings
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SettingsProfileSpec.
+func (in *SettingsProfileSpec) DeepCopy() *SettingsProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SettingsProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SettingsProfileStatus) DeepCopyInto(out *SettingsProfileStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		copy(*out, *in)
+	}
+	in.LastAppliedAt.DeepCopyInto(&out.LastAppliedAt)
+	if in.DriftedSettings != nil {
+		in, out := &in.DriftedSettings, &out.DriftedSettings
+		*out = make([]SettingsProfileDrift, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SettingsProfileStatus.
+func (in *SettingsProfileStatus) DeepCopy() *SettingsProfileStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SettingsProfileStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShareManager) DeepCopyInto(out *ShareManager) {
 	*out = *in
@@ -2597,7 +3024,7 @@ func (in *SystemBackup) DeepCopyInto(out *SystemBackup) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -2653,9 +3080,31 @@ func (in *SystemBackupList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemBackupScope) DeepCopyInto(out *SystemBackupScope) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemBackupScope.
+func (in *SystemBackupScope) DeepCopy() *SystemBackupScope {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemBackupScope)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SystemBackupSpec) DeepCopyInto(out *SystemBackupSpec) {
 	*out = *in
+	in.Scope.DeepCopyInto(&out.Scope)
 	return
 }
 
@@ -2697,7 +3146,7 @@ func (in *SystemRestore) DeepCopyInto(out *SystemRestore) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -2720,6 +3169,22 @@ func (in *SystemRestore) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemRestoreBackupTargetOverride) DeepCopyInto(out *SystemRestoreBackupTargetOverride) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemRestoreBackupTargetOverride.
+func (in *SystemRestoreBackupTargetOverride) DeepCopy() *SystemRestoreBackupTargetOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemRestoreBackupTargetOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SystemRestoreList) DeepCopyInto(out *SystemRestoreList) {
 	*out = *in
@@ -2753,9 +3218,103 @@ func (in *SystemRestoreList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemRestoreRemap) DeepCopyInto(out *SystemRestoreRemap) {
+	*out = *in
+	if in.NodeNames != nil {
+		in, out := &in.NodeNames, &out.NodeNames
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.StorageClassNames != nil {
+		in, out := &in.StorageClassNames, &out.StorageClassNames
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.BackupTargets != nil {
+		in, out := &in.BackupTargets, &out.BackupTargets
+		*out = make(map[string]SystemRestoreBackupTargetOverride, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemRestoreRemap.
+func (in *SystemRestoreRemap) DeepCopy() *SystemRestoreRemap {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemRestoreRemap)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemRestoreResourceChange) DeepCopyInto(out *SystemRestoreResourceChange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemRestoreResourceChange.
+func (in *SystemRestoreResourceChange) DeepCopy() *SystemRestoreResourceChange {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemRestoreResourceChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemRestoreResourceFilter) DeepCopyInto(out *SystemRestoreResourceFilter) {
+	*out = *in
+	if in.Kinds != nil {
+		in, out := &in.Kinds, &out.Kinds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VolumeSelector != nil {
+		in, out := &in.VolumeSelector, &out.VolumeSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemRestoreResourceFilter.
+func (in *SystemRestoreResourceFilter) DeepCopy() *SystemRestoreResourceFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemRestoreResourceFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SystemRestoreSpec) DeepCopyInto(out *SystemRestoreSpec) {
 	*out = *in
+	in.Include.DeepCopyInto(&out.Include)
+	in.Exclude.DeepCopyInto(&out.Exclude)
+	in.Remap.DeepCopyInto(&out.Remap)
 	return
 }
 
@@ -2777,6 +3336,11 @@ func (in *SystemRestoreStatus) DeepCopyInto(out *SystemRestoreStatus) {
 		*out = make([]Condition, len(*in))
 		copy(*out, *in)
 	}
+	if in.DryRunReport != nil {
+		in, out := &in.DryRunReport, &out.DryRunReport
+		*out = make([]SystemRestoreResourceChange, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2989,6 +3553,22 @@ func (in *VolumeCloneStatus) DeepCopy() *VolumeCloneStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeIOQoS) DeepCopyInto(out *VolumeIOQoS) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeIOQoS.
+func (in *VolumeIOQoS) DeepCopy() *VolumeIOQoS {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeIOQoS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeList) DeepCopyInto(out *VolumeList) {
 	*out = *in
@@ -3073,6 +3653,7 @@ func (in *VolumeSpec) DeepCopyInto(out *VolumeSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	out.IOQoS = in.IOQoS
 	return
 }
 
@@ -0,0 +1,98 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AlertApplyConfiguration represents a declarative configuration of the Alert type for use
+// with apply.
+type AlertApplyConfiguration struct {
+	ObjectKind *string                     `json:"objectKind,omitempty"`
+	ObjectName *string                     `json:"objectName,omitempty"`
+	State      *longhornv1beta2.AlertState `json:"state,omitempty"`
+	Message    *string                     `json:"message,omitempty"`
+	StartsAt   *v1.Time                    `json:"startsAt,omitempty"`
+	FiredAt    *v1.Time                    `json:"firedAt,omitempty"`
+	Notified   *bool                       `json:"notified,omitempty"`
+}
+
+// AlertApplyConfiguration constructs a declarative configuration of the Alert type for use with
+// apply.
+func Alert() *AlertApplyConfiguration {
+	return &AlertApplyConfiguration{}
+}
+
+// WithObjectKind sets the ObjectKind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObjectKind field is set to the value of the last call.
+func (b *AlertApplyConfiguration) WithObjectKind(value string) *AlertApplyConfiguration {
+	b.ObjectKind = &value
+	return b
+}
+
+// WithObjectName sets the ObjectName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObjectName field is set to the value of the last call.
+func (b *AlertApplyConfiguration) WithObjectName(value string) *AlertApplyConfiguration {
+	b.ObjectName = &value
+	return b
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *AlertApplyConfiguration) WithState(value longhornv1beta2.AlertState) *AlertApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *AlertApplyConfiguration) WithMessage(value string) *AlertApplyConfiguration {
+	b.Message = &value
+	return b
+}
+
+// WithStartsAt sets the StartsAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartsAt field is set to the value of the last call.
+func (b *AlertApplyConfiguration) WithStartsAt(value v1.Time) *AlertApplyConfiguration {
+	b.StartsAt = &value
+	return b
+}
+
+// WithFiredAt sets the FiredAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FiredAt field is set to the value of the last call.
+func (b *AlertApplyConfiguration) WithFiredAt(value v1.Time) *AlertApplyConfiguration {
+	b.FiredAt = &value
+	return b
+}
+
+// WithNotified sets the Notified field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Notified field is set to the value of the last call.
+func (b *AlertApplyConfiguration) WithNotified(value bool) *AlertApplyConfiguration {
+	b.Notified = &value
+	return b
+}
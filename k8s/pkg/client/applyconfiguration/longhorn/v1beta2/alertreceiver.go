@@ -0,0 +1,57 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// AlertReceiverApplyConfiguration represents a declarative configuration of the AlertReceiver type for use
+// with apply.
+type AlertReceiverApplyConfiguration struct {
+	Name         *string `json:"name,omitempty"`
+	URL          *string `json:"url,omitempty"`
+	SendResolved *bool   `json:"sendResolved,omitempty"`
+}
+
+// AlertReceiverApplyConfiguration constructs a declarative configuration of the AlertReceiver type for use with
+// apply.
+func AlertReceiver() *AlertReceiverApplyConfiguration {
+	return &AlertReceiverApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *AlertReceiverApplyConfiguration) WithName(value string) *AlertReceiverApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithURL sets the URL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the URL field is set to the value of the last call.
+func (b *AlertReceiverApplyConfiguration) WithURL(value string) *AlertReceiverApplyConfiguration {
+	b.URL = &value
+	return b
+}
+
+// WithSendResolved sets the SendResolved field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SendResolved field is set to the value of the last call.
+func (b *AlertReceiverApplyConfiguration) WithSendResolved(value bool) *AlertReceiverApplyConfiguration {
+	b.SendResolved = &value
+	return b
+}
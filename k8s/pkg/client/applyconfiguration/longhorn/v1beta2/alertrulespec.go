@@ -0,0 +1,93 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// AlertRuleSpecApplyConfiguration represents a declarative configuration of the AlertRuleSpec type for use
+// with apply.
+type AlertRuleSpecApplyConfiguration struct {
+	Type       *longhornv1beta2.AlertRuleType    `json:"type,omitempty"`
+	Severity   *longhornv1beta2.AlertSeverity    `json:"severity,omitempty"`
+	Threshold  *int64                            `json:"threshold,omitempty"`
+	ForMinutes *int64                            `json:"forMinutes,omitempty"`
+	Receivers  []AlertReceiverApplyConfiguration `json:"receivers,omitempty"`
+	Disabled   *bool                             `json:"disabled,omitempty"`
+}
+
+// AlertRuleSpecApplyConfiguration constructs a declarative configuration of the AlertRuleSpec type for use with
+// apply.
+func AlertRuleSpec() *AlertRuleSpecApplyConfiguration {
+	return &AlertRuleSpecApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *AlertRuleSpecApplyConfiguration) WithType(value longhornv1beta2.AlertRuleType) *AlertRuleSpecApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithSeverity sets the Severity field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Severity field is set to the value of the last call.
+func (b *AlertRuleSpecApplyConfiguration) WithSeverity(value longhornv1beta2.AlertSeverity) *AlertRuleSpecApplyConfiguration {
+	b.Severity = &value
+	return b
+}
+
+// WithThreshold sets the Threshold field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Threshold field is set to the value of the last call.
+func (b *AlertRuleSpecApplyConfiguration) WithThreshold(value int64) *AlertRuleSpecApplyConfiguration {
+	b.Threshold = &value
+	return b
+}
+
+// WithForMinutes sets the ForMinutes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ForMinutes field is set to the value of the last call.
+func (b *AlertRuleSpecApplyConfiguration) WithForMinutes(value int64) *AlertRuleSpecApplyConfiguration {
+	b.ForMinutes = &value
+	return b
+}
+
+// WithReceivers adds the given value to the Receivers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Receivers field.
+func (b *AlertRuleSpecApplyConfiguration) WithReceivers(values ...*AlertReceiverApplyConfiguration) *AlertRuleSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithReceivers")
+		}
+		b.Receivers = append(b.Receivers, *values[i])
+	}
+	return b
+}
+
+// WithDisabled sets the Disabled field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Disabled field is set to the value of the last call.
+func (b *AlertRuleSpecApplyConfiguration) WithDisabled(value bool) *AlertRuleSpecApplyConfiguration {
+	b.Disabled = &value
+	return b
+}
@@ -0,0 +1,80 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AlertRuleStatusApplyConfiguration represents a declarative configuration of the AlertRuleStatus type for use
+// with apply.
+type AlertRuleStatusApplyConfiguration struct {
+	OwnerID         *string                       `json:"ownerID,omitempty"`
+	Alerts          []AlertApplyConfiguration     `json:"alerts,omitempty"`
+	Conditions      []ConditionApplyConfiguration `json:"conditions,omitempty"`
+	LastEvaluatedAt *v1.Time                      `json:"lastEvaluatedAt,omitempty"`
+}
+
+// AlertRuleStatusApplyConfiguration constructs a declarative configuration of the AlertRuleStatus type for use with
+// apply.
+func AlertRuleStatus() *AlertRuleStatusApplyConfiguration {
+	return &AlertRuleStatusApplyConfiguration{}
+}
+
+// WithOwnerID sets the OwnerID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OwnerID field is set to the value of the last call.
+func (b *AlertRuleStatusApplyConfiguration) WithOwnerID(value string) *AlertRuleStatusApplyConfiguration {
+	b.OwnerID = &value
+	return b
+}
+
+// WithAlerts adds the given value to the Alerts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Alerts field.
+func (b *AlertRuleStatusApplyConfiguration) WithAlerts(values ...*AlertApplyConfiguration) *AlertRuleStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAlerts")
+		}
+		b.Alerts = append(b.Alerts, *values[i])
+	}
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *AlertRuleStatusApplyConfiguration) WithConditions(values ...*ConditionApplyConfiguration) *AlertRuleStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithLastEvaluatedAt sets the LastEvaluatedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastEvaluatedAt field is set to the value of the last call.
+func (b *AlertRuleStatusApplyConfiguration) WithLastEvaluatedAt(value v1.Time) *AlertRuleStatusApplyConfiguration {
+	b.LastEvaluatedAt = &value
+	return b
+}
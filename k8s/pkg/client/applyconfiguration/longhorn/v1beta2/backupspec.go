@@ -26,10 +26,11 @@ import (
 // BackupSpecApplyConfiguration represents a declarative configuration of the BackupSpec type for use
 // with apply.
 type BackupSpecApplyConfiguration struct {
-	SyncRequestedAt *v1.Time                    `json:"syncRequestedAt,omitempty"`
-	SnapshotName    *string                     `json:"snapshotName,omitempty"`
-	Labels          map[string]string           `json:"labels,omitempty"`
-	BackupMode      *longhornv1beta2.BackupMode `json:"backupMode,omitempty"`
+	SyncRequestedAt  *v1.Time                    `json:"syncRequestedAt,omitempty"`
+	SnapshotName     *string                     `json:"snapshotName,omitempty"`
+	Labels           map[string]string           `json:"labels,omitempty"`
+	BackupMode       *longhornv1beta2.BackupMode `json:"backupMode,omitempty"`
+	SourceBackupName *string                     `json:"sourceBackupName,omitempty"`
 }
 
 // BackupSpecApplyConfiguration constructs a declarative configuration of the BackupSpec type for use with
@@ -75,3 +76,11 @@ func (b *BackupSpecApplyConfiguration) WithBackupMode(value longhornv1beta2.Back
 	b.BackupMode = &value
 	return b
 }
+
+// WithSourceBackupName sets the SourceBackupName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SourceBackupName field is set to the value of the last call.
+func (b *BackupSpecApplyConfiguration) WithSourceBackupName(value string) *BackupSpecApplyConfiguration {
+	b.SourceBackupName = &value
+	return b
+}
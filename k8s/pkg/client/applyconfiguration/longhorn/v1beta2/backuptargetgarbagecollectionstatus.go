@@ -0,0 +1,109 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BackupTargetGarbageCollectionStatusApplyConfiguration represents a declarative configuration of the BackupTargetGarbageCollectionStatus type for use
+// with apply.
+type BackupTargetGarbageCollectionStatusApplyConfiguration struct {
+	RequestedAt      *v1.Time                                            `json:"requestedAt,omitempty"`
+	DryRun           *bool                                               `json:"dryRun,omitempty"`
+	State            *longhornv1beta2.BackupTargetGarbageCollectionState `json:"state,omitempty"`
+	Error            *string                                             `json:"error,omitempty"`
+	OrphanBlockCount *int64                                              `json:"orphanBlockCount,omitempty"`
+	OrphanConfigs    []string                                            `json:"orphanConfigs,omitempty"`
+	ReclaimableBytes *int64                                              `json:"reclaimableBytes,omitempty"`
+	CompletedAt      *v1.Time                                            `json:"completedAt,omitempty"`
+}
+
+// BackupTargetGarbageCollectionStatusApplyConfiguration constructs a declarative configuration of the BackupTargetGarbageCollectionStatus type for use with
+// apply.
+func BackupTargetGarbageCollectionStatus() *BackupTargetGarbageCollectionStatusApplyConfiguration {
+	return &BackupTargetGarbageCollectionStatusApplyConfiguration{}
+}
+
+// WithRequestedAt sets the RequestedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RequestedAt field is set to the value of the last call.
+func (b *BackupTargetGarbageCollectionStatusApplyConfiguration) WithRequestedAt(value v1.Time) *BackupTargetGarbageCollectionStatusApplyConfiguration {
+	b.RequestedAt = &value
+	return b
+}
+
+// WithDryRun sets the DryRun field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DryRun field is set to the value of the last call.
+func (b *BackupTargetGarbageCollectionStatusApplyConfiguration) WithDryRun(value bool) *BackupTargetGarbageCollectionStatusApplyConfiguration {
+	b.DryRun = &value
+	return b
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *BackupTargetGarbageCollectionStatusApplyConfiguration) WithState(value longhornv1beta2.BackupTargetGarbageCollectionState) *BackupTargetGarbageCollectionStatusApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithError sets the Error field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Error field is set to the value of the last call.
+func (b *BackupTargetGarbageCollectionStatusApplyConfiguration) WithError(value string) *BackupTargetGarbageCollectionStatusApplyConfiguration {
+	b.Error = &value
+	return b
+}
+
+// WithOrphanBlockCount sets the OrphanBlockCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OrphanBlockCount field is set to the value of the last call.
+func (b *BackupTargetGarbageCollectionStatusApplyConfiguration) WithOrphanBlockCount(value int64) *BackupTargetGarbageCollectionStatusApplyConfiguration {
+	b.OrphanBlockCount = &value
+	return b
+}
+
+// WithOrphanConfigs adds the given value to the OrphanConfigs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the OrphanConfigs field.
+func (b *BackupTargetGarbageCollectionStatusApplyConfiguration) WithOrphanConfigs(values ...string) *BackupTargetGarbageCollectionStatusApplyConfiguration {
+	for i := range values {
+		b.OrphanConfigs = append(b.OrphanConfigs, values[i])
+	}
+	return b
+}
+
+// WithReclaimableBytes sets the ReclaimableBytes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReclaimableBytes field is set to the value of the last call.
+func (b *BackupTargetGarbageCollectionStatusApplyConfiguration) WithReclaimableBytes(value int64) *BackupTargetGarbageCollectionStatusApplyConfiguration {
+	b.ReclaimableBytes = &value
+	return b
+}
+
+// WithCompletedAt sets the CompletedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CompletedAt field is set to the value of the last call.
+func (b *BackupTargetGarbageCollectionStatusApplyConfiguration) WithCompletedAt(value v1.Time) *BackupTargetGarbageCollectionStatusApplyConfiguration {
+	b.CompletedAt = &value
+	return b
+}
@@ -25,10 +25,13 @@ import (
 // BackupTargetSpecApplyConfiguration represents a declarative configuration of the BackupTargetSpec type for use
 // with apply.
 type BackupTargetSpecApplyConfiguration struct {
-	BackupTargetURL  *string      `json:"backupTargetURL,omitempty"`
-	CredentialSecret *string      `json:"credentialSecret,omitempty"`
-	PollInterval     *v1.Duration `json:"pollInterval,omitempty"`
-	SyncRequestedAt  *v1.Time     `json:"syncRequestedAt,omitempty"`
+	BackupTargetURL              *string                           `json:"backupTargetURL,omitempty"`
+	CredentialSecret             *string                           `json:"credentialSecret,omitempty"`
+	PollInterval                 *v1.Duration                      `json:"pollInterval,omitempty"`
+	SyncRequestedAt              *v1.Time                          `json:"syncRequestedAt,omitempty"`
+	BandwidthLimit               *BandwidthLimitApplyConfiguration `json:"bandwidthLimit,omitempty"`
+	GarbageCollectionRequestedAt *v1.Time                          `json:"garbageCollectionRequestedAt,omitempty"`
+	GarbageCollectionDryRun      *bool                             `json:"garbageCollectionDryRun,omitempty"`
 }
 
 // BackupTargetSpecApplyConfiguration constructs a declarative configuration of the BackupTargetSpec type for use with
@@ -68,3 +71,27 @@ func (b *BackupTargetSpecApplyConfiguration) WithSyncRequestedAt(value v1.Time)
 	b.SyncRequestedAt = &value
 	return b
 }
+
+// WithBandwidthLimit sets the BandwidthLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BandwidthLimit field is set to the value of the last call.
+func (b *BackupTargetSpecApplyConfiguration) WithBandwidthLimit(value *BandwidthLimitApplyConfiguration) *BackupTargetSpecApplyConfiguration {
+	b.BandwidthLimit = value
+	return b
+}
+
+// WithGarbageCollectionRequestedAt sets the GarbageCollectionRequestedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GarbageCollectionRequestedAt field is set to the value of the last call.
+func (b *BackupTargetSpecApplyConfiguration) WithGarbageCollectionRequestedAt(value v1.Time) *BackupTargetSpecApplyConfiguration {
+	b.GarbageCollectionRequestedAt = &value
+	return b
+}
+
+// WithGarbageCollectionDryRun sets the GarbageCollectionDryRun field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GarbageCollectionDryRun field is set to the value of the last call.
+func (b *BackupTargetSpecApplyConfiguration) WithGarbageCollectionDryRun(value bool) *BackupTargetSpecApplyConfiguration {
+	b.GarbageCollectionDryRun = &value
+	return b
+}
@@ -25,10 +25,16 @@ import (
 // BackupTargetStatusApplyConfiguration represents a declarative configuration of the BackupTargetStatus type for use
 // with apply.
 type BackupTargetStatusApplyConfiguration struct {
-	OwnerID      *string                       `json:"ownerID,omitempty"`
-	Available    *bool                         `json:"available,omitempty"`
-	Conditions   []ConditionApplyConfiguration `json:"conditions,omitempty"`
-	LastSyncedAt *v1.Time                      `json:"lastSyncedAt,omitempty"`
+	OwnerID           *string                                                `json:"ownerID,omitempty"`
+	Available         *bool                                                  `json:"available,omitempty"`
+	Conditions        []ConditionApplyConfiguration                          `json:"conditions,omitempty"`
+	LastSyncedAt      *v1.Time                                               `json:"lastSyncedAt,omitempty"`
+	LastListLatency   *v1.Duration                                           `json:"lastListLatency,omitempty"`
+	BackupVolumeCount *int                                                   `json:"backupVolumeCount,omitempty"`
+	TotalStoredBytes  *int64                                                 `json:"totalStoredBytes,omitempty"`
+	TotalLogicalBytes *int64                                                 `json:"totalLogicalBytes,omitempty"`
+	VolumeStorage     map[string]BackupTargetVolumeStorageApplyConfiguration `json:"volumeStorage,omitempty"`
+	GarbageCollection *BackupTargetGarbageCollectionStatusApplyConfiguration `json:"garbageCollection,omitempty"`
 }
 
 // BackupTargetStatusApplyConfiguration constructs a declarative configuration of the BackupTargetStatus type for use with
@@ -73,3 +79,57 @@ func (b *BackupTargetStatusApplyConfiguration) WithLastSyncedAt(value v1.Time) *
 	b.LastSyncedAt = &value
 	return b
 }
+
+// WithLastListLatency sets the LastListLatency field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastListLatency field is set to the value of the last call.
+func (b *BackupTargetStatusApplyConfiguration) WithLastListLatency(value v1.Duration) *BackupTargetStatusApplyConfiguration {
+	b.LastListLatency = &value
+	return b
+}
+
+// WithBackupVolumeCount sets the BackupVolumeCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BackupVolumeCount field is set to the value of the last call.
+func (b *BackupTargetStatusApplyConfiguration) WithBackupVolumeCount(value int) *BackupTargetStatusApplyConfiguration {
+	b.BackupVolumeCount = &value
+	return b
+}
+
+// WithTotalStoredBytes sets the TotalStoredBytes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TotalStoredBytes field is set to the value of the last call.
+func (b *BackupTargetStatusApplyConfiguration) WithTotalStoredBytes(value int64) *BackupTargetStatusApplyConfiguration {
+	b.TotalStoredBytes = &value
+	return b
+}
+
+// WithTotalLogicalBytes sets the TotalLogicalBytes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TotalLogicalBytes field is set to the value of the last call.
+func (b *BackupTargetStatusApplyConfiguration) WithTotalLogicalBytes(value int64) *BackupTargetStatusApplyConfiguration {
+	b.TotalLogicalBytes = &value
+	return b
+}
+
+// WithVolumeStorage puts the entries into the VolumeStorage field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the VolumeStorage field,
+// overwriting an existing map entries in VolumeStorage field with the same key.
+func (b *BackupTargetStatusApplyConfiguration) WithVolumeStorage(entries map[string]BackupTargetVolumeStorageApplyConfiguration) *BackupTargetStatusApplyConfiguration {
+	if b.VolumeStorage == nil && len(entries) > 0 {
+		b.VolumeStorage = make(map[string]BackupTargetVolumeStorageApplyConfiguration, len(entries))
+	}
+	for k, v := range entries {
+		b.VolumeStorage[k] = v
+	}
+	return b
+}
+
+// WithGarbageCollection sets the GarbageCollection field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GarbageCollection field is set to the value of the last call.
+func (b *BackupTargetStatusApplyConfiguration) WithGarbageCollection(value *BackupTargetGarbageCollectionStatusApplyConfiguration) *BackupTargetStatusApplyConfiguration {
+	b.GarbageCollection = value
+	return b
+}
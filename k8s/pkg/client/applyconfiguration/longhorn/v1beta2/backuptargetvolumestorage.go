@@ -0,0 +1,48 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// BackupTargetVolumeStorageApplyConfiguration represents a declarative configuration of the BackupTargetVolumeStorage type for use
+// with apply.
+type BackupTargetVolumeStorageApplyConfiguration struct {
+	StoredBytes  *int64 `json:"storedBytes,omitempty"`
+	LogicalBytes *int64 `json:"logicalBytes,omitempty"`
+}
+
+// BackupTargetVolumeStorageApplyConfiguration constructs a declarative configuration of the BackupTargetVolumeStorage type for use with
+// apply.
+func BackupTargetVolumeStorage() *BackupTargetVolumeStorageApplyConfiguration {
+	return &BackupTargetVolumeStorageApplyConfiguration{}
+}
+
+// WithStoredBytes sets the StoredBytes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StoredBytes field is set to the value of the last call.
+func (b *BackupTargetVolumeStorageApplyConfiguration) WithStoredBytes(value int64) *BackupTargetVolumeStorageApplyConfiguration {
+	b.StoredBytes = &value
+	return b
+}
+
+// WithLogicalBytes sets the LogicalBytes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LogicalBytes field is set to the value of the last call.
+func (b *BackupTargetVolumeStorageApplyConfiguration) WithLogicalBytes(value int64) *BackupTargetVolumeStorageApplyConfiguration {
+	b.LogicalBytes = &value
+	return b
+}
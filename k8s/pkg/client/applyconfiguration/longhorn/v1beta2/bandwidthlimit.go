@@ -0,0 +1,62 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// BandwidthLimitApplyConfiguration represents a declarative configuration of the BandwidthLimit type for use
+// with apply.
+type BandwidthLimitApplyConfiguration struct {
+	BackupBytesPerSecond  *int64                                   `json:"backupBytesPerSecond,omitempty"`
+	RestoreBytesPerSecond *int64                                   `json:"restoreBytesPerSecond,omitempty"`
+	Windows               []BandwidthLimitWindowApplyConfiguration `json:"windows,omitempty"`
+}
+
+// BandwidthLimitApplyConfiguration constructs a declarative configuration of the BandwidthLimit type for use with
+// apply.
+func BandwidthLimit() *BandwidthLimitApplyConfiguration {
+	return &BandwidthLimitApplyConfiguration{}
+}
+
+// WithBackupBytesPerSecond sets the BackupBytesPerSecond field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BackupBytesPerSecond field is set to the value of the last call.
+func (b *BandwidthLimitApplyConfiguration) WithBackupBytesPerSecond(value int64) *BandwidthLimitApplyConfiguration {
+	b.BackupBytesPerSecond = &value
+	return b
+}
+
+// WithRestoreBytesPerSecond sets the RestoreBytesPerSecond field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RestoreBytesPerSecond field is set to the value of the last call.
+func (b *BandwidthLimitApplyConfiguration) WithRestoreBytesPerSecond(value int64) *BandwidthLimitApplyConfiguration {
+	b.RestoreBytesPerSecond = &value
+	return b
+}
+
+// WithWindows adds the given value to the Windows field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Windows field.
+func (b *BandwidthLimitApplyConfiguration) WithWindows(values ...*BandwidthLimitWindowApplyConfiguration) *BandwidthLimitApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithWindows")
+		}
+		b.Windows = append(b.Windows, *values[i])
+	}
+	return b
+}
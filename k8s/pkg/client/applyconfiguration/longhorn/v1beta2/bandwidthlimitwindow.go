@@ -0,0 +1,66 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// BandwidthLimitWindowApplyConfiguration represents a declarative configuration of the BandwidthLimitWindow type for use
+// with apply.
+type BandwidthLimitWindowApplyConfiguration struct {
+	Start                 *string `json:"start,omitempty"`
+	End                   *string `json:"end,omitempty"`
+	BackupBytesPerSecond  *int64  `json:"backupBytesPerSecond,omitempty"`
+	RestoreBytesPerSecond *int64  `json:"restoreBytesPerSecond,omitempty"`
+}
+
+// BandwidthLimitWindowApplyConfiguration constructs a declarative configuration of the BandwidthLimitWindow type for use with
+// apply.
+func BandwidthLimitWindow() *BandwidthLimitWindowApplyConfiguration {
+	return &BandwidthLimitWindowApplyConfiguration{}
+}
+
+// WithStart sets the Start field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Start field is set to the value of the last call.
+func (b *BandwidthLimitWindowApplyConfiguration) WithStart(value string) *BandwidthLimitWindowApplyConfiguration {
+	b.Start = &value
+	return b
+}
+
+// WithEnd sets the End field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the End field is set to the value of the last call.
+func (b *BandwidthLimitWindowApplyConfiguration) WithEnd(value string) *BandwidthLimitWindowApplyConfiguration {
+	b.End = &value
+	return b
+}
+
+// WithBackupBytesPerSecond sets the BackupBytesPerSecond field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BackupBytesPerSecond field is set to the value of the last call.
+func (b *BandwidthLimitWindowApplyConfiguration) WithBackupBytesPerSecond(value int64) *BandwidthLimitWindowApplyConfiguration {
+	b.BackupBytesPerSecond = &value
+	return b
+}
+
+// WithRestoreBytesPerSecond sets the RestoreBytesPerSecond field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RestoreBytesPerSecond field is set to the value of the last call.
+func (b *BandwidthLimitWindowApplyConfiguration) WithRestoreBytesPerSecond(value int64) *BandwidthLimitWindowApplyConfiguration {
+	b.RestoreBytesPerSecond = &value
+	return b
+}
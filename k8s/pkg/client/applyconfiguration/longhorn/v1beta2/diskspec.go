@@ -32,6 +32,7 @@ type DiskSpecApplyConfiguration struct {
 	EvictionRequested *bool                       `json:"evictionRequested,omitempty"`
 	StorageReserved   *int64                      `json:"storageReserved,omitempty"`
 	Tags              []string                    `json:"tags,omitempty"`
+	SettingOverrides  map[string]string           `json:"settingOverrides,omitempty"`
 }
 
 // DiskSpecApplyConfiguration constructs a declarative configuration of the DiskSpec type for use with
@@ -97,3 +98,17 @@ func (b *DiskSpecApplyConfiguration) WithTags(values ...string) *DiskSpecApplyCo
 	}
 	return b
 }
+
+// WithSettingOverrides puts the entries into the SettingOverrides field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the SettingOverrides field,
+// overwriting an existing map entries in SettingOverrides field with the same key.
+func (b *DiskSpecApplyConfiguration) WithSettingOverrides(entries map[string]string) *DiskSpecApplyConfiguration {
+	if b.SettingOverrides == nil && len(entries) > 0 {
+		b.SettingOverrides = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.SettingOverrides[k] = v
+	}
+	return b
+}
@@ -44,6 +44,7 @@ type EngineStatusApplyConfiguration struct {
 	UnmapMarkSnapChainRemovedEnabled *bool                                           `json:"unmapMarkSnapChainRemovedEnabled,omitempty"`
 	SnapshotMaxCount                 *int                                            `json:"snapshotMaxCount,omitempty"`
 	SnapshotMaxSize                  *int64                                          `json:"snapshotMaxSize,omitempty"`
+	IOQoS                            *VolumeIOQoSApplyConfiguration                  `json:"ioQoS,omitempty"`
 }
 
 // EngineStatusApplyConfiguration constructs a declarative configuration of the EngineStatus type for use with
@@ -257,3 +258,11 @@ func (b *EngineStatusApplyConfiguration) WithSnapshotMaxSize(value int64) *Engin
 	b.SnapshotMaxSize = &value
 	return b
 }
+
+// WithIOQoS sets the IOQoS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IOQoS field is set to the value of the last call.
+func (b *EngineStatusApplyConfiguration) WithIOQoS(value *VolumeIOQoSApplyConfiguration) *EngineStatusApplyConfiguration {
+	b.IOQoS = value
+	return b
+}
@@ -23,6 +23,8 @@ package v1beta2
 type RecurringJobStatusApplyConfiguration struct {
 	OwnerID        *string `json:"ownerID,omitempty"`
 	ExecutionCount *int    `json:"executionCount,omitempty"`
+	SucceededCount *int    `json:"succeededCount,omitempty"`
+	FailedCount    *int    `json:"failedCount,omitempty"`
 }
 
 // RecurringJobStatusApplyConfiguration constructs a declarative configuration of the RecurringJobStatus type for use with
@@ -46,3 +48,19 @@ func (b *RecurringJobStatusApplyConfiguration) WithExecutionCount(value int) *Re
 	b.ExecutionCount = &value
 	return b
 }
+
+// WithSucceededCount sets the SucceededCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SucceededCount field is set to the value of the last call.
+func (b *RecurringJobStatusApplyConfiguration) WithSucceededCount(value int) *RecurringJobStatusApplyConfiguration {
+	b.SucceededCount = &value
+	return b
+}
+
+// WithFailedCount sets the FailedCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FailedCount field is set to the value of the last call.
+func (b *RecurringJobStatusApplyConfiguration) WithFailedCount(value int) *RecurringJobStatusApplyConfiguration {
+	b.FailedCount = &value
+	return b
+}
@@ -21,7 +21,8 @@ package v1beta2
 // ReplicaStatusApplyConfiguration represents a declarative configuration of the ReplicaStatus type for use
 // with apply.
 type ReplicaStatusApplyConfiguration struct {
-	EvictionRequested *bool `json:"evictionRequested,omitempty"`
+	EvictionRequested *bool                               `json:"evictionRequested,omitempty"`
+	IOMetrics         *ReplicaIOMetricsApplyConfiguration `json:"ioMetrics,omitempty"`
 }
 
 // ReplicaStatusApplyConfiguration constructs a declarative configuration of the ReplicaStatus type for use with
@@ -37,3 +38,11 @@ func (b *ReplicaStatusApplyConfiguration) WithEvictionRequested(value bool) *Rep
 	b.EvictionRequested = &value
 	return b
 }
+
+// WithIOMetrics sets the IOMetrics field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IOMetrics field is set to the value of the last call.
+func (b *ReplicaStatusApplyConfiguration) WithIOMetrics(value *ReplicaIOMetricsApplyConfiguration) *ReplicaStatusApplyConfiguration {
+	b.IOMetrics = value
+	return b
+}
@@ -0,0 +1,79 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SettingHistoryEntryApplyConfiguration represents a declarative configuration of the SettingHistoryEntry type for use
+// with apply.
+type SettingHistoryEntryApplyConfiguration struct {
+	Revision  *int64   `json:"revision,omitempty"`
+	OldValue  *string  `json:"oldValue,omitempty"`
+	NewValue  *string  `json:"newValue,omitempty"`
+	Actor     *string  `json:"actor,omitempty"`
+	Timestamp *v1.Time `json:"timestamp,omitempty"`
+}
+
+// SettingHistoryEntryApplyConfiguration constructs a declarative configuration of the SettingHistoryEntry type for use with
+// apply.
+func SettingHistoryEntry() *SettingHistoryEntryApplyConfiguration {
+	return &SettingHistoryEntryApplyConfiguration{}
+}
+
+// WithRevision sets the Revision field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Revision field is set to the value of the last call.
+func (b *SettingHistoryEntryApplyConfiguration) WithRevision(value int64) *SettingHistoryEntryApplyConfiguration {
+	b.Revision = &value
+	return b
+}
+
+// WithOldValue sets the OldValue field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OldValue field is set to the value of the last call.
+func (b *SettingHistoryEntryApplyConfiguration) WithOldValue(value string) *SettingHistoryEntryApplyConfiguration {
+	b.OldValue = &value
+	return b
+}
+
+// WithNewValue sets the NewValue field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NewValue field is set to the value of the last call.
+func (b *SettingHistoryEntryApplyConfiguration) WithNewValue(value string) *SettingHistoryEntryApplyConfiguration {
+	b.NewValue = &value
+	return b
+}
+
+// WithActor sets the Actor field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Actor field is set to the value of the last call.
+func (b *SettingHistoryEntryApplyConfiguration) WithActor(value string) *SettingHistoryEntryApplyConfiguration {
+	b.Actor = &value
+	return b
+}
+
+// WithTimestamp sets the Timestamp field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Timestamp field is set to the value of the last call.
+func (b *SettingHistoryEntryApplyConfiguration) WithTimestamp(value v1.Time) *SettingHistoryEntryApplyConfiguration {
+	b.Timestamp = &value
+	return b
+}
@@ -0,0 +1,57 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// SettingsProfileDriftApplyConfiguration represents a declarative configuration of the SettingsProfileDrift type for use
+// with apply.
+type SettingsProfileDriftApplyConfiguration struct {
+	Name          *string `json:"name,omitempty"`
+	ExpectedValue *string `json:"expectedValue,omitempty"`
+	ActualValue   *string `json:"actualValue,omitempty"`
+}
+
+// SettingsProfileDriftApplyConfiguration constructs a declarative configuration of the SettingsProfileDrift type for use with
+// apply.
+func SettingsProfileDrift() *SettingsProfileDriftApplyConfiguration {
+	return &SettingsProfileDriftApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *SettingsProfileDriftApplyConfiguration) WithName(value string) *SettingsProfileDriftApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithExpectedValue sets the ExpectedValue field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExpectedValue field is set to the value of the last call.
+func (b *SettingsProfileDriftApplyConfiguration) WithExpectedValue(value string) *SettingsProfileDriftApplyConfiguration {
+	b.ExpectedValue = &value
+	return b
+}
+
+// WithActualValue sets the ActualValue field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ActualValue field is set to the value of the last call.
+func (b *SettingsProfileDriftApplyConfiguration) WithActualValue(value string) *SettingsProfileDriftApplyConfiguration {
+	b.ActualValue = &value
+	return b
+}
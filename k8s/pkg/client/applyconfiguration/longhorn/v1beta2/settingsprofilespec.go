@@ -0,0 +1,45 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// SettingsProfileSpecApplyConfiguration represents a declarative configuration of the SettingsProfileSpec type for use
+// with apply.
+type SettingsProfileSpecApplyConfiguration struct {
+	Settings map[string]string `json:"settings,omitempty"`
+}
+
+// SettingsProfileSpecApplyConfiguration constructs a declarative configuration of the SettingsProfileSpec type for use with
+// apply.
+func SettingsProfileSpec() *SettingsProfileSpecApplyConfiguration {
+	return &SettingsProfileSpecApplyConfiguration{}
+}
+
+// WithSettings puts the entries into the Settings field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Settings field,
+// overwriting an existing map entries in Settings field with the same key.
+func (b *SettingsProfileSpecApplyConfiguration) WithSettings(entries map[string]string) *SettingsProfileSpecApplyConfiguration {
+	if b.Settings == nil && len(entries) > 0 {
+		b.Settings = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Settings[k] = v
+	}
+	return b
+}
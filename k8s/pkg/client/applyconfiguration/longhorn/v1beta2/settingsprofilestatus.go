@@ -0,0 +1,99 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SettingsProfileStatusApplyConfiguration represents a declarative configuration of the SettingsProfileStatus type for use
+// with apply.
+type SettingsProfileStatusApplyConfiguration struct {
+	OwnerID           *string                                  `json:"ownerID,omitempty"`
+	State             *longhornv1beta2.SettingsProfileState    `json:"state,omitempty"`
+	Conditions        []ConditionApplyConfiguration            `json:"conditions,omitempty"`
+	AppliedGeneration *int64                                   `json:"appliedGeneration,omitempty"`
+	LastAppliedAt     *v1.Time                                 `json:"lastAppliedAt,omitempty"`
+	DriftedSettings   []SettingsProfileDriftApplyConfiguration `json:"driftedSettings,omitempty"`
+}
+
+// SettingsProfileStatusApplyConfiguration constructs a declarative configuration of the SettingsProfileStatus type for use with
+// apply.
+func SettingsProfileStatus() *SettingsProfileStatusApplyConfiguration {
+	return &SettingsProfileStatusApplyConfiguration{}
+}
+
+// WithOwnerID sets the OwnerID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OwnerID field is set to the value of the last call.
+func (b *SettingsProfileStatusApplyConfiguration) WithOwnerID(value string) *SettingsProfileStatusApplyConfiguration {
+	b.OwnerID = &value
+	return b
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *SettingsProfileStatusApplyConfiguration) WithState(value longhornv1beta2.SettingsProfileState) *SettingsProfileStatusApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *SettingsProfileStatusApplyConfiguration) WithConditions(values ...*ConditionApplyConfiguration) *SettingsProfileStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithAppliedGeneration sets the AppliedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AppliedGeneration field is set to the value of the last call.
+func (b *SettingsProfileStatusApplyConfiguration) WithAppliedGeneration(value int64) *SettingsProfileStatusApplyConfiguration {
+	b.AppliedGeneration = &value
+	return b
+}
+
+// WithLastAppliedAt sets the LastAppliedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastAppliedAt field is set to the value of the last call.
+func (b *SettingsProfileStatusApplyConfiguration) WithLastAppliedAt(value v1.Time) *SettingsProfileStatusApplyConfiguration {
+	b.LastAppliedAt = &value
+	return b
+}
+
+// WithDriftedSettings adds the given value to the DriftedSettings field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DriftedSettings field.
+func (b *SettingsProfileStatusApplyConfiguration) WithDriftedSettings(values ...*SettingsProfileDriftApplyConfiguration) *SettingsProfileStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithDriftedSettings")
+		}
+		b.DriftedSettings = append(b.DriftedSettings, *values[i])
+	}
+	return b
+}
@@ -21,7 +21,9 @@ package v1beta2
 // SettingStatusApplyConfiguration represents a declarative configuration of the SettingStatus type for use
 // with apply.
 type SettingStatusApplyConfiguration struct {
-	Applied *bool `json:"applied,omitempty"`
+	Applied    *bool                                   `json:"applied,omitempty"`
+	History    []SettingHistoryEntryApplyConfiguration `json:"history,omitempty"`
+	Conditions []ConditionApplyConfiguration           `json:"conditions,omitempty"`
 }
 
 // SettingStatusApplyConfiguration constructs a declarative configuration of the SettingStatus type for use with
@@ -37,3 +39,29 @@ func (b *SettingStatusApplyConfiguration) WithApplied(value bool) *SettingStatus
 	b.Applied = &value
 	return b
 }
+
+// WithHistory adds the given value to the History field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the History field.
+func (b *SettingStatusApplyConfiguration) WithHistory(values ...*SettingHistoryEntryApplyConfiguration) *SettingStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithHistory")
+		}
+		b.History = append(b.History, *values[i])
+	}
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *SettingStatusApplyConfiguration) WithConditions(values ...*ConditionApplyConfiguration) *SettingStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
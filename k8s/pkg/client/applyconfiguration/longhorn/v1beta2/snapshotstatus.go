@@ -21,18 +21,19 @@ package v1beta2
 // SnapshotStatusApplyConfiguration represents a declarative configuration of the SnapshotStatus type for use
 // with apply.
 type SnapshotStatusApplyConfiguration struct {
-	Parent       *string           `json:"parent,omitempty"`
-	Children     map[string]bool   `json:"children,omitempty"`
-	MarkRemoved  *bool             `json:"markRemoved,omitempty"`
-	UserCreated  *bool             `json:"userCreated,omitempty"`
-	CreationTime *string           `json:"creationTime,omitempty"`
-	Size         *int64            `json:"size,omitempty"`
-	Labels       map[string]string `json:"labels,omitempty"`
-	OwnerID      *string           `json:"ownerID,omitempty"`
-	Error        *string           `json:"error,omitempty"`
-	RestoreSize  *int64            `json:"restoreSize,omitempty"`
-	ReadyToUse   *bool             `json:"readyToUse,omitempty"`
-	Checksum     *string           `json:"checksum,omitempty"`
+	Parent             *string           `json:"parent,omitempty"`
+	Children           map[string]bool   `json:"children,omitempty"`
+	MarkRemoved        *bool             `json:"markRemoved,omitempty"`
+	UserCreated        *bool             `json:"userCreated,omitempty"`
+	CreationTime       *string           `json:"creationTime,omitempty"`
+	Size               *int64            `json:"size,omitempty"`
+	Labels             map[string]string `json:"labels,omitempty"`
+	OwnerID            *string           `json:"ownerID,omitempty"`
+	Error              *string           `json:"error,omitempty"`
+	RestoreSize        *int64            `json:"restoreSize,omitempty"`
+	ReadyToUse         *bool             `json:"readyToUse,omitempty"`
+	Checksum           *string           `json:"checksum,omitempty"`
+	DataIntegrityError *string           `json:"dataIntegrityError,omitempty"`
 }
 
 // SnapshotStatusApplyConfiguration constructs a declarative configuration of the SnapshotStatus type for use with
@@ -148,3 +149,11 @@ func (b *SnapshotStatusApplyConfiguration) WithChecksum(value string) *SnapshotS
 	b.Checksum = &value
 	return b
 }
+
+// WithDataIntegrityError sets the DataIntegrityError field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DataIntegrityError field is set to the value of the last call.
+func (b *SnapshotStatusApplyConfiguration) WithDataIntegrityError(value string) *SnapshotStatusApplyConfiguration {
+	b.DataIntegrityError = &value
+	return b
+}
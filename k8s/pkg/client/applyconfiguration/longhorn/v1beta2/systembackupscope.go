@@ -0,0 +1,41 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// SystemBackupScopeApplyConfiguration represents a declarative configuration of the SystemBackupScope type for use
+// with apply.
+type SystemBackupScopeApplyConfiguration struct {
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// SystemBackupScopeApplyConfiguration constructs a declarative configuration of the SystemBackupScope type for use with
+// apply.
+func SystemBackupScope() *SystemBackupScopeApplyConfiguration {
+	return &SystemBackupScopeApplyConfiguration{}
+}
+
+// WithNamespaces adds the given value to the Namespaces field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Namespaces field.
+func (b *SystemBackupScopeApplyConfiguration) WithNamespaces(values ...string) *SystemBackupScopeApplyConfiguration {
+	for i := range values {
+		b.Namespaces = append(b.Namespaces, values[i])
+	}
+	return b
+}
@@ -26,6 +26,8 @@ import (
 // with apply.
 type SystemBackupSpecApplyConfiguration struct {
 	VolumeBackupPolicy *longhornv1beta2.SystemBackupCreateVolumeBackupPolicy `json:"volumeBackupPolicy,omitempty"`
+	Scope              *SystemBackupScopeApplyConfiguration                  `json:"scope,omitempty"`
+	BaseSystemBackup   *string                                               `json:"baseSystemBackup,omitempty"`
 }
 
 // SystemBackupSpecApplyConfiguration constructs a declarative configuration of the SystemBackupSpec type for use with
@@ -41,3 +43,19 @@ func (b *SystemBackupSpecApplyConfiguration) WithVolumeBackupPolicy(value longho
 	b.VolumeBackupPolicy = &value
 	return b
 }
+
+// WithScope sets the Scope field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Scope field is set to the value of the last call.
+func (b *SystemBackupSpecApplyConfiguration) WithScope(value *SystemBackupScopeApplyConfiguration) *SystemBackupSpecApplyConfiguration {
+	b.Scope = value
+	return b
+}
+
+// WithBaseSystemBackup sets the BaseSystemBackup field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BaseSystemBackup field is set to the value of the last call.
+func (b *SystemBackupSpecApplyConfiguration) WithBaseSystemBackup(value string) *SystemBackupSpecApplyConfiguration {
+	b.BaseSystemBackup = &value
+	return b
+}
@@ -0,0 +1,90 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// SystemRestoreRemapApplyConfiguration represents a declarative configuration of the SystemRestoreRemap type for use
+// with apply.
+type SystemRestoreRemapApplyConfiguration struct {
+	NodeNames         map[string]string                                              `json:"nodeNames,omitempty"`
+	StorageClassNames map[string]string                                              `json:"storageClassNames,omitempty"`
+	Namespaces        map[string]string                                              `json:"namespaces,omitempty"`
+	BackupTargets     map[string]SystemRestoreBackupTargetOverrideApplyConfiguration `json:"backupTargets,omitempty"`
+}
+
+// SystemRestoreRemapApplyConfiguration constructs a declarative configuration of the SystemRestoreRemap type for use with
+// apply.
+func SystemRestoreRemap() *SystemRestoreRemapApplyConfiguration {
+	return &SystemRestoreRemapApplyConfiguration{}
+}
+
+// WithNodeNames puts the entries into the NodeNames field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the NodeNames field,
+// overwriting an existing map entries in NodeNames field with the same key.
+func (b *SystemRestoreRemapApplyConfiguration) WithNodeNames(entries map[string]string) *SystemRestoreRemapApplyConfiguration {
+	if b.NodeNames == nil && len(entries) > 0 {
+		b.NodeNames = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.NodeNames[k] = v
+	}
+	return b
+}
+
+// WithStorageClassNames puts the entries into the StorageClassNames field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the StorageClassNames field,
+// overwriting an existing map entries in StorageClassNames field with the same key.
+func (b *SystemRestoreRemapApplyConfiguration) WithStorageClassNames(entries map[string]string) *SystemRestoreRemapApplyConfiguration {
+	if b.StorageClassNames == nil && len(entries) > 0 {
+		b.StorageClassNames = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.StorageClassNames[k] = v
+	}
+	return b
+}
+
+// WithNamespaces puts the entries into the Namespaces field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Namespaces field,
+// overwriting an existing map entries in Namespaces field with the same key.
+func (b *SystemRestoreRemapApplyConfiguration) WithNamespaces(entries map[string]string) *SystemRestoreRemapApplyConfiguration {
+	if b.Namespaces == nil && len(entries) > 0 {
+		b.Namespaces = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Namespaces[k] = v
+	}
+	return b
+}
+
+// WithBackupTargets puts the entries into the BackupTargets field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the BackupTargets field,
+// overwriting an existing map entries in BackupTargets field with the same key.
+func (b *SystemRestoreRemapApplyConfiguration) WithBackupTargets(entries map[string]SystemRestoreBackupTargetOverrideApplyConfiguration) *SystemRestoreRemapApplyConfiguration {
+	if b.BackupTargets == nil && len(entries) > 0 {
+		b.BackupTargets = make(map[string]SystemRestoreBackupTargetOverrideApplyConfiguration, len(entries))
+	}
+	for k, v := range entries {
+		b.BackupTargets[k] = v
+	}
+	return b
+}
@@ -0,0 +1,79 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// SystemRestoreResourceChangeApplyConfiguration represents a declarative configuration of the SystemRestoreResourceChange type for use
+// with apply.
+type SystemRestoreResourceChangeApplyConfiguration struct {
+	Kind      *string                                      `json:"kind,omitempty"`
+	Namespace *string                                      `json:"namespace,omitempty"`
+	Name      *string                                      `json:"name,omitempty"`
+	Action    *longhornv1beta2.SystemRestoreResourceAction `json:"action,omitempty"`
+	Reason    *string                                      `json:"reason,omitempty"`
+}
+
+// SystemRestoreResourceChangeApplyConfiguration constructs a declarative configuration of the SystemRestoreResourceChange type for use with
+// apply.
+func SystemRestoreResourceChange() *SystemRestoreResourceChangeApplyConfiguration {
+	return &SystemRestoreResourceChangeApplyConfiguration{}
+}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *SystemRestoreResourceChangeApplyConfiguration) WithKind(value string) *SystemRestoreResourceChangeApplyConfiguration {
+	b.Kind = &value
+	return b
+}
+
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Namespace field is set to the value of the last call.
+func (b *SystemRestoreResourceChangeApplyConfiguration) WithNamespace(value string) *SystemRestoreResourceChangeApplyConfiguration {
+	b.Namespace = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *SystemRestoreResourceChangeApplyConfiguration) WithName(value string) *SystemRestoreResourceChangeApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithAction sets the Action field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Action field is set to the value of the last call.
+func (b *SystemRestoreResourceChangeApplyConfiguration) WithAction(value longhornv1beta2.SystemRestoreResourceAction) *SystemRestoreResourceChangeApplyConfiguration {
+	b.Action = &value
+	return b
+}
+
+// WithReason sets the Reason field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Reason field is set to the value of the last call.
+func (b *SystemRestoreResourceChangeApplyConfiguration) WithReason(value string) *SystemRestoreResourceChangeApplyConfiguration {
+	b.Reason = &value
+	return b
+}
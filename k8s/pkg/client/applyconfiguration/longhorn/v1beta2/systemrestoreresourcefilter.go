@@ -0,0 +1,65 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// SystemRestoreResourceFilterApplyConfiguration represents a declarative configuration of the SystemRestoreResourceFilter type for use
+// with apply.
+type SystemRestoreResourceFilterApplyConfiguration struct {
+	Kinds          []string                            `json:"kinds,omitempty"`
+	Namespaces     []string                            `json:"namespaces,omitempty"`
+	VolumeSelector *v1.LabelSelectorApplyConfiguration `json:"volumeSelector,omitempty"`
+}
+
+// SystemRestoreResourceFilterApplyConfiguration constructs a declarative configuration of the SystemRestoreResourceFilter type for use with
+// apply.
+func SystemRestoreResourceFilter() *SystemRestoreResourceFilterApplyConfiguration {
+	return &SystemRestoreResourceFilterApplyConfiguration{}
+}
+
+// WithKinds adds the given value to the Kinds field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Kinds field.
+func (b *SystemRestoreResourceFilterApplyConfiguration) WithKinds(values ...string) *SystemRestoreResourceFilterApplyConfiguration {
+	for i := range values {
+		b.Kinds = append(b.Kinds, values[i])
+	}
+	return b
+}
+
+// WithNamespaces adds the given value to the Namespaces field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Namespaces field.
+func (b *SystemRestoreResourceFilterApplyConfiguration) WithNamespaces(values ...string) *SystemRestoreResourceFilterApplyConfiguration {
+	for i := range values {
+		b.Namespaces = append(b.Namespaces, values[i])
+	}
+	return b
+}
+
+// WithVolumeSelector sets the VolumeSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeSelector field is set to the value of the last call.
+func (b *SystemRestoreResourceFilterApplyConfiguration) WithVolumeSelector(value *v1.LabelSelectorApplyConfiguration) *SystemRestoreResourceFilterApplyConfiguration {
+	b.VolumeSelector = value
+	return b
+}
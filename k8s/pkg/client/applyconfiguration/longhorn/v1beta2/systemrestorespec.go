@@ -21,7 +21,11 @@ package v1beta2
 // SystemRestoreSpecApplyConfiguration represents a declarative configuration of the SystemRestoreSpec type for use
 // with apply.
 type SystemRestoreSpecApplyConfiguration struct {
-	SystemBackup *string `json:"systemBackup,omitempty"`
+	SystemBackup *string                                        `json:"systemBackup,omitempty"`
+	Include      *SystemRestoreResourceFilterApplyConfiguration `json:"include,omitempty"`
+	Exclude      *SystemRestoreResourceFilterApplyConfiguration `json:"exclude,omitempty"`
+	Remap        *SystemRestoreRemapApplyConfiguration          `json:"remap,omitempty"`
+	DryRun       *bool                                          `json:"dryRun,omitempty"`
 }
 
 // SystemRestoreSpecApplyConfiguration constructs a declarative configuration of the SystemRestoreSpec type for use with
@@ -37,3 +41,35 @@ func (b *SystemRestoreSpecApplyConfiguration) WithSystemBackup(value string) *Sy
 	b.SystemBackup = &value
 	return b
 }
+
+// WithInclude sets the Include field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Include field is set to the value of the last call.
+func (b *SystemRestoreSpecApplyConfiguration) WithInclude(value *SystemRestoreResourceFilterApplyConfiguration) *SystemRestoreSpecApplyConfiguration {
+	b.Include = value
+	return b
+}
+
+// WithExclude sets the Exclude field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Exclude field is set to the value of the last call.
+func (b *SystemRestoreSpecApplyConfiguration) WithExclude(value *SystemRestoreResourceFilterApplyConfiguration) *SystemRestoreSpecApplyConfiguration {
+	b.Exclude = value
+	return b
+}
+
+// WithRemap sets the Remap field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Remap field is set to the value of the last call.
+func (b *SystemRestoreSpecApplyConfiguration) WithRemap(value *SystemRestoreRemapApplyConfiguration) *SystemRestoreSpecApplyConfiguration {
+	b.Remap = value
+	return b
+}
+
+// WithDryRun sets the DryRun field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DryRun field is set to the value of the last call.
+func (b *SystemRestoreSpecApplyConfiguration) WithDryRun(value bool) *SystemRestoreSpecApplyConfiguration {
+	b.DryRun = &value
+	return b
+}
@@ -25,10 +25,11 @@ import (
 // SystemRestoreStatusApplyConfiguration represents a declarative configuration of the SystemRestoreStatus type for use
 // with apply.
 type SystemRestoreStatusApplyConfiguration struct {
-	OwnerID    *string                             `json:"ownerID,omitempty"`
-	State      *longhornv1beta2.SystemRestoreState `json:"state,omitempty"`
-	SourceURL  *string                             `json:"sourceURL,omitempty"`
-	Conditions []ConditionApplyConfiguration       `json:"conditions,omitempty"`
+	OwnerID      *string                                         `json:"ownerID,omitempty"`
+	State        *longhornv1beta2.SystemRestoreState             `json:"state,omitempty"`
+	SourceURL    *string                                         `json:"sourceURL,omitempty"`
+	Conditions   []ConditionApplyConfiguration                   `json:"conditions,omitempty"`
+	DryRunReport []SystemRestoreResourceChangeApplyConfiguration `json:"dryRunReport,omitempty"`
 }
 
 // SystemRestoreStatusApplyConfiguration constructs a declarative configuration of the SystemRestoreStatus type for use with
@@ -73,3 +74,16 @@ func (b *SystemRestoreStatusApplyConfiguration) WithConditions(values ...*Condit
 	}
 	return b
 }
+
+// WithDryRunReport adds the given value to the DryRunReport field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DryRunReport field.
+func (b *SystemRestoreStatusApplyConfiguration) WithDryRunReport(values ...*SystemRestoreResourceChangeApplyConfiguration) *SystemRestoreStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithDryRunReport")
+		}
+		b.DryRunReport = append(b.DryRunReport, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,75 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// VolumeIOQoSApplyConfiguration represents a declarative configuration of the VolumeIOQoS type for use
+// with apply.
+type VolumeIOQoSApplyConfiguration struct {
+	MaxReadIOPS       *int64 `json:"maxReadIOPS,omitempty"`
+	MaxWriteIOPS      *int64 `json:"maxWriteIOPS,omitempty"`
+	MaxReadBandwidth  *int64 `json:"maxReadBandwidth,omitempty"`
+	MaxWriteBandwidth *int64 `json:"maxWriteBandwidth,omitempty"`
+	BurstSeconds      *int64 `json:"burstSeconds,omitempty"`
+}
+
+// VolumeIOQoSApplyConfiguration constructs a declarative configuration of the VolumeIOQoS type for use with
+// apply.
+func VolumeIOQoS() *VolumeIOQoSApplyConfiguration {
+	return &VolumeIOQoSApplyConfiguration{}
+}
+
+// WithMaxReadIOPS sets the MaxReadIOPS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxReadIOPS field is set to the value of the last call.
+func (b *VolumeIOQoSApplyConfiguration) WithMaxReadIOPS(value int64) *VolumeIOQoSApplyConfiguration {
+	b.MaxReadIOPS = &value
+	return b
+}
+
+// WithMaxWriteIOPS sets the MaxWriteIOPS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxWriteIOPS field is set to the value of the last call.
+func (b *VolumeIOQoSApplyConfiguration) WithMaxWriteIOPS(value int64) *VolumeIOQoSApplyConfiguration {
+	b.MaxWriteIOPS = &value
+	return b
+}
+
+// WithMaxReadBandwidth sets the MaxReadBandwidth field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxReadBandwidth field is set to the value of the last call.
+func (b *VolumeIOQoSApplyConfiguration) WithMaxReadBandwidth(value int64) *VolumeIOQoSApplyConfiguration {
+	b.MaxReadBandwidth = &value
+	return b
+}
+
+// WithMaxWriteBandwidth sets the MaxWriteBandwidth field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxWriteBandwidth field is set to the value of the last call.
+func (b *VolumeIOQoSApplyConfiguration) WithMaxWriteBandwidth(value int64) *VolumeIOQoSApplyConfiguration {
+	b.MaxWriteBandwidth = &value
+	return b
+}
+
+// WithBurstSeconds sets the BurstSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BurstSeconds field is set to the value of the last call.
+func (b *VolumeIOQoSApplyConfiguration) WithBurstSeconds(value int64) *VolumeIOQoSApplyConfiguration {
+	b.BurstSeconds = &value
+	return b
+}
@@ -58,6 +58,7 @@ type VolumeSpecApplyConfiguration struct {
 	SnapshotMaxSize             *int64                                         `json:"snapshotMaxSize,omitempty"`
 	FreezeFilesystemForSnapshot *longhornv1beta2.FreezeFilesystemForSnapshot   `json:"freezeFilesystemForSnapshot,omitempty"`
 	BackupTargetName            *string                                        `json:"backupTargetName,omitempty"`
+	IOQoS                       *VolumeIOQoSApplyConfiguration                 `json:"ioQoS,omitempty"`
 }
 
 // VolumeSpecApplyConfiguration constructs a declarative configuration of the VolumeSpec type for use with
@@ -333,3 +334,11 @@ func (b *VolumeSpecApplyConfiguration) WithBackupTargetName(value string) *Volum
 	b.BackupTargetName = &value
 	return b
 }
+
+// WithIOQoS sets the IOQoS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IOQoS field is set to the value of the last call.
+func (b *VolumeSpecApplyConfiguration) WithIOQoS(value *VolumeIOQoSApplyConfiguration) *VolumeSpecApplyConfiguration {
+	b.IOQoS = value
+	return b
+}
@@ -46,6 +46,7 @@ type VolumeStatusApplyConfiguration struct {
 	LastDegradedAt         *string                              `json:"lastDegradedAt,omitempty"`
 	ShareEndpoint          *string                              `json:"shareEndpoint,omitempty"`
 	ShareState             *longhornv1beta2.ShareManagerState   `json:"shareState,omitempty"`
+	HealthScore            *int                                 `json:"healthScore,omitempty"`
 }
 
 // VolumeStatusApplyConfiguration constructs a declarative configuration of the VolumeStatus type for use with
@@ -226,3 +227,11 @@ func (b *VolumeStatusApplyConfiguration) WithShareState(value longhornv1beta2.Sh
 	b.ShareState = &value
 	return b
 }
+
+// WithHealthScore sets the HealthScore field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HealthScore field is set to the value of the last call.
+func (b *VolumeStatusApplyConfiguration) WithHealthScore(value int) *VolumeStatusApplyConfiguration {
+	b.HealthScore = &value
+	return b
+}
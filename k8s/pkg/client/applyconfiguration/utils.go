@@ -164,6 +164,16 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &longhornv1beta1.WorkloadStatusApplyConfiguration{}
 
 		// Group=longhorn.io, Version=v1beta2
+	case v1beta2.SchemeGroupVersion.WithKind("Alert"):
+		return &longhornv1beta2.AlertApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("AlertReceiver"):
+		return &longhornv1beta2.AlertReceiverApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("AlertRule"):
+		return &longhornv1beta2.AlertRuleApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("AlertRuleSpec"):
+		return &longhornv1beta2.AlertRuleSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("AlertRuleStatus"):
+		return &longhornv1beta2.AlertRuleStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("AttachmentTicket"):
 		return &longhornv1beta2.AttachmentTicketApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("AttachmentTicketStatus"):
@@ -208,16 +218,24 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &longhornv1beta2.BackupStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("BackupTarget"):
 		return &longhornv1beta2.BackupTargetApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("BackupTargetGarbageCollectionStatus"):
+		return &longhornv1beta2.BackupTargetGarbageCollectionStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("BackupTargetSpec"):
 		return &longhornv1beta2.BackupTargetSpecApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("BackupTargetStatus"):
 		return &longhornv1beta2.BackupTargetStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("BackupTargetVolumeStorage"):
+		return &longhornv1beta2.BackupTargetVolumeStorageApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("BackupVolume"):
 		return &longhornv1beta2.BackupVolumeApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("BackupVolumeSpec"):
 		return &longhornv1beta2.BackupVolumeSpecApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("BackupVolumeStatus"):
 		return &longhornv1beta2.BackupVolumeStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("BandwidthLimit"):
+		return &longhornv1beta2.BandwidthLimitApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("BandwidthLimitWindow"):
+		return &longhornv1beta2.BandwidthLimitWindowApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("Condition"):
 		return &longhornv1beta2.ConditionApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("DataEngineSpec"):
@@ -286,6 +304,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &longhornv1beta2.RecurringJobStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("Replica"):
 		return &longhornv1beta2.ReplicaApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("ReplicaIOMetrics"):
+		return &longhornv1beta2.ReplicaIOMetricsApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ReplicaSpec"):
 		return &longhornv1beta2.ReplicaSpecApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ReplicaStatus"):
@@ -294,6 +314,16 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &longhornv1beta2.RestoreStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("Setting"):
 		return &longhornv1beta2.SettingApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("SettingHistoryEntry"):
+		return &longhornv1beta2.SettingHistoryEntryApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("SettingsProfile"):
+		return &longhornv1beta2.SettingsProfileApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("SettingsProfileDrift"):
+		return &longhornv1beta2.SettingsProfileDriftApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("SettingsProfileSpec"):
+		return &longhornv1beta2.SettingsProfileSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("SettingsProfileStatus"):
+		return &longhornv1beta2.SettingsProfileStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("SettingStatus"):
 		return &longhornv1beta2.SettingStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ShareManager"):
@@ -322,12 +352,22 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &longhornv1beta2.SupportBundleStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("SystemBackup"):
 		return &longhornv1beta2.SystemBackupApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("SystemBackupScope"):
+		return &longhornv1beta2.SystemBackupScopeApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("SystemBackupSpec"):
 		return &longhornv1beta2.SystemBackupSpecApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("SystemBackupStatus"):
 		return &longhornv1beta2.SystemBackupStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("SystemRestore"):
 		return &longhornv1beta2.SystemRestoreApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("SystemRestoreBackupTargetOverride"):
+		return &longhornv1beta2.SystemRestoreBackupTargetOverrideApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("SystemRestoreRemap"):
+		return &longhornv1beta2.SystemRestoreRemapApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("SystemRestoreResourceChange"):
+		return &longhornv1beta2.SystemRestoreResourceChangeApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("SystemRestoreResourceFilter"):
+		return &longhornv1beta2.SystemRestoreResourceFilterApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("SystemRestoreSpec"):
 		return &longhornv1beta2.SystemRestoreSpecApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("SystemRestoreStatus"):
@@ -346,6 +386,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &longhornv1beta2.VolumeAttachmentStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("VolumeCloneStatus"):
 		return &longhornv1beta2.VolumeCloneStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("VolumeIOQoS"):
+		return &longhornv1beta2.VolumeIOQoSApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("VolumeSpec"):
 		return &longhornv1beta2.VolumeSpecApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("VolumeStatus"):
@@ -28,6 +28,10 @@ type FakeLonghornV1beta2 struct {
 	*testing.Fake
 }
 
+func (c *FakeLonghornV1beta2) AlertRules(namespace string) v1beta2.AlertRuleInterface {
+	return newFakeAlertRules(c, namespace)
+}
+
 func (c *FakeLonghornV1beta2) BackingImages(namespace string) v1beta2.BackingImageInterface {
 	return newFakeBackingImages(c, namespace)
 }
@@ -88,6 +92,10 @@ func (c *FakeLonghornV1beta2) Settings(namespace string) v1beta2.SettingInterfac
 	return newFakeSettings(c, namespace)
 }
 
+func (c *FakeLonghornV1beta2) SettingsProfiles(namespace string) v1beta2.SettingsProfileInterface {
+	return newFakeSettingsProfiles(c, namespace)
+}
+
 func (c *FakeLonghornV1beta2) ShareManagers(namespace string) v1beta2.ShareManagerInterface {
 	return newFakeShareManagers(c, namespace)
 }
@@ -18,6 +18,8 @@ limitations under the License.
 
 package v1beta2
 
+type AlertRuleExpansion interface{}
+
 type BackingImageExpansion interface{}
 
 type BackingImageDataSourceExpansion interface{}
@@ -48,6 +50,8 @@ type ReplicaExpansion interface{}
 
 type SettingExpansion interface{}
 
+type SettingsProfileExpansion interface{}
+
 type ShareManagerExpansion interface{}
 
 type SnapshotExpansion interface{}
@@ -28,6 +28,7 @@ import (
 
 type LonghornV1beta2Interface interface {
 	RESTClient() rest.Interface
+	AlertRulesGetter
 	BackingImagesGetter
 	BackingImageDataSourcesGetter
 	BackingImageManagersGetter
@@ -43,6 +44,7 @@ type LonghornV1beta2Interface interface {
 	RecurringJobsGetter
 	ReplicasGetter
 	SettingsGetter
+	SettingsProfilesGetter
 	ShareManagersGetter
 	SnapshotsGetter
 	SupportBundlesGetter
@@ -57,6 +59,10 @@ type LonghornV1beta2Client struct {
 	restClient rest.Interface
 }
 
+func (c *LonghornV1beta2Client) AlertRules(namespace string) AlertRuleInterface {
+	return newAlertRules(c, namespace)
+}
+
 func (c *LonghornV1beta2Client) BackingImages(namespace string) BackingImageInterface {
 	return newBackingImages(c, namespace)
 }
@@ -117,6 +123,10 @@ func (c *LonghornV1beta2Client) Settings(namespace string) SettingInterface {
 	return newSettings(c, namespace)
 }
 
+func (c *LonghornV1beta2Client) SettingsProfiles(namespace string) SettingsProfileInterface {
+	return newSettingsProfiles(c, namespace)
+}
+
 func (c *LonghornV1beta2Client) ShareManagers(namespace string) ShareManagerInterface {
 	return newShareManagers(c, namespace)
 }
@@ -86,6 +86,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Longhorn().V1beta1().Volumes().Informer()}, nil
 
 		// Group=longhorn.io, Version=v1beta2
+	case v1beta2.SchemeGroupVersion.WithResource("alertrules"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Longhorn().V1beta2().AlertRules().Informer()}, nil
 	case v1beta2.SchemeGroupVersion.WithResource("backingimages"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Longhorn().V1beta2().BackingImages().Informer()}, nil
 	case v1beta2.SchemeGroupVersion.WithResource("backingimagedatasources"):
@@ -116,6 +118,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Longhorn().V1beta2().Replicas().Informer()}, nil
 	case v1beta2.SchemeGroupVersion.WithResource("settings"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Longhorn().V1beta2().Settings().Informer()}, nil
+	case v1beta2.SchemeGroupVersion.WithResource("settingsprofiles"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Longhorn().V1beta2().SettingsProfiles().Informer()}, nil
 	case v1beta2.SchemeGroupVersion.WithResource("sharemanagers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Longhorn().V1beta2().ShareManagers().Informer()}, nil
 	case v1beta2.SchemeGroupVersion.WithResource("snapshots"):
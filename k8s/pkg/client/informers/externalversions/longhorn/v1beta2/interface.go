@@ -24,6 +24,8 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// AlertRules returns a AlertRuleInformer.
+	AlertRules() AlertRuleInformer
 	// BackingImages returns a BackingImageInformer.
 	BackingImages() BackingImageInformer
 	// BackingImageDataSources returns a BackingImageDataSourceInformer.
@@ -54,6 +56,8 @@ type Interface interface {
 	Replicas() ReplicaInformer
 	// Settings returns a SettingInformer.
 	Settings() SettingInformer
+	// SettingsProfiles returns a SettingsProfileInformer.
+	SettingsProfiles() SettingsProfileInformer
 	// ShareManagers returns a ShareManagerInformer.
 	ShareManagers() ShareManagerInformer
 	// Snapshots returns a SnapshotInformer.
@@ -81,6 +85,11 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// AlertRules returns a AlertRuleInformer.
+func (v *version) AlertRules() AlertRuleInformer {
+	return &alertRuleInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // BackingImages returns a BackingImageInformer.
 func (v *version) BackingImages() BackingImageInformer {
 	return &backingImageInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -156,6 +165,11 @@ func (v *version) Settings() SettingInformer {
 	return &settingInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// SettingsProfiles returns a SettingsProfileInformer.
+func (v *version) SettingsProfiles() SettingsProfileInformer {
+	return &settingsProfileInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // ShareManagers returns a ShareManagerInformer.
 func (v *version) ShareManagers() ShareManagerInformer {
 	return &shareManagerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
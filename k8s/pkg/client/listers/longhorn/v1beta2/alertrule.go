@@ -0,0 +1,70 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// AlertRuleLister helps list AlertRules.
+// All objects returned here must be treated as read-only.
+type AlertRuleLister interface {
+	// List lists all AlertRules in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*longhornv1beta2.AlertRule, err error)
+	// AlertRules returns an object that can list and get AlertRules.
+	AlertRules(namespace string) AlertRuleNamespaceLister
+	AlertRuleListerExpansion
+}
+
+// alertRuleLister implements the AlertRuleLister interface.
+type alertRuleLister struct {
+	listers.ResourceIndexer[*longhornv1beta2.AlertRule]
+}
+
+// NewAlertRuleLister returns a new AlertRuleLister.
+func NewAlertRuleLister(indexer cache.Indexer) AlertRuleLister {
+	return &alertRuleLister{listers.New[*longhornv1beta2.AlertRule](indexer, longhornv1beta2.Resource("alertrule"))}
+}
+
+// AlertRules returns an object that can list and get AlertRules.
+func (s *alertRuleLister) AlertRules(namespace string) AlertRuleNamespaceLister {
+	return alertRuleNamespaceLister{listers.NewNamespaced[*longhornv1beta2.AlertRule](s.ResourceIndexer, namespace)}
+}
+
+// AlertRuleNamespaceLister helps list and get AlertRules.
+// All objects returned here must be treated as read-only.
+type AlertRuleNamespaceLister interface {
+	// List lists all AlertRules in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*longhornv1beta2.AlertRule, err error)
+	// Get retrieves the AlertRule from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*longhornv1beta2.AlertRule, error)
+	AlertRuleNamespaceListerExpansion
+}
+
+// alertRuleNamespaceLister implements the AlertRuleNamespaceLister
+// interface.
+type alertRuleNamespaceLister struct {
+	listers.ResourceIndexer[*longhornv1beta2.AlertRule]
+}
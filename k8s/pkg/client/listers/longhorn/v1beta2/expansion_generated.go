@@ -18,6 +18,14 @@ limitations under the License.
 
 package v1beta2
 
+// AlertRuleListerExpansion allows custom methods to be added to
+// AlertRuleLister.
+type AlertRuleListerExpansion interface{}
+
+// AlertRuleNamespaceListerExpansion allows custom methods to be added to
+// AlertRuleNamespaceLister.
+type AlertRuleNamespaceListerExpansion interface{}
+
 // BackingImageListerExpansion allows custom methods to be added to
 // BackingImageLister.
 type BackingImageListerExpansion interface{}
@@ -138,6 +146,14 @@ type SettingListerExpansion interface{}
 // SettingNamespaceLister.
 type SettingNamespaceListerExpansion interface{}
 
+// SettingsProfileListerExpansion allows custom methods to be added to
+// SettingsProfileLister.
+type SettingsProfileListerExpansion interface{}
+
+// SettingsProfileNamespaceListerExpansion allows custom methods to be added to
+// SettingsProfileNamespaceLister.
+type SettingsProfileNamespaceListerExpansion interface{}
+
 // ShareManagerListerExpansion allows custom methods to be added to
 // ShareManagerLister.
 type ShareManagerListerExpansion interface{}
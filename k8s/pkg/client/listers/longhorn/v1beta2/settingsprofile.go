@@ -0,0 +1,70 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// SettingsProfileLister helps list SettingsProfiles.
+// All objects returned here must be treated as read-only.
+type SettingsProfileLister interface {
+	// List lists all SettingsProfiles in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*longhornv1beta2.SettingsProfile, err error)
+	// SettingsProfiles returns an object that can list and get SettingsProfiles.
+	SettingsProfiles(namespace string) SettingsProfileNamespaceLister
+	SettingsProfileListerExpansion
+}
+
+// settingsProfileLister implements the SettingsProfileLister interface.
+type settingsProfileLister struct {
+	listers.ResourceIndexer[*longhornv1beta2.SettingsProfile]
+}
+
+// NewSettingsProfileLister returns a new SettingsProfileLister.
+func NewSettingsProfileLister(indexer cache.Indexer) SettingsProfileLister {
+	return &settingsProfileLister{listers.New[*longhornv1beta2.SettingsProfile](indexer, longhornv1beta2.Resource("settingsprofile"))}
+}
+
+// SettingsProfiles returns an object that can list and get SettingsProfiles.
+func (s *settingsProfileLister) SettingsProfiles(namespace string) SettingsProfileNamespaceLister {
+	return settingsProfileNamespaceLister{listers.NewNamespaced[*longhornv1beta2.SettingsProfile](s.ResourceIndexer, namespace)}
+}
+
+// SettingsProfileNamespaceLister helps list and get SettingsProfiles.
+// All objects returned here must be treated as read-only.
+type SettingsProfileNamespaceLister interface {
+	// List lists all SettingsProfiles in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*longhornv1beta2.SettingsProfile, err error)
+	// Get retrieves the SettingsProfile from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*longhornv1beta2.SettingsProfile, error)
+	SettingsProfileNamespaceListerExpansion
+}
+
+// settingsProfileNamespaceLister implements the SettingsProfileNamespaceLister
+// interface.
+type settingsProfileNamespaceLister struct {
+	listers.ResourceIndexer[*longhornv1beta2.SettingsProfile]
+}
@@ -64,9 +64,11 @@ func main() {
 		app.DeployDriverCmd(),
 		app.CSICommand(),
 		app.PreUpgradeCmd(),
+		app.UpgradeReadinessCmd(),
 		app.PostUpgradeCmd(),
 		app.UninstallCmd(),
 		app.SystemRolloutCmd(),
+		app.BackupStoreCmd(),
 		// TODO: Remove MigrateForPre070VolumesCmd() after v0.8.1
 		app.MigrateForPre070VolumesCmd(),
 	}
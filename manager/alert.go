@@ -0,0 +1,25 @@
+package manager
+
+import (
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+func (m *VolumeManager) ListAlertRulesSorted() ([]*longhorn.AlertRule, error) {
+	alertRules, err := m.ds.ListAlertRules()
+	if err != nil {
+		return []*longhorn.AlertRule{}, err
+	}
+
+	alertRuleNames, err := util.SortKeys(alertRules)
+	if err != nil {
+		return []*longhorn.AlertRule{}, err
+	}
+
+	sortedAlertRules := make([]*longhorn.AlertRule, len(alertRuleNames))
+	for i, name := range alertRuleNames {
+		sortedAlertRules[i] = alertRules[name]
+	}
+	return sortedAlertRules, nil
+}
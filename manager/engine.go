@@ -2,6 +2,7 @@ package manager
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	"github.com/pkg/errors"
@@ -10,6 +11,8 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	etypes "github.com/longhorn/longhorn-engine/pkg/types"
+
 	"github.com/longhorn/longhorn-manager/datastore"
 	"github.com/longhorn/longhorn-manager/engineapi"
 	"github.com/longhorn/longhorn-manager/types"
@@ -82,6 +85,43 @@ func (m *VolumeManager) GetSnapshotInfo(snapshotName, volumeName string) (*longh
 	return snapshot, nil
 }
 
+// GetSnapshotChangedBlocks returns the extents changed between the snapshot fromSnapshotName and its descendant
+// toSnapshotName. The volume head is used if toSnapshotName is empty.
+func (m *VolumeManager) GetSnapshotChangedBlocks(volumeName, fromSnapshotName, toSnapshotName string) ([]engineapi.SnapshotExtent, error) {
+	if volumeName == "" || fromSnapshotName == "" {
+		return nil, fmt.Errorf("volume and from snapshot name required")
+	}
+	if toSnapshotName == "" {
+		toSnapshotName = etypes.VolumeHeadName
+	}
+
+	engineCliClient, err := engineapi.GetEngineBinaryClient(m.ds, volumeName, m.currentNodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	engine, err := m.GetRunningEngineByVolume(volumeName)
+	if err != nil {
+		return nil, err
+	}
+
+	engineClientProxy, err := engineapi.GetCompatibleClient(engine, engineCliClient, m.ds, nil, m.proxyConnCounter)
+	if err != nil {
+		return nil, err
+	}
+	defer engineClientProxy.Close()
+
+	snapshots, err := engineClientProxy.SnapshotList(engine)
+	if err != nil {
+		return nil, err
+	}
+	if err := engineapi.ValidateSnapshotChangedBlocksRange(snapshots, fromSnapshotName, toSnapshotName); err != nil {
+		return nil, err
+	}
+
+	return engineClientProxy.SnapshotChangedBlocks(engine, fromSnapshotName, toSnapshotName)
+}
+
 func (m *VolumeManager) CreateSnapshot(snapshotName string, labels map[string]string, volumeName string) (*longhorn.SnapshotInfo, error) {
 	if volumeName == "" {
 		return nil, fmt.Errorf("volume name required")
@@ -280,6 +320,44 @@ func (m *VolumeManager) BackupSnapshot(backupName, backupTargetName, volumeName,
 	return err
 }
 
+// CopyBackup creates a backup in the backup target with the given name by copying the source backup of the backup
+// volume from another backup target
+func (m *VolumeManager) CopyBackup(backupName, backupVolumeName, sourceBackupName, backupTargetName string, labels map[string]string) (*longhorn.Backup, error) {
+	if sourceBackupName == "" || backupTargetName == "" {
+		return nil, fmt.Errorf("source backup and backup target name required")
+	}
+
+	backupVolume, err := m.ds.GetBackupVolumeRO(backupVolumeName)
+	if err != nil {
+		return nil, err
+	}
+	sourceBackup, err := m.ds.GetBackupRO(sourceBackupName)
+	if err != nil {
+		return nil, err
+	}
+	volumeName, ok := sourceBackup.Labels[types.LonghornLabelBackupVolume]
+	if !ok {
+		return nil, fmt.Errorf("cannot find the backup volume label of the source backup %v", sourceBackupName)
+	}
+	if volumeName != backupVolume.Spec.VolumeName || sourceBackup.Labels[types.LonghornLabelBackupTarget] != backupVolume.Spec.BackupTargetName {
+		return nil, fmt.Errorf("source backup %v does not belong to the backup volume %v", sourceBackupName, backupVolumeName)
+	}
+
+	backupCR := &longhorn.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: backupName,
+			Labels: map[string]string{
+				types.LonghornLabelBackupTarget: backupTargetName,
+			},
+		},
+		Spec: longhorn.BackupSpec{
+			SourceBackupName: sourceBackupName,
+			Labels:           labels,
+		},
+	}
+	return m.ds.CreateBackup(backupCR, volumeName)
+}
+
 func (m *VolumeManager) checkVolumeNotInMigration(volumeName string) error {
 	v, err := m.ds.GetVolume(volumeName)
 	if err != nil {
@@ -384,8 +462,12 @@ func (m *VolumeManager) UpdateBackupTarget(backupTargetName string, backupTarget
 	}
 
 	if isBackupTargetSpecChanged(backupTargetSpec, &existingBackupTarget.Spec) {
+		gcRequestedAt := existingBackupTarget.Spec.GarbageCollectionRequestedAt
+		gcDryRun := existingBackupTarget.Spec.GarbageCollectionDryRun
 		existingBackupTarget.Spec = *backupTargetSpec.DeepCopy()
 		existingBackupTarget.Spec.SyncRequestedAt = metav1.Time{Time: time.Now().UTC()}
+		existingBackupTarget.Spec.GarbageCollectionRequestedAt = gcRequestedAt
+		existingBackupTarget.Spec.GarbageCollectionDryRun = gcDryRun
 		existingBackupTarget, err = m.ds.UpdateBackupTarget(existingBackupTarget)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to update backup target spec")
@@ -398,7 +480,8 @@ func (m *VolumeManager) UpdateBackupTarget(backupTargetName string, backupTarget
 func isBackupTargetSpecChanged(newSpec, existingSpec *longhorn.BackupTargetSpec) bool {
 	return newSpec.BackupTargetURL != existingSpec.BackupTargetURL ||
 		newSpec.CredentialSecret != existingSpec.CredentialSecret ||
-		newSpec.PollInterval != existingSpec.PollInterval
+		newSpec.PollInterval != existingSpec.PollInterval ||
+		!reflect.DeepEqual(newSpec.BandwidthLimit, existingSpec.BandwidthLimit)
 }
 
 func (m *VolumeManager) DeleteBackupTarget(backupTargetName string) error {
@@ -414,6 +497,20 @@ func (m *VolumeManager) SyncBackupTarget(backupTarget *longhorn.BackupTarget) (*
 	return m.ds.UpdateBackupTarget(backupTarget)
 }
 
+// GarbageCollectBackupTarget requests the backup target controller to delete the blocks and config files
+// not referenced by any backup in the backup target, or only report them if it is a dry run.
+func (m *VolumeManager) GarbageCollectBackupTarget(backupTarget *longhorn.BackupTarget, dryRun bool) (*longhorn.BackupTarget, error) {
+	if backupTarget.Spec.BackupTargetURL == "" {
+		return nil, errors.Errorf("cannot collect garbage in backup target %v without URL", backupTarget.Name)
+	}
+	if backupTarget.Status.GarbageCollection.State == longhorn.BackupTargetGarbageCollectionStateInProgress {
+		return nil, errors.Errorf("garbage collection of backup target %v is in progress", backupTarget.Name)
+	}
+	backupTarget.Spec.GarbageCollectionRequestedAt = metav1.Time{Time: time.Now().UTC()}
+	backupTarget.Spec.GarbageCollectionDryRun = dryRun
+	return m.ds.UpdateBackupTarget(backupTarget)
+}
+
 func (m *VolumeManager) ListBackupVolumes() (map[string]*longhorn.BackupVolume, error) {
 	return m.ds.ListBackupVolumes()
 }
@@ -107,6 +107,13 @@ func (m *VolumeManager) DiskUpdate(name string, updateDisks map[string]longhorn.
 		return nil, err
 	}
 
+	// Keep the setting overrides of the disks if the client does not know the field
+	for name, disk := range updateDisks {
+		if existing, ok := node.Spec.Disks[name]; ok && disk.SettingOverrides == nil {
+			disk.SettingOverrides = existing.SettingOverrides
+			updateDisks[name] = disk
+		}
+	}
 	node.Spec.Disks = updateDisks
 
 	node, err = m.ds.UpdateNode(node)
@@ -1,6 +1,8 @@
 package manager
 
 import (
+	"fmt"
+
 	"github.com/sirupsen/logrus"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -55,3 +57,53 @@ func (m *VolumeManager) CreateOrUpdateSetting(s *longhorn.Setting) (*longhorn.Se
 	logrus.Infof("Updated setting %v to %v", s.Name, setting.Value)
 	return setting, nil
 }
+
+// UpdateSettings applies the setting values together, and returns the updated settings sorted by name.
+func (m *VolumeManager) UpdateSettings(values map[types.SettingName]string) ([]*longhorn.Setting, error) {
+	if err := m.ds.UpdateSettings(values); err != nil {
+		return nil, err
+	}
+	logrus.Infof("Updated settings %+v", values)
+
+	sList, err := m.ListSettingsSorted()
+	if err != nil {
+		return nil, err
+	}
+
+	updated := []*longhorn.Setting{}
+	for _, setting := range sList {
+		if _, ok := values[types.SettingName(setting.Name)]; ok {
+			updated = append(updated, setting)
+		}
+	}
+	return updated, nil
+}
+
+// RollbackSetting sets the setting back to its value at the revision in the setting history.
+func (m *VolumeManager) RollbackSetting(sName types.SettingName, revision int64) (*longhorn.Setting, error) {
+	setting, err := m.ds.GetSetting(sName)
+	if err != nil {
+		return nil, err
+	}
+
+	found := false
+	for _, entry := range setting.Status.History {
+		// The value at a revision is the new value of its change, or the old value of the next change.
+		if entry.Revision == revision {
+			setting.Value = entry.NewValue
+			found = true
+			break
+		}
+		if entry.Revision == revision+1 {
+			setting.Value = entry.OldValue
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("cannot find revision %v in the history of setting %v", revision, sName)
+	}
+
+	logrus.Infof("Rolling back setting %v to revision %v", sName, revision)
+	return m.CreateOrUpdateSetting(setting)
+}
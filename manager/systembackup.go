@@ -14,6 +14,8 @@ func (m *VolumeManager) CreateSystemBackup(obj *longhorn.SystemBackup) (*longhor
 	logrus.WithFields(logrus.Fields{
 		"systemBackup":       obj.Name,
 		"volumeBackupPolicy": obj.Spec.VolumeBackupPolicy,
+		"scope":              obj.Spec.Scope.Namespaces,
+		"baseSystemBackup":   obj.Spec.BaseSystemBackup,
 	}).Info("Creating SystemBackup")
 
 	return m.ds.CreateSystemBackup(obj)
@@ -0,0 +1,249 @@
+package manager
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/cli-runtime/pkg/printers"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/engineapi"
+	"github.com/longhorn/longhorn-manager/types"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+const (
+	// The number of unpacked system backups kept in memory for browsing
+	systemBackupContentCacheSize = 5
+)
+
+// SystemBackupContent is the resources unpacked from a system backup archive.
+type SystemBackupContent struct {
+	// Resources sorted by kind, namespace and name
+	Resources []*unstructured.Unstructured
+
+	cachedAt time.Time
+}
+
+// SystemBackupVolumeBackup is the volume backup referenced by a volume in a system backup.
+type SystemBackupVolumeBackup struct {
+	VolumeName string
+	BackupName string
+	// Empty if the backup is not in the cluster
+	BackupState longhorn.BackupState
+}
+
+type systemBackupContentCache struct {
+	lock     sync.Mutex
+	contents map[string]*SystemBackupContent
+}
+
+func newSystemBackupContentCache() *systemBackupContentCache {
+	return &systemBackupContentCache{
+		contents: map[string]*SystemBackupContent{},
+	}
+}
+
+// get returns the cached content, or the content from fetch and caches it by evicting the oldest one if the cache is full.
+func (c *systemBackupContentCache) get(key string, fetch func() (*SystemBackupContent, error)) (*SystemBackupContent, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if content, ok := c.contents[key]; ok {
+		return content, nil
+	}
+
+	content, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	content.cachedAt = time.Now()
+
+	if len(c.contents) >= systemBackupContentCacheSize {
+		oldestKey := ""
+		for k, v := range c.contents {
+			if oldestKey == "" || v.cachedAt.Before(c.contents[oldestKey].cachedAt) {
+				oldestKey = k
+			}
+		}
+		delete(c.contents, oldestKey)
+	}
+	c.contents[key] = content
+	return content, nil
+}
+
+// GetSystemBackupContent returns the resources in the system backup archive in the backup target.
+func (m *VolumeManager) GetSystemBackupContent(name string) (*SystemBackupContent, error) {
+	systemBackup, err := m.ds.GetSystemBackupRO(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if systemBackup.Status.State != longhorn.SystemBackupStateReady {
+		return nil, fmt.Errorf("system backup %v is in %v state, expecting %v", name, systemBackup.Status.State, longhorn.SystemBackupStateReady)
+	}
+
+	// A system backup recreated with the same name has a different creation time
+	key := fmt.Sprintf("%v/%v/%v", name, systemBackup.Status.Version, systemBackup.Status.CreatedAt.UTC().Format(time.RFC3339))
+	return m.systemBackupContents.get(key, func() (*SystemBackupContent, error) {
+		return downloadSystemBackupContent(m.ds, systemBackup)
+	})
+}
+
+// GetSystemBackupResourceYAML returns the YAML of the resource in the system backup.
+func (m *VolumeManager) GetSystemBackupResourceYAML(name, kind, namespace, resourceName string) (string, error) {
+	content, err := m.GetSystemBackupContent(name)
+	if err != nil {
+		return "", err
+	}
+
+	for _, obj := range content.Resources {
+		if obj.GetKind() != kind || obj.GetNamespace() != namespace || obj.GetName() != resourceName {
+			continue
+		}
+
+		buf := &bytes.Buffer{}
+		printer := printers.YAMLPrinter{}
+		if err := printer.PrintObj(obj, buf); err != nil {
+			return "", err
+		}
+		return buf.String(), nil
+	}
+
+	return "", fmt.Errorf("cannot find %v %v in namespace %q in system backup %v", kind, resourceName, namespace, name)
+}
+
+// ListSystemBackupVolumeBackups returns the last backups of the volumes in the system backup, which are restored
+// from by the system restore.
+func (m *VolumeManager) ListSystemBackupVolumeBackups(name string) ([]*SystemBackupVolumeBackup, error) {
+	content, err := m.GetSystemBackupContent(name)
+	if err != nil {
+		return nil, err
+	}
+
+	volumeBackups := []*SystemBackupVolumeBackup{}
+	for _, obj := range content.Resources {
+		if obj.GetKind() != types.LonghornKindVolume {
+			continue
+		}
+
+		backupName, _, err := unstructured.NestedString(obj.Object, "status", "lastBackup")
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get last backup of volume %v", obj.GetName())
+		}
+		if backupName == "" {
+			continue
+		}
+
+		volumeBackup := &SystemBackupVolumeBackup{
+			VolumeName: obj.GetName(),
+			BackupName: backupName,
+		}
+
+		backup, err := m.ds.GetBackupRO(backupName)
+		if err != nil && !datastore.ErrorIsNotFound(err) {
+			return nil, err
+		}
+		if backup != nil {
+			volumeBackup.BackupState = backup.Status.State
+		}
+
+		volumeBackups = append(volumeBackups, volumeBackup)
+	}
+	return volumeBackups, nil
+}
+
+func downloadSystemBackupContent(ds *datastore.DataStore, systemBackup *longhorn.SystemBackup) (*SystemBackupContent, error) {
+	backupTarget, err := ds.GetBackupTargetRO(types.DefaultBackupTargetName)
+	if err != nil {
+		return nil, err
+	}
+
+	backupTargetClient, err := engineapi.NewBackupTargetClientFromBackupTarget(backupTarget, ds)
+	if err != nil {
+		return nil, err
+	}
+
+	tempDir, err := os.MkdirTemp("", "system-backup-content-")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := os.RemoveAll(tempDir); err != nil {
+			logrus.WithError(err).Warnf("Failed to remove %v", tempDir)
+		}
+	}()
+
+	archivePath := filepath.Join(tempDir, systemBackup.Name+types.SystemBackupExtension)
+	if err := backupTargetClient.DownloadSystemBackup(systemBackup.Name, systemBackup.Status.Version, archivePath); err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command("unzip", "-o", archivePath)
+	cmd.Dir = tempDir
+	if err := cmd.Run(); err != nil {
+		return nil, errors.Wrapf(err, "failed to unzip %v", archivePath)
+	}
+
+	return readSystemBackupContent(filepath.Join(tempDir, systemBackup.Name, types.SystemBackupSubDirYaml))
+}
+
+func readSystemBackupContent(yamlsDir string) (*SystemBackupContent, error) {
+	content := &SystemBackupContent{}
+	err := filepath.WalkDir(yamlsDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || filepath.Ext(path) != ".yaml" {
+			return nil
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		data, err = yaml.ToJSON(data)
+		if err != nil {
+			return errors.Wrapf(err, "failed to convert %v to JSON", path)
+		}
+
+		list := &unstructured.UnstructuredList{}
+		if err := list.UnmarshalJSON(data); err != nil {
+			return errors.Wrapf(err, "failed to decode %v", path)
+		}
+
+		for i := range list.Items {
+			content.Resources = append(content.Resources, &list.Items[i])
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(content.Resources, func(i, j int) bool {
+		a, b := content.Resources[i], content.Resources[j]
+		if a.GetKind() != b.GetKind() {
+			return a.GetKind() < b.GetKind()
+		}
+		if a.GetNamespace() != b.GetNamespace() {
+			return a.GetNamespace() < b.GetNamespace()
+		}
+		return a.GetName() < b.GetName()
+	})
+	return content, nil
+}
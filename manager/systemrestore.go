@@ -11,10 +11,11 @@ import (
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
 
-func (m *VolumeManager) CreateSystemRestore(name, systemBackup string) (*longhorn.SystemRestore, error) {
+func (m *VolumeManager) CreateSystemRestore(name, systemBackup string, include, exclude longhorn.SystemRestoreResourceFilter, remap longhorn.SystemRestoreRemap, dryRun bool) (*longhorn.SystemRestore, error) {
 	log := logrus.WithFields(logrus.Fields{
 		"systemBackup":  systemBackup,
 		"systemRestore": name,
+		"dryRun":        dryRun,
 	})
 	log.Info("Creating SystemRestore")
 
@@ -24,6 +25,10 @@ func (m *VolumeManager) CreateSystemRestore(name, systemBackup string) (*longhor
 		},
 		Spec: longhorn.SystemRestoreSpec{
 			SystemBackup: systemBackup,
+			Include:      include,
+			Exclude:      exclude,
+			Remap:        remap,
+			DryRun:       dryRun,
 		},
 	})
 }
@@ -0,0 +1,95 @@
+package manager
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	emeta "github.com/longhorn/longhorn-engine/pkg/meta"
+
+	"github.com/longhorn/longhorn-manager/meta"
+	"github.com/longhorn/longhorn-manager/types"
+
+	upgradeutil "github.com/longhorn/longhorn-manager/upgrade/util"
+)
+
+// GetUpgradeReadiness checks if the existing resources are ready to be upgraded to the target version. The target
+// defaults to the latest Longhorn version found by the upgrade checker. The engine images are checked only if the
+// target is the running version, since the engine API versions of other versions are unknown to the manager.
+func (m *VolumeManager) GetUpgradeReadiness(targetVersion string) (*upgradeutil.UpgradeReadinessReport, error) {
+	if targetVersion == "" {
+		latestVersion, err := m.ds.GetSettingWithAutoFillingRO(types.SettingNameLatestLonghornVersion)
+		if err != nil {
+			return nil, err
+		}
+		targetVersion = latestVersion.Value
+	}
+	if targetVersion == "" {
+		return nil, fmt.Errorf("target version is required since the latest Longhorn version is unknown")
+	}
+
+	target := upgradeutil.UpgradeReadinessTarget{
+		Version: targetVersion,
+	}
+	if targetVersion == meta.Version {
+		engineVersion := emeta.GetVersion()
+		target.EngineVersion = &engineVersion
+	}
+
+	resources, err := m.listUpgradeReadinessResources()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list resources for upgrade readiness check")
+	}
+
+	return upgradeutil.CheckUpgradeReadiness(resources, target), nil
+}
+
+func (m *VolumeManager) listUpgradeReadinessResources() (*upgradeutil.UpgradeReadinessResources, error) {
+	currentVersion, err := m.ds.GetSettingWithAutoFillingRO(types.SettingNameCurrentLonghornVersion)
+	if err != nil {
+		return nil, err
+	}
+	resources := &upgradeutil.UpgradeReadinessResources{
+		CurrentVersion: currentVersion.Value,
+	}
+
+	if resources.Volumes, err = m.ds.ListVolumesRO(); err != nil {
+		return nil, err
+	}
+
+	engineImages, err := m.ds.ListEngineImages()
+	if err != nil {
+		return nil, err
+	}
+	for _, ei := range engineImages {
+		resources.EngineImages = append(resources.EngineImages, ei)
+	}
+
+	if resources.BackingImages, err = m.ds.ListBackingImagesRO(); err != nil {
+		return nil, err
+	}
+
+	settings, err := m.ds.ListSettings()
+	if err != nil {
+		return nil, err
+	}
+	for _, setting := range settings {
+		resources.Settings = append(resources.Settings, setting)
+	}
+
+	obj, err := m.ds.GetAllLonghornCustomResourceDefinitions()
+	if err != nil {
+		return nil, err
+	}
+	crdList, ok := obj.(*apiextensionsv1.CustomResourceDefinitionList)
+	if !ok {
+		return nil, fmt.Errorf("unexpected CustomResourceDefinition list %T", obj)
+	}
+	for i := range crdList.Items {
+		resources.CRDs = append(resources.CRDs, &crdList.Items[i])
+	}
+
+	return resources, nil
+}
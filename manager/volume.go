@@ -1,6 +1,7 @@
 package manager
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"strconv"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 
 	"k8s.io/apimachinery/pkg/api/resource"
 
@@ -20,6 +22,7 @@ import (
 	"github.com/longhorn/longhorn-manager/scheduler"
 	"github.com/longhorn/longhorn-manager/types"
 	"github.com/longhorn/longhorn-manager/util"
+	"github.com/longhorn/longhorn-manager/util/tracing"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
@@ -31,6 +34,10 @@ type VolumeManager struct {
 	currentNodeID string
 
 	proxyConnCounter util.Counter
+
+	systemBackupContents *systemBackupContentCache
+
+	ioStatsHistory *volumeIOStatsHistory
 }
 
 func NewVolumeManager(currentNodeID string, ds *datastore.DataStore, proxyConnCounter util.Counter) *VolumeManager {
@@ -41,6 +48,10 @@ func NewVolumeManager(currentNodeID string, ds *datastore.DataStore, proxyConnCo
 		currentNodeID: currentNodeID,
 
 		proxyConnCounter: proxyConnCounter,
+
+		systemBackupContents: newSystemBackupContentCache(),
+
+		ioStatsHistory: newVolumeIOStatsHistory(),
 	}
 }
 
@@ -198,6 +209,7 @@ func (m *VolumeManager) Create(name string, spec *longhorn.VolumeSpec, recurring
 			DataEngine:                  spec.DataEngine,
 			FreezeFilesystemForSnapshot: spec.FreezeFilesystemForSnapshot,
 			BackupTargetName:            backupTargetName,
+			IOQoS:                       spec.IOQoS,
 		},
 	}
 
@@ -217,9 +229,11 @@ func (m *VolumeManager) Delete(name string) error {
 	return nil
 }
 
-func (m *VolumeManager) Attach(name, nodeID string, disableFrontend bool, attachedBy, attacherType, attachmentID string) (v *longhorn.Volume, err error) {
+func (m *VolumeManager) Attach(ctx context.Context, name, nodeID string, disableFrontend bool, attachedBy, attacherType, attachmentID string) (v *longhorn.Volume, err error) {
+	ctx, span := tracing.StartSpan(ctx, "VolumeManager.Attach", attribute.String("volume", name), attribute.String("node", nodeID))
 	defer func() {
 		err = errors.Wrapf(err, "unable to attach volume %v to %v", name, nodeID)
+		tracing.EndSpan(span, err)
 	}()
 
 	node, err := m.ds.GetNode(nodeID)
@@ -277,6 +291,7 @@ func (m *VolumeManager) Attach(name, nodeID string, disableFrontend bool, attach
 			longhorn.AttachmentParameterLastAttachedBy:  attachedBy,
 		},
 	}
+	tracing.InjectIntoAnnotations(ctx, va)
 
 	if _, err := m.ds.UpdateLHVolumeAttachment(va); err != nil {
 		return nil, err
@@ -286,9 +301,11 @@ func (m *VolumeManager) Attach(name, nodeID string, disableFrontend bool, attach
 }
 
 // Detach will handle regular detachment as well as cleaning up attachment Ticket created by upgrade path
-func (m *VolumeManager) Detach(name, attachmentID, hostID string, forceDetach bool) (v *longhorn.Volume, err error) {
+func (m *VolumeManager) Detach(ctx context.Context, name, attachmentID, hostID string, forceDetach bool) (v *longhorn.Volume, err error) {
+	ctx, span := tracing.StartSpan(ctx, "VolumeManager.Detach", attribute.String("volume", name))
 	defer func() {
 		err = errors.Wrapf(err, "unable to detach volume %v", name)
+		tracing.EndSpan(span, err)
 	}()
 
 	v, err = m.ds.GetVolume(name)
@@ -308,6 +325,7 @@ func (m *VolumeManager) Detach(name, attachmentID, hostID string, forceDetach bo
 	// if force detach, detach from all nodes by clearing the volumeattachment spec
 	if forceDetach {
 		va.Spec.AttachmentTickets = make(map[string]*longhorn.AttachmentTicket)
+		tracing.InjectIntoAnnotations(ctx, va)
 		if _, err := m.ds.UpdateLHVolumeAttachment(va); err != nil {
 			return nil, err
 		}
@@ -315,6 +333,7 @@ func (m *VolumeManager) Detach(name, attachmentID, hostID string, forceDetach bo
 	}
 
 	delete(va.Spec.AttachmentTickets, attachmentID)
+	tracing.InjectIntoAnnotations(ctx, va)
 
 	if _, err := m.ds.UpdateLHVolumeAttachment(va); err != nil {
 		return nil, err
@@ -1194,6 +1213,32 @@ func (m *VolumeManager) UpdateSnapshotMaxSize(name string, snapshotMaxSize int64
 	return v, nil
 }
 
+func (m *VolumeManager) UpdateIOQoS(name string, ioQoS longhorn.VolumeIOQoS) (v *longhorn.Volume, err error) {
+	defer func() {
+		err = errors.Wrapf(err, "unable to update field IOQoS for volume %s", name)
+	}()
+
+	v, err = m.ds.GetVolume(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.Spec.IOQoS == ioQoS {
+		logrus.Debugf("Volume %s already set field IOQoS to %+v", v.Name, ioQoS)
+		return v, nil
+	}
+
+	oldIOQoS := v.Spec.IOQoS
+	v.Spec.IOQoS = ioQoS
+	v, err = m.ds.UpdateVolume(v)
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.Infof("Updated volume %s field IOQoS from %+v to %+v", v.Name, oldIOQoS, ioQoS)
+	return v, nil
+}
+
 func (m *VolumeManager) restoreBackingImage(backupTargetName, biName, secret, secretNamespace, dataEngine string) error {
 	if secret != "" || secretNamespace != "" {
 		_, err := m.ds.GetSecretRO(secretNamespace, secret)
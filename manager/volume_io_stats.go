@@ -0,0 +1,165 @@
+package manager
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/longhorn/longhorn-manager/engineapi"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+const (
+	VolumeIOStatsSampleInterval = time.Minute
+	// The IO statistics history of a volume is kept in memory for this period
+	VolumeIOStatsRetention = 24 * time.Hour
+)
+
+// VolumeIOStatsSample is the IO statistics of a volume engine at a point in time.
+type VolumeIOStatsSample struct {
+	Timestamp       string `json:"timestamp"`
+	ReadThroughput  int64  `json:"readThroughput"`
+	WriteThroughput int64  `json:"writeThroughput"`
+	ReadIOPS        int64  `json:"readIOPS"`
+	WriteIOPS       int64  `json:"writeIOPS"`
+	ReadLatency     int64  `json:"readLatency"`
+	WriteLatency    int64  `json:"writeLatency"`
+
+	sampledAt time.Time
+}
+
+// volumeIOStatsHistory keeps the IO statistics samples of the volumes owned by the current node. The history is lost
+// once the manager restarts or the volume ownership moves to another node.
+type volumeIOStatsHistory struct {
+	lock    sync.RWMutex
+	samples map[string][]VolumeIOStatsSample
+}
+
+func newVolumeIOStatsHistory() *volumeIOStatsHistory {
+	return &volumeIOStatsHistory{
+		samples: map[string][]VolumeIOStatsSample{},
+	}
+}
+
+// add appends the sample of the volume and drops the samples older than the retention.
+func (h *volumeIOStatsHistory) add(volumeName string, sample VolumeIOStatsSample) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	samples := append(h.samples[volumeName], sample)
+	expired := 0
+	for expired < len(samples) && sample.sampledAt.Sub(samples[expired].sampledAt) > VolumeIOStatsRetention {
+		expired++
+	}
+	h.samples[volumeName] = samples[expired:]
+}
+
+// get returns the samples of the volume sorted from the oldest to the newest.
+func (h *volumeIOStatsHistory) get(volumeName string) []VolumeIOStatsSample {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	return append([]VolumeIOStatsSample{}, h.samples[volumeName]...)
+}
+
+// retain removes the history of the volumes not in volumeNames.
+func (h *volumeIOStatsHistory) retain(volumeNames map[string]bool) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	for volumeName := range h.samples {
+		if !volumeNames[volumeName] {
+			delete(h.samples, volumeName)
+		}
+	}
+}
+
+// GetVolumeIOStats returns the IO statistics history of the volume. The history is only kept by the volume owner node.
+func (m *VolumeManager) GetVolumeIOStats(volumeName string) ([]VolumeIOStatsSample, error) {
+	v, err := m.ds.GetVolumeRO(volumeName)
+	if err != nil {
+		return nil, err
+	}
+	if v.Status.OwnerID != m.currentNodeID {
+		return nil, errors.Errorf("IO statistics history of volume %v is kept by the owner node %v rather than node %v", volumeName, v.Status.OwnerID, m.currentNodeID)
+	}
+	return m.ioStatsHistory.get(volumeName), nil
+}
+
+// StartVolumeIOStatsRecorder samples the IO statistics of the running volumes owned by the current node every
+// VolumeIOStatsSampleInterval until stopCh is closed.
+func (m *VolumeManager) StartVolumeIOStatsRecorder(stopCh <-chan struct{}) {
+	go wait.Until(m.recordVolumeIOStats, VolumeIOStatsSampleInterval, stopCh)
+}
+
+func (m *VolumeManager) recordVolumeIOStats() {
+	log := logrus.WithField("node", m.currentNodeID)
+
+	volumes, err := m.ds.ListVolumesRO()
+	if err != nil {
+		log.WithError(err).Warn("Failed to list volumes for recording IO statistics")
+		return
+	}
+
+	ownedVolumes := map[string]bool{}
+	for _, v := range volumes {
+		if v.Status.OwnerID != m.currentNodeID {
+			continue
+		}
+		ownedVolumes[v.Name] = true
+		if v.Status.State != longhorn.VolumeStateAttached {
+			continue
+		}
+
+		sample, err := m.sampleVolumeIOStats(v)
+		if err != nil {
+			log.WithError(err).Debugf("Failed to sample IO statistics of volume %v", v.Name)
+			continue
+		}
+		m.ioStatsHistory.add(v.Name, *sample)
+	}
+	m.ioStatsHistory.retain(ownedVolumes)
+}
+
+func (m *VolumeManager) sampleVolumeIOStats(v *longhorn.Volume) (*VolumeIOStatsSample, error) {
+	e, err := m.ds.GetVolumeCurrentEngine(v.Name)
+	if err != nil {
+		return nil, err
+	}
+	if e.Status.CurrentState != longhorn.InstanceStateRunning {
+		return nil, errors.Errorf("engine %v is not running", e.Name)
+	}
+
+	engineCliClient, err := engineapi.GetEngineBinaryClient(m.ds, v.Name, m.currentNodeID)
+	if err != nil {
+		return nil, err
+	}
+	engineClientProxy, err := engineapi.GetCompatibleClient(e, engineCliClient, m.ds, nil, m.proxyConnCounter)
+	if err != nil {
+		return nil, err
+	}
+	defer engineClientProxy.Close()
+
+	metrics, err := engineClientProxy.MetricsGet(e)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	return &VolumeIOStatsSample{
+		Timestamp:       now.Format(time.RFC3339),
+		ReadThroughput:  int64(metrics.ReadThroughput),
+		WriteThroughput: int64(metrics.WriteThroughput),
+		ReadIOPS:        int64(metrics.ReadIOPS),
+		WriteIOPS:       int64(metrics.WriteIOPS),
+		ReadLatency:     int64(metrics.ReadLatency),
+		WriteLatency:    int64(metrics.WriteLatency),
+
+		sampledAt: now,
+	}, nil
+}
@@ -0,0 +1,103 @@
+package backup_adapter
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+
+	"github.com/longhorn/longhorn-manager/controller"
+	"github.com/longhorn/longhorn-manager/metrics_collector/registry"
+)
+
+// Package backup_adapter sets the controller backup lifecycle metric to produce
+// prometheus metrics. To use this package, you just have to import it.
+
+const (
+	LonghornName     = "longhorn"
+	BackupSubsystem  = "backup"
+	RestoreSubsystem = "restore"
+
+	ResultSucceeded = "succeeded"
+	ResultFailed    = "failed"
+)
+
+var (
+	durationBuckets         = prometheus.ExponentialBuckets(10, 2, 12)
+	transferredBytesBuckets = prometheus.ExponentialBuckets(1<<20, 4, 12)
+
+	backupDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: LonghornName,
+			Subsystem: BackupSubsystem,
+			Name:      "duration_seconds",
+			Help:      "Duration in seconds from the backup creation to the backup completion or failure. Broken down by result.",
+			Buckets:   durationBuckets,
+		},
+		[]string{"result"},
+	)
+
+	backupTransferredBytes = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: LonghornName,
+			Subsystem: BackupSubsystem,
+			Name:      "transferred_bytes",
+			Help:      "Data size in bytes uploaded to the backup target by the completed backups.",
+			Buckets:   transferredBytesBuckets,
+		},
+	)
+
+	restoreDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: LonghornName,
+			Subsystem: RestoreSubsystem,
+			Name:      "duration_seconds",
+			Help:      "Duration in seconds from the restore volume creation to the restore completion or failure. Broken down by result.",
+			Buckets:   durationBuckets,
+		},
+		[]string{"result"},
+	)
+
+	restoreTransferredBytes = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: LonghornName,
+			Subsystem: RestoreSubsystem,
+			Name:      "transferred_bytes",
+			Help:      "Data size in bytes downloaded from the backup target by the completed restores.",
+			Buckets:   transferredBytesBuckets,
+		},
+	)
+)
+
+func init() {
+	for _, c := range []prometheus.Collector{backupDuration, backupTransferredBytes, restoreDuration, restoreTransferredBytes} {
+		if err := registry.Register(c); err != nil {
+			logrus.WithError(err).Error("Failed to register backup lifecycle metrics")
+		}
+	}
+
+	controller.RegisterBackupLifecycleMetric(&lifecycleAdapter{})
+}
+
+type lifecycleAdapter struct{}
+
+func (l *lifecycleAdapter) ObserveBackup(succeeded bool, duration time.Duration, transferredBytes int64) {
+	backupDuration.WithLabelValues(getResult(succeeded)).Observe(duration.Seconds())
+	if succeeded {
+		backupTransferredBytes.Observe(float64(transferredBytes))
+	}
+}
+
+func (l *lifecycleAdapter) ObserveRestore(succeeded bool, duration time.Duration, transferredBytes int64) {
+	restoreDuration.WithLabelValues(getResult(succeeded)).Observe(duration.Seconds())
+	if succeeded {
+		restoreTransferredBytes.Observe(float64(transferredBytes))
+	}
+}
+
+func getResult(succeeded bool) string {
+	if succeeded {
+		return ResultSucceeded
+	}
+	return ResultFailed
+}
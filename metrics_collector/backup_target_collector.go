@@ -0,0 +1,165 @@
+package metricscollector
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+)
+
+type BackupTargetCollector struct {
+	*baseCollector
+
+	availableMetric          metricInfo
+	listLatencyMetric        metricInfo
+	lastSyncedMetric         metricInfo
+	backupVolumeCountMetric  metricInfo
+	storedBytesMetric        metricInfo
+	logicalBytesMetric       metricInfo
+	volumeStoredBytesMetric  metricInfo
+	volumeLogicalBytesMetric metricInfo
+}
+
+func NewBackupTargetCollector(
+	logger logrus.FieldLogger,
+	nodeID string,
+	ds *datastore.DataStore) *BackupTargetCollector {
+
+	bc := &BackupTargetCollector{
+		baseCollector: newBaseCollector(subsystemBackupTarget, logger, nodeID, ds),
+	}
+
+	bc.availableMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemBackupTarget, "available"),
+			"Whether this backup target is available (1) or not (0)",
+			[]string{backupTargetLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	bc.listLatencyMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemBackupTarget, "list_latency_seconds"),
+			"Time taken to list the backup volumes of this backup target in the last successful synchronization",
+			[]string{backupTargetLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	bc.lastSyncedMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemBackupTarget, "last_synced_timestamp_seconds"),
+			"Unix timestamp of the last successful synchronization of this backup target",
+			[]string{backupTargetLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	bc.backupVolumeCountMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemBackupTarget, "backup_volume_count"),
+			"Number of the backup volumes in this backup target",
+			[]string{backupTargetLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	bc.storedBytesMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemBackupTarget, "stored_bytes"),
+			"Deduplicated size of the data stored in this backup target",
+			[]string{backupTargetLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	bc.logicalBytesMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemBackupTarget, "logical_bytes"),
+			"Total size of all backups in this backup target",
+			[]string{backupTargetLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	bc.volumeStoredBytesMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemBackupTarget, "volume_stored_bytes"),
+			"Deduplicated size of the data stored for this volume in this backup target",
+			[]string{backupTargetLabel, volumeLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	bc.volumeLogicalBytesMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemBackupTarget, "volume_logical_bytes"),
+			"Total size of all backups of this volume in this backup target",
+			[]string{backupTargetLabel, volumeLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	return bc
+}
+
+func (bc *BackupTargetCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- bc.availableMetric.Desc
+	ch <- bc.listLatencyMetric.Desc
+	ch <- bc.lastSyncedMetric.Desc
+	ch <- bc.backupVolumeCountMetric.Desc
+	ch <- bc.storedBytesMetric.Desc
+	ch <- bc.logicalBytesMetric.Desc
+	ch <- bc.volumeStoredBytesMetric.Desc
+	ch <- bc.volumeLogicalBytesMetric.Desc
+}
+
+func (bc *BackupTargetCollector) Collect(ch chan<- prometheus.Metric) {
+	defer func() {
+		if err := recover(); err != nil {
+			bc.logger.WithField("error", err).Warn("Panic during collecting metrics")
+		}
+	}()
+
+	backupTargets, err := bc.ds.ListBackupTargetsRO()
+	if err != nil {
+		bc.logger.WithError(err).Warn("Error during scrape")
+		return
+	}
+
+	for _, backupTarget := range backupTargets {
+		if backupTarget.Status.OwnerID != bc.currentNodeID {
+			continue
+		}
+
+		available := 0.0
+		if backupTarget.Status.Available {
+			available = 1
+		}
+		var lastSynced float64
+		if !backupTarget.Status.LastSyncedAt.IsZero() {
+			lastSynced = float64(backupTarget.Status.LastSyncedAt.Unix())
+		}
+
+		ch <- prometheus.MustNewConstMetric(bc.availableMetric.Desc, bc.availableMetric.Type, available, backupTarget.Name)
+		ch <- prometheus.MustNewConstMetric(bc.listLatencyMetric.Desc, bc.listLatencyMetric.Type, backupTarget.Status.LastListLatency.Seconds(), backupTarget.Name)
+		ch <- prometheus.MustNewConstMetric(bc.lastSyncedMetric.Desc, bc.lastSyncedMetric.Type, lastSynced, backupTarget.Name)
+		ch <- prometheus.MustNewConstMetric(bc.backupVolumeCountMetric.Desc, bc.backupVolumeCountMetric.Type, float64(backupTarget.Status.BackupVolumeCount), backupTarget.Name)
+		ch <- prometheus.MustNewConstMetric(bc.storedBytesMetric.Desc, bc.storedBytesMetric.Type, float64(backupTarget.Status.TotalStoredBytes), backupTarget.Name)
+		ch <- prometheus.MustNewConstMetric(bc.logicalBytesMetric.Desc, bc.logicalBytesMetric.Type, float64(backupTarget.Status.TotalLogicalBytes), backupTarget.Name)
+
+		for volumeName, storage := range backupTarget.Status.VolumeStorage {
+			ch <- prometheus.MustNewConstMetric(bc.volumeStoredBytesMetric.Desc, bc.volumeStoredBytesMetric.Type, float64(storage.StoredBytes), backupTarget.Name, volumeName)
+			ch <- prometheus.MustNewConstMetric(bc.volumeLogicalBytesMetric.Desc, bc.volumeLogicalBytesMetric.Type, float64(storage.LogicalBytes), backupTarget.Name, volumeName)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package grpc_client_adapter
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+
+	"github.com/longhorn/longhorn-manager/engineapi"
+	"github.com/longhorn/longhorn-manager/metrics_collector/registry"
+)
+
+// Package grpc_client_adapter sets the engineapi gRPC metrics to produce
+// prometheus metrics. To use this package, you just have to import it.
+
+const (
+	LonghornName             = "longhorn"
+	InstanceManagerSubsystem = "instance_manager"
+)
+
+var (
+	requestLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: LonghornName,
+			Subsystem: InstanceManagerSubsystem,
+			Name:      "grpc_request_latency_seconds",
+			Help:      "Latency in seconds of the gRPC requests from this longhorn manager to the instance manager. Broken down by instance manager and method.",
+			Buckets:   prometheus.ExponentialBuckets(0.001, 2, 14),
+		},
+		[]string{"instance_manager", "method"},
+	)
+)
+
+func init() {
+	if err := registry.Register(requestLatency); err != nil {
+		logrus.WithError(err).Error("Failed to register instance manager gRPC client metrics")
+	}
+
+	engineapi.RegisterGRPCMetrics(&latencyAdapter{metric: requestLatency})
+}
+
+type latencyAdapter struct {
+	metric *prometheus.HistogramVec
+}
+
+func (l *latencyAdapter) Observe(instanceManagerName, method string, latency time.Duration) {
+	l.metric.WithLabelValues(instanceManagerName, method).Observe(latency.Seconds())
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
@@ -11,6 +12,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	metricsclientset "k8s.io/metrics/pkg/client/clientset/versioned"
 
+	lhtypes "github.com/longhorn/go-common-libs/types"
+
 	"github.com/longhorn/longhorn-manager/datastore"
 	"github.com/longhorn/longhorn-manager/types"
 	"github.com/longhorn/longhorn-manager/util"
@@ -31,6 +34,13 @@ type InstanceManagerCollector struct {
 
 	proxyConnCounter util.Counter
 	proxyConnMetric  metricInfo
+
+	processCountMetric    metricInfo
+	processCPUMetric      metricInfo
+	processMemoryMetric   metricInfo
+	processRestartsMetric metricInfo
+
+	processRestartTracker *instanceProcessRestartTracker
 }
 
 func NewInstanceManagerCollector(
@@ -46,6 +56,8 @@ func NewInstanceManagerCollector(
 		proxyConnCounter:  proxyConnCounter,
 		kubeMetricsClient: kubeMetricsClient,
 		namespace:         namespace,
+
+		processRestartTracker: newInstanceProcessRestartTracker(),
 	}
 
 	imc.cpuUsageMetric = metricInfo{
@@ -98,6 +110,46 @@ func NewInstanceManagerCollector(
 		Type: prometheus.GaugeValue,
 	}
 
+	imc.processCountMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemInstanceManager, "process_count"),
+			"The number of running engine or replica processes in this longhorn instance manager",
+			[]string{nodeLabel, instanceManagerLabel, instanceManagerType, processTypeLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	imc.processCPUMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemInstanceManager, "process_cpu_seconds_total"),
+			"The total user and system CPU time in seconds of this engine or replica process in the longhorn instance manager",
+			[]string{nodeLabel, instanceManagerLabel, volumeLabel, processLabel, processTypeLabel},
+			nil,
+		),
+		Type: prometheus.CounterValue,
+	}
+
+	imc.processMemoryMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemInstanceManager, "process_resident_memory_bytes"),
+			"The resident memory size in bytes of this engine or replica process in the longhorn instance manager",
+			[]string{nodeLabel, instanceManagerLabel, volumeLabel, processLabel, processTypeLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	imc.processRestartsMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemInstanceManager, "process_restarts_total"),
+			"The number of restarts of this engine or replica process in the longhorn instance manager observed by this longhorn manager",
+			[]string{nodeLabel, instanceManagerLabel, volumeLabel, processLabel, processTypeLabel},
+			nil,
+		),
+		Type: prometheus.CounterValue,
+	}
+
 	return imc
 }
 
@@ -107,6 +159,10 @@ func (imc *InstanceManagerCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- imc.memoryUsageMetric.Desc
 	ch <- imc.memoryRequestMetric.Desc
 	ch <- imc.proxyConnMetric.Desc
+	ch <- imc.processCountMetric.Desc
+	ch <- imc.processCPUMetric.Desc
+	ch <- imc.processMemoryMetric.Desc
+	ch <- imc.processRestartsMetric.Desc
 }
 
 func (imc *InstanceManagerCollector) Collect(ch chan<- prometheus.Metric) {
@@ -130,6 +186,12 @@ func (imc *InstanceManagerCollector) Collect(ch chan<- prometheus.Metric) {
 		imc.collectGrpcConnection(ch)
 	}()
 
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		imc.collectProcessMetrics(ch)
+	}()
+
 	wg.Wait()
 }
 
@@ -249,3 +311,71 @@ func (imc *InstanceManagerCollector) collectGrpcConnection(ch chan<- prometheus.
 		)
 	}
 }
+
+func (imc *InstanceManagerCollector) collectProcessMetrics(ch chan<- prometheus.Metric) {
+	defer func() {
+		if err := recover(); err != nil {
+			imc.logger.WithField("error", err).Warn("Panic during collecting metrics")
+		}
+	}()
+
+	instanceManagers, err := imc.ds.ListInstanceManagersRO()
+	if err != nil {
+		imc.logger.WithError(err).Warn("Error during scrape")
+		return
+	}
+
+	// Only the v1 data engine instances run as separate processes. The v2 data engine instances live in the SPDK target.
+	processStats, err := listInstanceProcessStats(lhtypes.HostProcDirectory)
+	if err != nil {
+		imc.logger.WithError(err).Warn("Error during scrape")
+		processStats = map[string]*instanceProcessStats{}
+	}
+
+	now := time.Now()
+	for _, im := range instanceManagers {
+		if im.Spec.NodeID != imc.currentNodeID {
+			continue
+		}
+
+		processGroups := map[string]map[string]longhorn.InstanceProcess{
+			string(longhorn.InstanceTypeEngine):  im.Status.InstanceEngines,
+			string(longhorn.InstanceTypeReplica): im.Status.InstanceReplicas,
+		}
+		for processType, processes := range processGroups {
+			runningCount := 0
+			for name, process := range processes {
+				if process.Status.State != longhorn.InstanceStateRunning {
+					continue
+				}
+				runningCount++
+
+				stats := processStats[name]
+				if stats == nil {
+					continue
+				}
+				volumeName := imc.getInstanceVolumeName(name, processType)
+				restarts := imc.processRestartTracker.observe(name, stats.pid, now)
+				ch <- prometheus.MustNewConstMetric(imc.processCPUMetric.Desc, imc.processCPUMetric.Type, stats.cpuSeconds, imc.currentNodeID, im.Name, volumeName, name, processType)
+				ch <- prometheus.MustNewConstMetric(imc.processMemoryMetric.Desc, imc.processMemoryMetric.Type, float64(stats.rssBytes), imc.currentNodeID, im.Name, volumeName, name, processType)
+				ch <- prometheus.MustNewConstMetric(imc.processRestartsMetric.Desc, imc.processRestartsMetric.Type, float64(restarts), imc.currentNodeID, im.Name, volumeName, name, processType)
+			}
+			ch <- prometheus.MustNewConstMetric(imc.processCountMetric.Desc, imc.processCountMetric.Type, float64(runningCount), imc.currentNodeID, im.Name, string(im.Spec.Type), processType)
+		}
+	}
+	imc.processRestartTracker.prune(now)
+}
+
+func (imc *InstanceManagerCollector) getInstanceVolumeName(instanceName, processType string) string {
+	switch processType {
+	case string(longhorn.InstanceTypeEngine):
+		if e, err := imc.ds.GetEngineRO(instanceName); err == nil {
+			return e.Spec.VolumeName
+		}
+	case string(longhorn.InstanceTypeReplica):
+		if r, err := imc.ds.GetReplicaRO(instanceName); err == nil {
+			return r.Spec.VolumeName
+		}
+	}
+	return ""
+}
@@ -0,0 +1,173 @@
+package metricscollector
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// The kernel reports the process CPU time in clock ticks of USER_HZ, which is 100 on all supported platforms
+	procClockTicksPerSecond = 100
+
+	// The restart count of an instance process is forgotten once the process hasn't been seen for this period
+	instanceProcessRestartRetention = time.Hour
+
+	// The flags identifying the instance name in the command line of the v1 data engine processes
+	engineInstanceNameFlag  = "--engine-instance-name"
+	replicaInstanceNameFlag = "--replica-instance-name"
+)
+
+// instanceProcessStats is the resource usage of a v1 data engine instance process read from the host proc directory.
+type instanceProcessStats struct {
+	pid        int
+	cpuSeconds float64
+	rssBytes   int64
+}
+
+// listInstanceProcessStats scans the proc directory for the v1 data engine instance processes and returns their
+// resource usage keyed by the instance name.
+func listInstanceProcessStats(procDir string) (map[string]*instanceProcessStats, error) {
+	entries, err := os.ReadDir(procDir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read proc directory %v", procDir)
+	}
+
+	pageSize := int64(os.Getpagesize())
+	ret := map[string]*instanceProcessStats{}
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+
+		// The process may exit during the scan, hence skip it on any read failure.
+		cmdline, err := os.ReadFile(filepath.Join(procDir, entry.Name(), "cmdline"))
+		if err != nil {
+			continue
+		}
+		instanceName := getInstanceNameFromCmdline(cmdline)
+		if instanceName == "" {
+			continue
+		}
+		stat, err := os.ReadFile(filepath.Join(procDir, entry.Name(), "stat"))
+		if err != nil {
+			continue
+		}
+		cpuTicks, err := parseProcStatCPUTicks(string(stat))
+		if err != nil {
+			continue
+		}
+		statm, err := os.ReadFile(filepath.Join(procDir, entry.Name(), "statm"))
+		if err != nil {
+			continue
+		}
+		rssPages, err := parseProcStatmRSSPages(string(statm))
+		if err != nil {
+			continue
+		}
+
+		ret[instanceName] = &instanceProcessStats{
+			pid:        pid,
+			cpuSeconds: float64(cpuTicks) / procClockTicksPerSecond,
+			rssBytes:   rssPages * pageSize,
+		}
+	}
+	return ret, nil
+}
+
+func getInstanceNameFromCmdline(cmdline []byte) string {
+	args := strings.Split(string(bytes.TrimRight(cmdline, "\x00")), "\x00")
+	for i := 0; i < len(args)-1; i++ {
+		if args[i] == engineInstanceNameFlag || args[i] == replicaInstanceNameFlag {
+			return args[i+1]
+		}
+	}
+	return ""
+}
+
+// parseProcStatCPUTicks returns the sum of utime and stime, which are the 14th and 15th fields of /proc/<pid>/stat.
+func parseProcStatCPUTicks(stat string) (int64, error) {
+	// The 2nd field comm is in parentheses and may contain spaces
+	commEnd := strings.LastIndex(stat, ")")
+	if commEnd < 0 {
+		return 0, errors.Errorf("invalid proc stat %v", stat)
+	}
+	// The fields after comm start from the 3rd field state
+	fields := strings.Fields(stat[commEnd+1:])
+	if len(fields) < 13 {
+		return 0, errors.Errorf("invalid proc stat %v", stat)
+	}
+	utime, err := strconv.ParseInt(fields[11], 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid utime in proc stat %v", stat)
+	}
+	stime, err := strconv.ParseInt(fields[12], 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid stime in proc stat %v", stat)
+	}
+	return utime + stime, nil
+}
+
+// parseProcStatmRSSPages returns the resident set size in pages, which is the 2nd field of /proc/<pid>/statm.
+func parseProcStatmRSSPages(statm string) (int64, error) {
+	fields := strings.Fields(statm)
+	if len(fields) < 2 {
+		return 0, errors.Errorf("invalid proc statm %v", statm)
+	}
+	return strconv.ParseInt(fields[1], 10, 64)
+}
+
+// instanceProcessRestartTracker counts the restarts of the instance processes by watching their PIDs change.
+type instanceProcessRestartTracker struct {
+	lock      sync.Mutex
+	processes map[string]*trackedInstanceProcess
+}
+
+type trackedInstanceProcess struct {
+	pid      int
+	restarts int
+	lastSeen time.Time
+}
+
+func newInstanceProcessRestartTracker() *instanceProcessRestartTracker {
+	return &instanceProcessRestartTracker{
+		processes: map[string]*trackedInstanceProcess{},
+	}
+}
+
+// observe records the PID of the instance process and returns its restart count.
+func (t *instanceProcessRestartTracker) observe(instanceName string, pid int, now time.Time) int {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	p := t.processes[instanceName]
+	if p == nil {
+		p = &trackedInstanceProcess{pid: pid}
+		t.processes[instanceName] = p
+	}
+	if p.pid != pid {
+		p.pid = pid
+		p.restarts++
+	}
+	p.lastSeen = now
+	return p.restarts
+}
+
+// prune forgets the instance processes not seen within instanceProcessRestartRetention.
+func (t *instanceProcessRestartTracker) prune(now time.Time) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	for name, p := range t.processes {
+		if now.Sub(p.lastSeen) > instanceProcessRestartRetention {
+			delete(t.processes, name)
+		}
+	}
+}
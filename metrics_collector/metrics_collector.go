@@ -16,8 +16,10 @@ import (
 	"github.com/longhorn/longhorn-manager/types"
 	"github.com/longhorn/longhorn-manager/util"
 
-	_ "github.com/longhorn/longhorn-manager/metrics_collector/client_go_adaper" // load the client-go metrics
-	_ "github.com/longhorn/longhorn-manager/metrics_collector/workqueue"        // load the workqueue metrics
+	_ "github.com/longhorn/longhorn-manager/metrics_collector/backup_adapter"      // load the backup and restore lifecycle metrics
+	_ "github.com/longhorn/longhorn-manager/metrics_collector/client_go_adaper"    // load the client-go metrics
+	_ "github.com/longhorn/longhorn-manager/metrics_collector/grpc_client_adapter" // load the instance manager gRPC client metrics
+	_ "github.com/longhorn/longhorn-manager/metrics_collector/workqueue"           // load the workqueue metrics
 )
 
 func InitMetricsCollectorSystem(logger logrus.FieldLogger, currentNodeID string, ds *datastore.DataStore, kubeconfigPath string, proxyConnCounter util.Counter) {
@@ -29,6 +31,8 @@ func InitMetricsCollectorSystem(logger logrus.FieldLogger, currentNodeID string,
 	snapshotController := NewSnapshotCollector(logger, currentNodeID, ds)
 	backingImageCollector := NewBackingImageCollector(logger, currentNodeID, ds)
 	backupBackingImageCollector := NewBackupBackingImageCollector(logger, currentNodeID, ds)
+	backupTargetCollector := NewBackupTargetCollector(logger, currentNodeID, ds)
+	recurringJobCollector := NewRecurringJobCollector(logger, currentNodeID, ds)
 
 	if err := registry.Register(volumeCollector); err != nil {
 		logger.WithField("collector", subsystemVolume).WithError(err).Warn("Failed to register collector")
@@ -54,6 +58,14 @@ func InitMetricsCollectorSystem(logger logrus.FieldLogger, currentNodeID string,
 		logger.WithField("collector", subsystemBackupBackingImage).WithError(err).Warn("Failed to register collector")
 	}
 
+	if err := registry.Register(backupTargetCollector); err != nil {
+		logger.WithField("collector", subsystemBackupTarget).WithError(err).Warn("Failed to register collector")
+	}
+
+	if err := registry.Register(recurringJobCollector); err != nil {
+		logger.WithField("collector", subsystemRecurringJob).WithError(err).Warn("Failed to register collector")
+	}
+
 	namespace := os.Getenv(types.EnvPodNamespace)
 	if namespace == "" {
 		logger.Warnf("Cannot detect pod namespace, environment variable %v is missing, "+
@@ -0,0 +1,69 @@
+package metricscollector
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+)
+
+const (
+	recurringJobResultSucceeded = "succeeded"
+	recurringJobResultFailed    = "failed"
+)
+
+type RecurringJobCollector struct {
+	*baseCollector
+
+	runsMetric metricInfo
+}
+
+func NewRecurringJobCollector(
+	logger logrus.FieldLogger,
+	nodeID string,
+	ds *datastore.DataStore) *RecurringJobCollector {
+
+	rc := &RecurringJobCollector{
+		baseCollector: newBaseCollector(subsystemRecurringJob, logger, nodeID, ds),
+	}
+
+	rc.runsMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemRecurringJob, "runs_total"),
+			"Number of the finished runs of this recurring job. A run is failed if the task fails on any of the volumes",
+			[]string{recurringJobLabel, taskLabel, resultLabel},
+			nil,
+		),
+		Type: prometheus.CounterValue,
+	}
+
+	return rc
+}
+
+func (rc *RecurringJobCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- rc.runsMetric.Desc
+}
+
+func (rc *RecurringJobCollector) Collect(ch chan<- prometheus.Metric) {
+	defer func() {
+		if err := recover(); err != nil {
+			rc.logger.WithField("error", err).Warn("Panic during collecting metrics")
+		}
+	}()
+
+	recurringJobs, err := rc.ds.ListRecurringJobsRO()
+	if err != nil {
+		rc.logger.WithError(err).Warn("Error during scrape")
+		return
+	}
+
+	for _, recurringJob := range recurringJobs {
+		if recurringJob.Status.OwnerID != rc.currentNodeID {
+			continue
+		}
+
+		task := string(recurringJob.Spec.Task)
+		ch <- prometheus.MustNewConstMetric(rc.runsMetric.Desc, rc.runsMetric.Type, float64(recurringJob.Status.SucceededCount), recurringJob.Name, task, recurringJobResultSucceeded)
+		ch <- prometheus.MustNewConstMetric(rc.runsMetric.Desc, rc.runsMetric.Type, float64(recurringJob.Status.FailedCount), recurringJob.Name, task, recurringJobResultFailed)
+	}
+}
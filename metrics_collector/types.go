@@ -18,14 +18,19 @@ const (
 	subsystemSnapshot           = "snapshot"
 	subsystemBackingImage       = "backing_image"
 	subsystemBackupBackingImage = "backup_backing_image"
+	subsystemBackupTarget       = "backup_target"
+	subsystemRecurringJob       = "recurring_job"
 
 	nodeLabel               = "node"
 	diskLabel               = "disk"
 	volumeLabel             = "volume"
+	replicaLabel            = "replica"
 	conditionLabel          = "condition"
 	conditionReasonLabel    = "condition_reason"
 	instanceManagerLabel    = "instance_manager"
 	instanceManagerType     = "instance_manager_type"
+	processLabel            = "process"
+	processTypeLabel        = "process_type"
 	managerLabel            = "manager"
 	backupLabel             = "backup"
 	snapshotLabel           = "snapshot"
@@ -35,6 +40,9 @@ const (
 	backingImageLabel       = "backing_image"
 	backupBackingImageLabel = "backup_backing_image"
 	recurringJobLabel       = "recurring_job"
+	backupTargetLabel       = "backup_target"
+	taskLabel               = "task"
+	resultLabel             = "result"
 )
 
 type metricInfo struct {
@@ -1,6 +1,8 @@
 package metricscollector
 
 import (
+	"time"
+
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
@@ -25,9 +27,26 @@ type VolumeCollector struct {
 	sizeMetric               metricInfo
 	stateMetric              metricInfo
 	robustnessMetric         metricInfo
+	healthScoreMetric        metricInfo
 	fileSystemReadOnlyMetric metricInfo
 
+	restoreProgressMetric        metricInfo
+	secondsSinceLastBackupMetric metricInfo
+
 	volumePerfMetrics
+	volumeIOQoSMetrics
+	replicaIOMetrics
+}
+
+type replicaIOMetrics struct {
+	replicaLatencyMetrics          rwMetrics
+	replicaErrorMetrics            rwMetrics
+	replicaRebuildThroughputMetric metricInfo
+}
+
+type volumeIOQoSMetrics struct {
+	throughputLimitMetrics rwMetrics
+	iopsLimitMetrics       rwMetrics
 }
 
 type volumePerfMetrics struct {
@@ -101,6 +120,36 @@ func NewVolumeCollector(
 		Type: prometheus.GaugeValue,
 	}
 
+	vc.healthScoreMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemVolume, "health_score"),
+			"Health score of this volume from 0 to 100",
+			[]string{nodeLabel, volumeLabel, pvcLabel, pvcNamespaceLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	vc.restoreProgressMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemVolume, "restore_progress"),
+			"Restore progress of this restore or DR volume from 0 to 100, which is the lowest progress among the restoring replicas",
+			[]string{nodeLabel, volumeLabel, pvcLabel, pvcNamespaceLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	vc.secondsSinceLastBackupMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemVolume, "seconds_since_last_backup"),
+			"Seconds since the last successful backup of this volume",
+			[]string{nodeLabel, volumeLabel, pvcLabel, pvcNamespaceLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
 	vc.throughputMetrics.read = metricInfo{
 		Desc: prometheus.NewDesc(
 			prometheus.BuildFQName(longhornName, subsystemVolume, "read_throughput"),
@@ -161,6 +210,96 @@ func NewVolumeCollector(
 		Type: prometheus.GaugeValue,
 	}
 
+	vc.throughputLimitMetrics.read = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemVolume, "read_throughput_limit"),
+			"Read throughput limit of this volume (Bytes/s), 0 means unlimited",
+			[]string{nodeLabel, volumeLabel, pvcLabel, pvcNamespaceLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	vc.throughputLimitMetrics.write = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemVolume, "write_throughput_limit"),
+			"Write throughput limit of this volume (Bytes/s), 0 means unlimited",
+			[]string{nodeLabel, volumeLabel, pvcLabel, pvcNamespaceLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	vc.iopsLimitMetrics.read = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemVolume, "read_iops_limit"),
+			"Read IOPS limit of this volume, 0 means unlimited",
+			[]string{nodeLabel, volumeLabel, pvcLabel, pvcNamespaceLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	vc.iopsLimitMetrics.write = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemVolume, "write_iops_limit"),
+			"Write IOPS limit of this volume, 0 means unlimited",
+			[]string{nodeLabel, volumeLabel, pvcLabel, pvcNamespaceLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	vc.replicaLatencyMetrics.read = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemVolume, "replica_read_latency"),
+			"Read latency of this replica of the volume (ns)",
+			[]string{nodeLabel, diskLabel, volumeLabel, replicaLabel, pvcLabel, pvcNamespaceLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	vc.replicaLatencyMetrics.write = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemVolume, "replica_write_latency"),
+			"Write latency of this replica of the volume (ns)",
+			[]string{nodeLabel, diskLabel, volumeLabel, replicaLabel, pvcLabel, pvcNamespaceLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	vc.replicaErrorMetrics.read = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemVolume, "replica_read_errors_total"),
+			"Number of the failed reads of this replica of the volume",
+			[]string{nodeLabel, diskLabel, volumeLabel, replicaLabel, pvcLabel, pvcNamespaceLabel},
+			nil,
+		),
+		Type: prometheus.CounterValue,
+	}
+
+	vc.replicaErrorMetrics.write = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemVolume, "replica_write_errors_total"),
+			"Number of the failed writes of this replica of the volume",
+			[]string{nodeLabel, diskLabel, volumeLabel, replicaLabel, pvcLabel, pvcNamespaceLabel},
+			nil,
+		),
+		Type: prometheus.CounterValue,
+	}
+
+	vc.replicaRebuildThroughputMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemVolume, "replica_rebuild_throughput"),
+			"Rebuild throughput of this replica of the volume (Bytes/s), 0 if the replica is not rebuilding",
+			[]string{nodeLabel, diskLabel, volumeLabel, replicaLabel, pvcLabel, pvcNamespaceLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
 	return vc
 }
 
@@ -169,7 +308,10 @@ func (vc *VolumeCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- vc.sizeMetric.Desc
 	ch <- vc.stateMetric.Desc
 	ch <- vc.robustnessMetric.Desc
+	ch <- vc.healthScoreMetric.Desc
 	ch <- vc.fileSystemReadOnlyMetric.Desc
+	ch <- vc.restoreProgressMetric.Desc
+	ch <- vc.secondsSinceLastBackupMetric.Desc
 }
 
 func (vc *VolumeCollector) Collect(ch chan<- prometheus.Metric) {
@@ -203,6 +345,22 @@ func (vc *VolumeCollector) collectMetrics(ch chan<- prometheus.Metric, v *longho
 	ch <- prometheus.MustNewConstMetric(vc.sizeMetric.Desc, vc.sizeMetric.Type, float64(v.Status.ActualSize), vc.currentNodeID, v.Name, v.Status.KubernetesStatus.PVCName, v.Status.KubernetesStatus.Namespace)
 	ch <- prometheus.MustNewConstMetric(vc.stateMetric.Desc, vc.stateMetric.Type, float64(getVolumeStateValue(v)), vc.currentNodeID, v.Name, v.Status.KubernetesStatus.PVCName, v.Status.KubernetesStatus.Namespace)
 	ch <- prometheus.MustNewConstMetric(vc.robustnessMetric.Desc, vc.robustnessMetric.Type, float64(getVolumeRobustnessValue(v)), vc.currentNodeID, v.Name, v.Status.KubernetesStatus.PVCName, v.Status.KubernetesStatus.Namespace)
+	ch <- prometheus.MustNewConstMetric(vc.healthScoreMetric.Desc, vc.healthScoreMetric.Type, float64(v.Status.HealthScore), vc.currentNodeID, v.Name, v.Status.KubernetesStatus.PVCName, v.Status.KubernetesStatus.Namespace)
+	ch <- prometheus.MustNewConstMetric(vc.throughputLimitMetrics.read.Desc, vc.throughputLimitMetrics.read.Type, float64(v.Spec.IOQoS.MaxReadBandwidth), vc.currentNodeID, v.Name, v.Status.KubernetesStatus.PVCName, v.Status.KubernetesStatus.Namespace)
+	ch <- prometheus.MustNewConstMetric(vc.throughputLimitMetrics.write.Desc, vc.throughputLimitMetrics.write.Type, float64(v.Spec.IOQoS.MaxWriteBandwidth), vc.currentNodeID, v.Name, v.Status.KubernetesStatus.PVCName, v.Status.KubernetesStatus.Namespace)
+	ch <- prometheus.MustNewConstMetric(vc.iopsLimitMetrics.read.Desc, vc.iopsLimitMetrics.read.Type, float64(v.Spec.IOQoS.MaxReadIOPS), vc.currentNodeID, v.Name, v.Status.KubernetesStatus.PVCName, v.Status.KubernetesStatus.Namespace)
+	ch <- prometheus.MustNewConstMetric(vc.iopsLimitMetrics.write.Desc, vc.iopsLimitMetrics.write.Type, float64(v.Spec.IOQoS.MaxWriteIOPS), vc.currentNodeID, v.Name, v.Status.KubernetesStatus.PVCName, v.Status.KubernetesStatus.Namespace)
+
+	if v.Status.LastBackupAt != "" {
+		lastBackupAt, err := time.Parse(time.RFC3339, v.Status.LastBackupAt)
+		if err != nil {
+			vc.logger.WithError(err).Debugf("Failed to parse the last backup time of volume %v", v.Name)
+		} else {
+			ch <- prometheus.MustNewConstMetric(vc.secondsSinceLastBackupMetric.Desc, vc.secondsSinceLastBackupMetric.Type, time.Since(lastBackupAt).Seconds(), vc.currentNodeID, v.Name, v.Status.KubernetesStatus.PVCName, v.Status.KubernetesStatus.Namespace)
+		}
+	}
+
+	vc.collectReplicaIOMetrics(ch, v)
 
 	e, err := vc.ds.GetVolumeCurrentEngine(v.Name)
 	if err != nil {
@@ -210,6 +368,10 @@ func (vc *VolumeCollector) collectMetrics(ch chan<- prometheus.Metric, v *longho
 		return
 	}
 
+	if progress, restoring := getVolumeRestoreProgress(v, e); restoring {
+		ch <- prometheus.MustNewConstMetric(vc.restoreProgressMetric.Desc, vc.restoreProgressMetric.Type, float64(progress), vc.currentNodeID, v.Name, v.Status.KubernetesStatus.PVCName, v.Status.KubernetesStatus.Namespace)
+	}
+
 	engineClientProxy, err := vc.getEngineClientProxy(e)
 	if err != nil {
 		vc.logger.WithError(err).Debugf("Failed to get engine proxy of %v for volume %v", e.Name, v.Name)
@@ -242,6 +404,59 @@ func (vc *VolumeCollector) collectMetrics(ch chan<- prometheus.Metric, v *longho
 
 }
 
+// getVolumeRestoreProgress returns the lowest restore progress among the replicas of the restore or DR volume. The
+// progress is only available while the volume is restoring.
+func getVolumeRestoreProgress(v *longhorn.Volume, e *longhorn.Engine) (int, bool) {
+	if !v.Status.RestoreRequired || len(e.Status.RestoreStatus) == 0 {
+		return 0, false
+	}
+
+	progress := 100
+	for _, status := range e.Status.RestoreStatus {
+		if status != nil && status.Progress < progress {
+			progress = status.Progress
+		}
+	}
+	return progress, true
+}
+
+// collectReplicaIOMetrics exports the IO statistics recorded in the status of the running replicas of the volume.
+func (vc *VolumeCollector) collectReplicaIOMetrics(ch chan<- prometheus.Metric, v *longhorn.Volume) {
+	replicas, err := vc.ds.ListVolumeReplicasRO(v.Name)
+	if err != nil {
+		vc.logger.WithError(err).Debugf("Failed to list replicas for volume %v", v.Name)
+		return
+	}
+
+	for _, r := range replicas {
+		if r.Status.IOMetrics == nil || r.Status.CurrentState != longhorn.InstanceStateRunning {
+			continue
+		}
+		labels := []string{r.Spec.NodeID, vc.getReplicaDiskName(r), v.Name, r.Name, v.Status.KubernetesStatus.PVCName, v.Status.KubernetesStatus.Namespace}
+		metrics := r.Status.IOMetrics
+		ch <- prometheus.MustNewConstMetric(vc.replicaLatencyMetrics.read.Desc, vc.replicaLatencyMetrics.read.Type, float64(metrics.ReadLatency), labels...)
+		ch <- prometheus.MustNewConstMetric(vc.replicaLatencyMetrics.write.Desc, vc.replicaLatencyMetrics.write.Type, float64(metrics.WriteLatency), labels...)
+		ch <- prometheus.MustNewConstMetric(vc.replicaErrorMetrics.read.Desc, vc.replicaErrorMetrics.read.Type, float64(metrics.ReadErrorCount), labels...)
+		ch <- prometheus.MustNewConstMetric(vc.replicaErrorMetrics.write.Desc, vc.replicaErrorMetrics.write.Type, float64(metrics.WriteErrorCount), labels...)
+		ch <- prometheus.MustNewConstMetric(vc.replicaRebuildThroughputMetric.Desc, vc.replicaRebuildThroughputMetric.Type, float64(metrics.RebuildThroughput), labels...)
+	}
+}
+
+// getReplicaDiskName returns the name of the disk the replica is on. It falls back to the disk UUID if the disk
+// cannot be found on the node.
+func (vc *VolumeCollector) getReplicaDiskName(r *longhorn.Replica) string {
+	node, err := vc.ds.GetNodeRO(r.Spec.NodeID)
+	if err != nil {
+		return r.Spec.DiskID
+	}
+	for diskName, diskStatus := range node.Status.DiskStatus {
+		if diskStatus != nil && diskStatus.DiskUUID == r.Spec.DiskID {
+			return diskName
+		}
+	}
+	return r.Spec.DiskID
+}
+
 func (vc *VolumeCollector) getEngineClientProxy(engine *longhorn.Engine) (c engineapi.EngineClientProxy, err error) {
 	engineCliClient, err := controller.GetBinaryClientForEngine(engine, &engineapi.EngineCollection{}, engine.Status.CurrentImage)
 	if err != nil {
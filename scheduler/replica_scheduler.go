@@ -416,7 +416,7 @@ func (rcs *ReplicaScheduler) filterNodeDisksForReplica(node *longhorn.Node, disk
 		}
 
 		if requireSchedulingCheck {
-			info, err := rcs.GetDiskSchedulingInfo(diskSpec, diskStatus)
+			info, err := rcs.GetDiskSchedulingInfo(node, diskSpec, diskStatus)
 			if err != nil {
 				logrus.Errorf("Failed to get settings when scheduling replica: %v", err)
 				multiError.Append(util.NewMultiError(longhorn.ErrorReplicaScheduleSchedulingSettingsRetrieveFailed))
@@ -738,7 +738,7 @@ func (rcs *ReplicaScheduler) isFailedReplicaReusable(r *longhorn.Replica, v *lon
 			if types.GetCondition(diskStatus.Conditions, longhorn.DiskConditionTypeSchedulable).Reason != longhorn.DiskConditionReasonDiskPressure {
 				continue
 			}
-			schedulingInfo, err := rcs.GetDiskSchedulingInfo(diskSpec, diskStatus)
+			schedulingInfo, err := rcs.GetDiskSchedulingInfo(node, diskSpec, diskStatus)
 			if err != nil {
 				logrus.Warnf("failed to GetDiskSchedulingInfo of disk %v on node %v when checking replica %v is reusable: %v", diskName, node.Name, r.Name, err)
 			}
@@ -868,7 +868,7 @@ func (rcs *ReplicaScheduler) FilterNodesSchedulableForVolume(nodes map[string]*l
 				continue
 			}
 
-			diskInfo, err := rcs.GetDiskSchedulingInfo(diskSpec, diskStatus)
+			diskInfo, err := rcs.GetDiskSchedulingInfo(node, diskSpec, diskStatus)
 			if err != nil {
 				logrus.WithError(err).Debugf("Failed to get disk scheduling info for disk %v on node %v", diskName, node.Name)
 				continue
@@ -898,13 +898,14 @@ func (rcs *ReplicaScheduler) isDiskNotFull(info *DiskSchedulingInfo) bool {
 		info.StorageAvailable > int64(float64(info.StorageMaximum)*float64(info.MinimalAvailablePercentage)/100)
 }
 
-func (rcs *ReplicaScheduler) GetDiskSchedulingInfo(disk longhorn.DiskSpec, diskStatus *longhorn.DiskStatus) (*DiskSchedulingInfo, error) {
-	// get StorageOverProvisioningPercentage and StorageMinimalAvailablePercentage settings
-	overProvisioningPercentage, err := rcs.ds.GetSettingAsInt(types.SettingNameStorageOverProvisioningPercentage)
+func (rcs *ReplicaScheduler) GetDiskSchedulingInfo(node *longhorn.Node, disk longhorn.DiskSpec, diskStatus *longhorn.DiskStatus) (*DiskSchedulingInfo, error) {
+	// get StorageOverProvisioningPercentage and StorageMinimalAvailablePercentage settings, which can be overridden
+	// for the node and the disk
+	overProvisioningPercentage, err := rcs.ds.GetSettingAsIntForDisk(types.SettingNameStorageOverProvisioningPercentage, node, disk)
 	if err != nil {
 		return nil, err
 	}
-	minimalAvailablePercentage, err := rcs.ds.GetSettingAsInt(types.SettingNameStorageMinimalAvailablePercentage)
+	minimalAvailablePercentage, err := rcs.ds.GetSettingAsIntForDisk(types.SettingNameStorageMinimalAvailablePercentage, node, disk)
 	if err != nil {
 		return nil, err
 	}
@@ -944,7 +945,7 @@ func (rcs *ReplicaScheduler) CheckReplicasSizeExpansion(v *longhorn.Volume, oldS
 			return util.NewMultiError(longhorn.ErrorReplicaScheduleDiskNotFound),
 				fmt.Errorf("cannot find the disk %v in node %v", r.Spec.DiskID, node.Name)
 		}
-		diskInfo, err := rcs.GetDiskSchedulingInfo(diskSpec, &diskStatus)
+		diskInfo, err := rcs.GetDiskSchedulingInfo(node, diskSpec, &diskStatus)
 		if err != nil {
 			return util.NewMultiError(longhorn.ErrorReplicaScheduleDiskUnavailable),
 				fmt.Errorf("failed to GetDiskSchedulingInfo %v", err)
@@ -1685,6 +1685,103 @@ func (s *TestSuite) TestIsSchedulableToDiskConsiderDiskPressure(c *C) {
 	}
 }
 
+func (s *TestSuite) TestGetDiskSchedulingInfoWithSettingOverrides(c *C) {
+	type TestCase struct {
+		nodeLabels           map[string]string
+		nodeSettingOverrides map[string]string
+		diskSettingOverrides map[string]string
+
+		expectedOverProvisioningPercentage int64
+		expectedMinimalAvailablePercentage int64
+	}
+
+	testCases := map[string]TestCase{
+		"global settings": {
+			expectedOverProvisioningPercentage: 100,
+			expectedMinimalAvailablePercentage: 25,
+		},
+		"node label overrides": {
+			nodeLabels: map[string]string{
+				types.GetNodeSettingOverrideLabelKey(types.SettingNameStorageOverProvisioningPercentage): "200",
+			},
+			expectedOverProvisioningPercentage: 200,
+			expectedMinimalAvailablePercentage: 25,
+		},
+		"invalid node label is ignored": {
+			nodeLabels: map[string]string{
+				types.GetNodeSettingOverrideLabelKey(types.SettingNameStorageMinimalAvailablePercentage): "invalid",
+			},
+			expectedOverProvisioningPercentage: 100,
+			expectedMinimalAvailablePercentage: 25,
+		},
+		"node spec overrides take precedence over node label": {
+			nodeLabels: map[string]string{
+				types.GetNodeSettingOverrideLabelKey(types.SettingNameStorageOverProvisioningPercentage): "200",
+			},
+			nodeSettingOverrides: map[string]string{
+				string(types.SettingNameStorageOverProvisioningPercentage): "300",
+				string(types.SettingNameStorageMinimalAvailablePercentage): "10",
+			},
+			expectedOverProvisioningPercentage: 300,
+			expectedMinimalAvailablePercentage: 10,
+		},
+		"disk overrides take precedence over node": {
+			nodeSettingOverrides: map[string]string{
+				string(types.SettingNameStorageOverProvisioningPercentage): "300",
+				string(types.SettingNameStorageMinimalAvailablePercentage): "10",
+			},
+			diskSettingOverrides: map[string]string{
+				string(types.SettingNameStorageMinimalAvailablePercentage): "5",
+			},
+			expectedOverProvisioningPercentage: 300,
+			expectedMinimalAvailablePercentage: 5,
+		},
+	}
+
+	for name, tc := range testCases {
+		fmt.Printf("testing %v\n", name)
+
+		kubeClient := fake.NewSimpleClientset()
+		lhClient := lhfake.NewSimpleClientset()
+		extensionsClient := apiextensionsfake.NewSimpleClientset()
+		informerFactories := util.NewInformerFactories(TestNamespace, kubeClient, lhClient, controller.NoResyncPeriodFunc())
+
+		sIndexer := informerFactories.LhInformerFactory.Longhorn().V1beta2().Settings().Informer().GetIndexer()
+		knIndexer := informerFactories.KubeInformerFactory.Core().V1().Nodes().Informer().GetIndexer()
+
+		rcs := newReplicaScheduler(lhClient, kubeClient, extensionsClient, informerFactories)
+
+		for name, value := range map[types.SettingName]string{
+			types.SettingNameStorageOverProvisioningPercentage: "100",
+			types.SettingNameStorageMinimalAvailablePercentage: "25",
+		} {
+			setting, err := lhClient.LonghornV1beta2().Settings(TestNamespace).Create(context.TODO(), initSettings(string(name), value), metav1.CreateOptions{})
+			c.Assert(err, IsNil)
+			err = sIndexer.Add(setting)
+			c.Assert(err, IsNil)
+		}
+
+		kubeNode := &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   TestNode1,
+				Labels: tc.nodeLabels,
+			},
+		}
+		err := knIndexer.Add(kubeNode)
+		c.Assert(err, IsNil)
+
+		node := newNode(TestNode1, TestNamespace, TestZone1, true, longhorn.ConditionStatusTrue)
+		node.Spec.SettingOverrides = tc.nodeSettingOverrides
+		disk := newDisk(TestDefaultDataPath, true, 0)
+		disk.SettingOverrides = tc.diskSettingOverrides
+
+		info, err := rcs.GetDiskSchedulingInfo(node, disk, &longhorn.DiskStatus{})
+		c.Assert(err, IsNil)
+		c.Assert(info.OverProvisioningPercentage, Equals, tc.expectedOverProvisioningPercentage)
+		c.Assert(info.MinimalAvailablePercentage, Equals, tc.expectedMinimalAvailablePercentage)
+	}
+}
+
 func getTestNow() time.Time {
 	now, _ := time.Parse(time.RFC3339, TestTimeNow)
 	return now
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+const (
+	// EnvBackupStoreBandwidthLimit is the environment variable passed along with the backup target credential to
+	// the backupstore command of the manager binary. It holds the JSON encoded bandwidth limits capping the transfer.
+	EnvBackupStoreBandwidthLimit = "BACKUPSTORE_BANDWIDTH_LIMIT"
+
+	bandwidthLimitWindowTimeLayout = "15:04"
+)
+
+// ValidateBandwidthLimit checks the caps are not negative and the window times are in the format "HH:MM"
+func ValidateBandwidthLimit(limit longhorn.BandwidthLimit) error {
+	if limit.BackupBytesPerSecond < 0 || limit.RestoreBytesPerSecond < 0 {
+		return fmt.Errorf("bandwidth limit cannot be negative")
+	}
+	for _, window := range limit.Windows {
+		if _, err := time.Parse(bandwidthLimitWindowTimeLayout, window.Start); err != nil {
+			return fmt.Errorf("invalid bandwidth limit window start time %v: %v", window.Start, err)
+		}
+		if _, err := time.Parse(bandwidthLimitWindowTimeLayout, window.End); err != nil {
+			return fmt.Errorf("invalid bandwidth limit window end time %v: %v", window.End, err)
+		}
+		if window.BackupBytesPerSecond < 0 || window.RestoreBytesPerSecond < 0 {
+			return fmt.Errorf("bandwidth limit of window %v-%v cannot be negative", window.Start, window.End)
+		}
+	}
+	return nil
+}
+
+// GetEffectiveBandwidthLimit returns the backup and restore caps in bytes per second at the given time.
+// The caps of each limit come from the first window covering the time, or the limit itself if there is none.
+// The strictest non-zero cap among the limits is applied, and 0 means unlimited.
+func GetEffectiveBandwidthLimit(now time.Time, limits ...longhorn.BandwidthLimit) (backupBytesPerSecond, restoreBytesPerSecond int64) {
+	for _, limit := range limits {
+		backup, restore := limit.BackupBytesPerSecond, limit.RestoreBytesPerSecond
+		for _, window := range limit.Windows {
+			if isTimeInBandwidthLimitWindow(now, window) {
+				backup, restore = window.BackupBytesPerSecond, window.RestoreBytesPerSecond
+				break
+			}
+		}
+		backupBytesPerSecond = MinBandwidthLimit(backupBytesPerSecond, backup)
+		restoreBytesPerSecond = MinBandwidthLimit(restoreBytesPerSecond, restore)
+	}
+	return backupBytesPerSecond, restoreBytesPerSecond
+}
+
+func isTimeInBandwidthLimitWindow(now time.Time, window longhorn.BandwidthLimitWindow) bool {
+	start, err := time.Parse(bandwidthLimitWindowTimeLayout, window.Start)
+	if err != nil {
+		return false
+	}
+	end, err := time.Parse(bandwidthLimitWindowTimeLayout, window.End)
+	if err != nil {
+		return false
+	}
+
+	now = now.UTC()
+	minutes := now.Hour()*60 + now.Minute()
+	startMinutes := start.Hour()*60 + start.Minute()
+	endMinutes := end.Hour()*60 + end.Minute()
+	if startMinutes <= endMinutes {
+		return minutes >= startMinutes && minutes < endMinutes
+	}
+	// The window wraps around midnight
+	return minutes >= startMinutes || minutes < endMinutes
+}
+
+// MinBandwidthLimit returns the stricter of the two caps, where 0 means unlimited
+func MinBandwidthLimit(a, b int64) int64 {
+	if a == 0 {
+		return b
+	}
+	if b == 0 || a < b {
+		return a
+	}
+	return b
+}
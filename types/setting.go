@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -14,6 +16,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	"github.com/longhorn/longhorn-manager/meta"
 	"github.com/longhorn/longhorn-manager/util"
@@ -66,6 +69,8 @@ const (
 	SettingNameStorageReservedPercentageForDefaultDisk                  = SettingName("storage-reserved-percentage-for-default-disk")
 	SettingNameUpgradeChecker                                           = SettingName("upgrade-checker")
 	SettingNameUpgradeResponderURL                                      = SettingName("upgrade-responder-url")
+	SettingNameUpgradeCheckerBackend                                    = SettingName("upgrade-checker-backend")
+	SettingNameUpgradeCheckerLocalManifest                              = SettingName("upgrade-checker-local-manifest")
 	SettingNameAllowCollectingLonghornUsage                             = SettingName("allow-collecting-longhorn-usage-metrics")
 	SettingNameCurrentLonghornVersion                                   = SettingName("current-longhorn-version")
 	SettingNameLatestLonghornVersion                                    = SettingName("latest-longhorn-version")
@@ -110,6 +115,9 @@ const (
 	SettingNameSupportBundleNodeCollectionTimeout                       = SettingName("support-bundle-node-collection-timeout")
 	SettingNameDeletingConfirmationFlag                                 = SettingName("deleting-confirmation-flag")
 	SettingNameEngineReplicaTimeout                                     = SettingName("engine-replica-timeout")
+	SettingNameSlowReplicaLatencyFactor                                 = SettingName("slow-replica-latency-factor")
+	SettingNameSlowReplicaDetectionWindow                               = SettingName("slow-replica-detection-window")
+	SettingNameSlowReplicaAutoReplacement                               = SettingName("slow-replica-auto-replacement")
 	SettingNameSnapshotDataIntegrity                                    = SettingName("snapshot-data-integrity")
 	SettingNameSnapshotDataIntegrityImmediateCheckAfterSnapshotCreation = SettingName("snapshot-data-integrity-immediate-check-after-snapshot-creation")
 	SettingNameSnapshotDataIntegrityCronJob                             = SettingName("snapshot-data-integrity-cronjob")
@@ -140,6 +148,7 @@ const (
 	SettingNameAutoCleanupSnapshotAfterOnDemandBackupCompleted          = SettingName("auto-cleanup-snapshot-after-on-demand-backup-completed")
 	SettingNameDefaultMinNumberOfBackingImageCopies                     = SettingName("default-min-number-of-backing-image-copies")
 	SettingNameBackupExecutionTimeout                                   = SettingName("backup-execution-timeout")
+	SettingNameVolumeHealthBackupRPO                                    = SettingName("volume-health-backup-rpo")
 	SettingNameRWXVolumeFastFailover                                    = SettingName("rwx-volume-fast-failover")
 	// These three backup target parameters are used in the "longhorn-default-resource" ConfigMap
 	// to update the default BackupTarget resource.
@@ -166,6 +175,8 @@ var (
 		SettingNameStorageReservedPercentageForDefaultDisk,
 		SettingNameUpgradeChecker,
 		SettingNameUpgradeResponderURL,
+		SettingNameUpgradeCheckerBackend,
+		SettingNameUpgradeCheckerLocalManifest,
 		SettingNameAllowCollectingLonghornUsage,
 		SettingNameCurrentLonghornVersion,
 		SettingNameLatestLonghornVersion,
@@ -210,6 +221,9 @@ var (
 		SettingNameSupportBundleNodeCollectionTimeout,
 		SettingNameDeletingConfirmationFlag,
 		SettingNameEngineReplicaTimeout,
+		SettingNameSlowReplicaLatencyFactor,
+		SettingNameSlowReplicaDetectionWindow,
+		SettingNameSlowReplicaAutoReplacement,
 		SettingNameSnapshotDataIntegrity,
 		SettingNameSnapshotDataIntegrityCronJob,
 		SettingNameSnapshotDataIntegrityImmediateCheckAfterSnapshotCreation,
@@ -240,6 +254,7 @@ var (
 		SettingNameAutoCleanupSnapshotAfterOnDemandBackupCompleted,
 		SettingNameDefaultMinNumberOfBackingImageCopies,
 		SettingNameBackupExecutionTimeout,
+		SettingNameVolumeHealthBackupRPO,
 		SettingNameRWXVolumeFastFailover,
 	}
 )
@@ -288,6 +303,8 @@ var (
 		SettingNameStorageReservedPercentageForDefaultDisk:                  SettingDefinitionStorageReservedPercentageForDefaultDisk,
 		SettingNameUpgradeChecker:                                           SettingDefinitionUpgradeChecker,
 		SettingNameUpgradeResponderURL:                                      SettingDefinitionUpgradeResponderURL,
+		SettingNameUpgradeCheckerBackend:                                    SettingDefinitionUpgradeCheckerBackend,
+		SettingNameUpgradeCheckerLocalManifest:                              SettingDefinitionUpgradeCheckerLocalManifest,
 		SettingNameAllowCollectingLonghornUsage:                             SettingDefinitionAllowCollectingLonghornUsageMetrics,
 		SettingNameCurrentLonghornVersion:                                   SettingDefinitionCurrentLonghornVersion,
 		SettingNameLatestLonghornVersion:                                    SettingDefinitionLatestLonghornVersion,
@@ -332,6 +349,9 @@ var (
 		SettingNameSupportBundleNodeCollectionTimeout:                       SettingDefinitionSupportBundleNodeCollectionTimeout,
 		SettingNameDeletingConfirmationFlag:                                 SettingDefinitionDeletingConfirmationFlag,
 		SettingNameEngineReplicaTimeout:                                     SettingDefinitionEngineReplicaTimeout,
+		SettingNameSlowReplicaLatencyFactor:                                 SettingDefinitionSlowReplicaLatencyFactor,
+		SettingNameSlowReplicaDetectionWindow:                               SettingDefinitionSlowReplicaDetectionWindow,
+		SettingNameSlowReplicaAutoReplacement:                               SettingDefinitionSlowReplicaAutoReplacement,
 		SettingNameSnapshotDataIntegrity:                                    SettingDefinitionSnapshotDataIntegrity,
 		SettingNameSnapshotDataIntegrityImmediateCheckAfterSnapshotCreation: SettingDefinitionSnapshotDataIntegrityImmediateCheckAfterSnapshotCreation,
 		SettingNameSnapshotDataIntegrityCronJob:                             SettingDefinitionSnapshotDataIntegrityCronJob,
@@ -362,6 +382,7 @@ var (
 		SettingNameAutoCleanupSnapshotAfterOnDemandBackupCompleted:          SettingDefinitionAutoCleanupSnapshotAfterOnDemandBackupCompleted,
 		SettingNameDefaultMinNumberOfBackingImageCopies:                     SettingDefinitionDefaultMinNumberOfBackingImageCopies,
 		SettingNameBackupExecutionTimeout:                                   SettingDefinitionBackupExecutionTimeout,
+		SettingNameVolumeHealthBackupRPO:                                    SettingDefinitionVolumeHealthBackupRPO,
 		SettingNameRWXVolumeFastFailover:                                    SettingDefinitionRWXVolumeFastFailover,
 	}
 
@@ -406,6 +427,21 @@ var (
 		},
 	}
 
+	SettingDefinitionVolumeHealthBackupRPO = SettingDefinition{
+		DisplayName: "Volume Health Backup RPO",
+		Description: "In minutes. The target recovery point objective of the volumes. " +
+			"A volume whose last backup is older than this value, or which has never been backed up, does not meet the health condition **BackupRPO**. " +
+			"Set to 0 to disable the check.",
+		Category: SettingCategoryBackup,
+		Type:     SettingTypeInt,
+		Required: true,
+		ReadOnly: false,
+		Default:  "0",
+		ValueIntRange: map[string]int{
+			ValueIntRangeMinimum: 0,
+		},
+	}
+
 	SettingDefinitionRestoreVolumeRecurringJobs = SettingDefinition{
 		DisplayName: "Restore Volume Recurring Jobs",
 		Description: "Restore recurring jobs from the backup volume on the backup target and create recurring jobs if not exist during a backup restoration.\n\n" +
@@ -601,6 +637,34 @@ var (
 		Default:     "https://longhorn-upgrade-responder.rancher.io/v1/checkupgrade",
 	}
 
+	SettingDefinitionUpgradeCheckerBackend = SettingDefinition{
+		DisplayName: "Upgrade Checker Backend",
+		Description: "The source the Upgrade Checker gets the available Longhorn versions and advisories from. Available options:\n" +
+			"- **upgrade-responder**. The Upgrade Checker posts the cluster info to the Upgrade Responder URL.\n" +
+			"- **local-manifest**. The Upgrade Checker reads the version manifest specified by the Upgrade Checker Local Manifest setting. Use this option for air-gapped clusters.\n",
+		Category: SettingCategoryGeneral,
+		Type:     SettingTypeString,
+		Required: true,
+		ReadOnly: false,
+		Default:  string(UpgradeCheckerBackendUpgradeResponder),
+		Choices: []string{
+			string(UpgradeCheckerBackendUpgradeResponder),
+			string(UpgradeCheckerBackendLocalManifest),
+		},
+	}
+
+	SettingDefinitionUpgradeCheckerLocalManifest = SettingDefinition{
+		DisplayName: "Upgrade Checker Local Manifest",
+		Description: "The location of the version manifest used by the Upgrade Checker with the local-manifest backend. " +
+			"The value is either `configmap://<name>` for a ConfigMap in the Longhorn namespace storing the manifest in the `" + UpgradeCheckerLocalManifestConfigMapKey + "` key, " +
+			"or an absolute path of a manifest file mounted into the longhorn-manager pods.",
+		Category: SettingCategoryGeneral,
+		Type:     SettingTypeString,
+		Required: false,
+		ReadOnly: false,
+		Default:  UpgradeCheckerLocalManifestConfigMapPrefix + "longhorn-upgrade-manifest",
+	}
+
 	SettingDefinitionAllowCollectingLonghornUsageMetrics = SettingDefinition{
 		DisplayName: "Allow Collecting Longhorn Usage Metrics",
 		Description: "Enabling this setting will allow Longhorn to provide additional usage metrics to https://metrics.longhorn.io/.\n" +
@@ -1182,6 +1246,44 @@ var (
 		},
 	}
 
+	SettingDefinitionSlowReplicaLatencyFactor = SettingDefinition{
+		DisplayName: "Slow Replica Latency Factor",
+		Description: "A replica is considered slow if its read or write latency observed by the engine exceeds the median latency of the healthy replicas of the volume by this factor. " +
+			"The slow replica is marked with the condition **Slow** once it stays slow for the **Slow Replica Detection Window**. Set to 0 or 1 to disable the detection.",
+		Category: SettingCategoryGeneral,
+		Type:     SettingTypeInt,
+		Required: true,
+		ReadOnly: false,
+		Default:  "5",
+		ValueIntRange: map[string]int{
+			ValueIntRangeMinimum: 0,
+		},
+	}
+
+	SettingDefinitionSlowReplicaDetectionWindow = SettingDefinition{
+		DisplayName: "Slow Replica Detection Window",
+		Description: "In seconds. The setting specifies how long a replica must stay slow before it is marked with the condition **Slow**. The default value is 300 seconds.",
+		Category:    SettingCategoryGeneral,
+		Type:        SettingTypeInt,
+		Required:    true,
+		ReadOnly:    false,
+		Default:     "300",
+		ValueIntRange: map[string]int{
+			ValueIntRangeMinimum: 30,
+		},
+	}
+
+	SettingDefinitionSlowReplicaAutoReplacement = SettingDefinition{
+		DisplayName: "Slow Replica Auto Replacement",
+		Description: "If this setting is enabled, Longhorn will evict the replicas marked with the condition **Slow**. " +
+			"A new replica is scheduled and rebuilt before the slow replica is removed.",
+		Category: SettingCategoryGeneral,
+		Type:     SettingTypeBool,
+		Required: true,
+		ReadOnly: false,
+		Default:  "false",
+	}
+
 	SettingDefinitionSnapshotDataIntegrity = SettingDefinition{
 		DisplayName: "Snapshot Data Integrity",
 		Description: "This setting allows users to enable or disable snapshot hashing and data integrity checking. \n\n" +
@@ -1535,6 +1637,16 @@ const (
 	NodeDrainPolicyAlwaysAllow                           = NodeDrainPolicy("always-allow")
 )
 
+type UpgradeCheckerBackend string
+
+const (
+	UpgradeCheckerBackendUpgradeResponder = UpgradeCheckerBackend("upgrade-responder")
+	UpgradeCheckerBackendLocalManifest    = UpgradeCheckerBackend("local-manifest")
+
+	UpgradeCheckerLocalManifestConfigMapPrefix = "configmap://"
+	UpgradeCheckerLocalManifestConfigMapKey    = "manifest.json"
+)
+
 type SystemManagedPodsImagePullPolicy string
 
 const (
@@ -1584,6 +1696,20 @@ func ValidateSetting(name, value string) (err error) {
 	return nil
 }
 
+// ValidateSettingsConsistency checks the constraints across the settings. The given values should contain all
+// settings the constraints depend on.
+func ValidateSettingsConsistency(values map[SettingName]string) error {
+	if values[SettingNameAutoCleanupSystemGeneratedSnapshot] == "true" && values[SettingNameDisableSnapshotPurge] == "true" {
+		return fmt.Errorf("cannot set both %v and %v settings to true", SettingNameAutoCleanupSystemGeneratedSnapshot, SettingNameDisableSnapshotPurge)
+	}
+
+	if values[SettingNameStorageNetworkForRWXVolumeEnabled] == "true" && values[SettingNameStorageNetwork] == CniNetworkNone {
+		return fmt.Errorf("cannot set %v setting to true when %v setting is empty", SettingNameStorageNetworkForRWXVolumeEnabled, SettingNameStorageNetwork)
+	}
+
+	return nil
+}
+
 // isValidChoice checks if the passed value is part of the choices array,
 // an empty choices array allows for all values
 func isValidChoice(choices []string, value string) bool {
@@ -1749,6 +1875,45 @@ func SetSettingDefinition(name SettingName, definition SettingDefinition) {
 	settingDefinitions[name] = definition
 }
 
+// NodeOverridableSettings can be overridden per node by the node spec or the Kubernetes node label.
+var NodeOverridableSettings = []SettingName{
+	SettingNameGuaranteedInstanceManagerCPU,
+	SettingNameConcurrentReplicaRebuildPerNodeLimit,
+	SettingNameStorageReservedPercentageForDefaultDisk,
+	SettingNameReplicaAutoBalanceDiskPressurePercentage,
+	SettingNameStorageOverProvisioningPercentage,
+	SettingNameStorageMinimalAvailablePercentage,
+}
+
+// DiskOverridableSettings can be overridden per disk by the disk spec.
+var DiskOverridableSettings = []SettingName{
+	SettingNameReplicaAutoBalanceDiskPressurePercentage,
+	SettingNameStorageOverProvisioningPercentage,
+	SettingNameStorageMinimalAvailablePercentage,
+}
+
+func GetNodeSettingOverrideLabelKey(name SettingName) string {
+	return NodeSettingOverrideLabelKeyPrefix + string(name)
+}
+
+// ValidateSettingOverrides checks the overridden settings are in the overridable settings and the values are valid.
+func ValidateSettingOverrides(overrides map[string]string, overridableSettings []SettingName) error {
+	for name, value := range overrides {
+		if !slices.Contains(overridableSettings, SettingName(name)) {
+			return fmt.Errorf("setting %v cannot be overridden, overridable settings are %v", name, overridableSettings)
+		}
+		if err := ValidateSetting(name, value); err != nil {
+			return err
+		}
+		if SettingName(name) == SettingNameGuaranteedInstanceManagerCPU {
+			if err := ValidateCPUReservationValues(SettingName(name), value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func GetDangerZoneSettings() sets.Set[SettingName] {
 	settingList := sets.New[SettingName]()
 	for settingName, setting := range settingDefinitions {
@@ -1839,7 +2004,31 @@ func validateString(sName SettingName, definition SettingDefinition, value strin
 		if err := ValidateV2DataEngineLogFlags(value); err != nil {
 			return errors.Wrapf(err, "failed to validate v2 data engine log flags %v", value)
 		}
+
+	case SettingNameUpgradeCheckerLocalManifest:
+		if value == "" {
+			return nil
+		}
+		if _, _, err := ParseUpgradeCheckerLocalManifest(value); err != nil {
+			return errors.Wrapf(err, "the value of %v is invalid", sName)
+		}
 	}
 
 	return nil
 }
+
+// ParseUpgradeCheckerLocalManifest returns either the ConfigMap name or the file path of the local version manifest.
+func ParseUpgradeCheckerLocalManifest(value string) (configMapName, filePath string, err error) {
+	if strings.HasPrefix(value, UpgradeCheckerLocalManifestConfigMapPrefix) {
+		configMapName = strings.TrimPrefix(value, UpgradeCheckerLocalManifestConfigMapPrefix)
+		if errs := validation.IsDNS1123Subdomain(configMapName); len(errs) > 0 {
+			return "", "", fmt.Errorf("invalid ConfigMap name %v: %v", configMapName, strings.Join(errs, ", "))
+		}
+		return configMapName, "", nil
+	}
+
+	if !filepath.IsAbs(value) {
+		return "", "", fmt.Errorf("manifest %v should be either %v<name> or an absolute file path", value, UpgradeCheckerLocalManifestConfigMapPrefix)
+	}
+	return "", filepath.Clean(value), nil
+}
@@ -4,6 +4,7 @@ const (
 	SystemRolloutDirTemp = "/tmp"
 
 	SystemBackupExtension           = ".zip"
+	SystemBackupMetadataFile        = "metadata.yaml"
 	SystemBackupSubDirLonghorn      = "longhorn"
 	SystemBackupSubDirKubernetes    = "kubernetes"
 	SystemBackupSubDirAPIExtensions = "apiextensions"
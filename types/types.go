@@ -42,6 +42,8 @@ const (
 	LonghornKindBackingImageManager = "BackingImageManager"
 	LonghornKindRecurringJob        = "RecurringJob"
 	LonghornKindSetting             = "Setting"
+	LonghornKindSettingsProfile     = "SettingsProfile"
+	LonghornKindAlertRule           = "AlertRule"
 	LonghornKindSupportBundle       = "SupportBundle"
 	LonghornKindSystemBackup        = "SystemBackup"
 	LonghornKindSystemRestore       = "SystemRestore"
@@ -129,12 +131,24 @@ const (
 	NodeDisableV2DataEngineLabelKeyTrue       = "true"
 	KubeNodeDefaultDiskConfigAnnotationKey    = "node.longhorn.io/default-disks-config"
 	KubeNodeDefaultNodeTagConfigAnnotationKey = "node.longhorn.io/default-node-tags"
+	// The prefix of the Kubernetes node label overriding a setting for the node, followed by the setting name
+	NodeSettingOverrideLabelKeyPrefix = "setting.node.longhorn.io/"
 
 	LastAppliedTolerationAnnotationKeySuffix = "last-applied-tolerations"
 
+	// The W3C trace context of the request that last changed the resource spec and the time it was recorded at,
+	// which link the reconciliations of the resource to the trace of the request
+	TraceParentAnnotationKeySuffix     = "trace-parent"
+	TraceRecordedAtAnnotationKeySuffix = "trace-recorded-at"
+
 	ConfigMapResourceVersionKey = "configmap-resource-version"
 	UpdateSettingFromLonghorn   = "update-setting-from-longhorn"
 
+	SettingRevisionAnnotationKeySuffix      = "setting-revision"
+	SettingChangedByAnnotationKeySuffix     = "setting-changed-by"
+	SettingChangedAtAnnotationKeySuffix     = "setting-changed-at"
+	SettingPreviousValueAnnotationKeySuffix = "setting-previous-value"
+
 	DeleteCustomResourceOnly = "delete-custom-resource-only"
 
 	// const value `DeleteBackupTargetFromLonghorn` is used for annotation to note that deleting backup target is by Longhorn during uninstalling.
@@ -237,6 +251,7 @@ const (
 const (
 	RecurringJobParameterFullBackupInterval = "full-backup-interval"
 	RecurringJobParameterVolumeBackupPolicy = "volume-backup-policy"
+	RecurringJobParameterBackupTarget       = "backup-target"
 )
 
 const (
@@ -254,6 +269,8 @@ const (
 	BackupStoreTypeCIFS   = "cifs"
 	BackupStoreTypeNFS    = "nfs"
 	BackupStoreTypeAZBlob = "azblob"
+	// BackupStoreTypeFile is the local directory backup target for testing, e.g. file:///var/lib/longhorn-backupstore
+	BackupStoreTypeFile = "file"
 
 	AWSIAMRoleAnnotation = "iam.amazonaws.com/role"
 	AWSIAMRoleArn        = "AWS_IAM_ROLE_ARN"
@@ -985,6 +1002,24 @@ func ValidateFreezeFilesystemForSnapshot(value longhorn.FreezeFilesystemForSnaps
 	return nil
 }
 
+func ValidateVolumeIOQoS(qos longhorn.VolumeIOQoS) error {
+	if qos.MaxReadIOPS < 0 || qos.MaxWriteIOPS < 0 || qos.MaxReadBandwidth < 0 || qos.MaxWriteBandwidth < 0 {
+		return fmt.Errorf("invalid IO QoS %+v: limits cannot be negative", qos)
+	}
+	if qos.BurstSeconds < 0 {
+		return fmt.Errorf("invalid IO QoS %+v: burst seconds cannot be negative", qos)
+	}
+	if qos.BurstSeconds > 0 && !IsVolumeIOQoSLimited(qos) {
+		return fmt.Errorf("invalid IO QoS %+v: burst seconds requires at least one limit", qos)
+	}
+	return nil
+}
+
+// IsVolumeIOQoSLimited returns true if any IOPS or bandwidth limit is set.
+func IsVolumeIOQoSLimited(qos longhorn.VolumeIOQoS) bool {
+	return qos.MaxReadIOPS > 0 || qos.MaxWriteIOPS > 0 || qos.MaxReadBandwidth > 0 || qos.MaxWriteBandwidth > 0
+}
+
 func GetDaemonSetNameFromEngineImageName(engineImageName string) string {
 	return "engine-image-" + engineImageName
 }
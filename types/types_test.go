@@ -4,12 +4,15 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
 	corev1 "k8s.io/api/core/v1"
 
 	. "gopkg.in/check.v1"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
 
 const (
@@ -233,3 +236,184 @@ func (s *TestSuite) TestGenerateEngineNameForVolume(c *C) {
 		c.Assert(actual, Equals, testCase.expectedEngineName, Commentf(TestErrResultFmt, testName))
 	}
 }
+
+func (s *TestSuite) TestGetEffectiveBandwidthLimit(c *C) {
+	type testCase struct {
+		now    time.Time
+		limits []longhorn.BandwidthLimit
+
+		expectedBackup  int64
+		expectedRestore int64
+	}
+	nightWindow := longhorn.BandwidthLimitWindow{
+		Start:                 "22:00",
+		End:                   "06:00",
+		BackupBytesPerSecond:  100,
+		RestoreBytesPerSecond: 0,
+	}
+	testCases := map[string]testCase{
+		"no limit": {
+			now:             time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+			limits:          []longhorn.BandwidthLimit{{}, {}},
+			expectedBackup:  0,
+			expectedRestore: 0,
+		},
+		"strictest non-zero limit": {
+			now: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+			limits: []longhorn.BandwidthLimit{
+				{BackupBytesPerSecond: 300, RestoreBytesPerSecond: 0},
+				{BackupBytesPerSecond: 200, RestoreBytesPerSecond: 500},
+			},
+			expectedBackup:  200,
+			expectedRestore: 500,
+		},
+		"window wrapping around midnight": {
+			now: time.Date(2024, 1, 1, 23, 30, 0, 0, time.UTC),
+			limits: []longhorn.BandwidthLimit{
+				{BackupBytesPerSecond: 300, RestoreBytesPerSecond: 400, Windows: []longhorn.BandwidthLimitWindow{nightWindow}},
+			},
+			expectedBackup:  100,
+			expectedRestore: 0,
+		},
+		"outside window": {
+			now: time.Date(2024, 1, 1, 6, 0, 0, 0, time.UTC),
+			limits: []longhorn.BandwidthLimit{
+				{BackupBytesPerSecond: 300, RestoreBytesPerSecond: 400, Windows: []longhorn.BandwidthLimitWindow{nightWindow}},
+			},
+			expectedBackup:  300,
+			expectedRestore: 400,
+		},
+	}
+
+	for testName, testCase := range testCases {
+		fmt.Printf("testing %v\n", testName)
+
+		backup, restore := GetEffectiveBandwidthLimit(testCase.now, testCase.limits...)
+		c.Assert(backup, Equals, testCase.expectedBackup, Commentf(TestErrResultFmt, testName))
+		c.Assert(restore, Equals, testCase.expectedRestore, Commentf(TestErrResultFmt, testName))
+	}
+}
+
+func (s *TestSuite) TestValidateSettingsConsistency(c *C) {
+	type testCase struct {
+		values map[SettingName]string
+
+		expectError bool
+	}
+	testCases := map[string]testCase{
+		"consistent settings": {
+			values: map[SettingName]string{
+				SettingNameAutoCleanupSystemGeneratedSnapshot: "true",
+				SettingNameDisableSnapshotPurge:               "false",
+				SettingNameStorageNetwork:                     "longhorn-system/storage",
+				SettingNameStorageNetworkForRWXVolumeEnabled:  "true",
+			},
+			expectError: false,
+		},
+		"snapshot auto cleanup with purge disabled": {
+			values: map[SettingName]string{
+				SettingNameAutoCleanupSystemGeneratedSnapshot: "true",
+				SettingNameDisableSnapshotPurge:               "true",
+			},
+			expectError: true,
+		},
+		"storage network for RWX volumes without storage network": {
+			values: map[SettingName]string{
+				SettingNameStorageNetwork:                    CniNetworkNone,
+				SettingNameStorageNetworkForRWXVolumeEnabled: "true",
+			},
+			expectError: true,
+		},
+	}
+
+	for testName, testCase := range testCases {
+		fmt.Printf("testing %v\n", testName)
+
+		err := ValidateSettingsConsistency(testCase.values)
+		if testCase.expectError {
+			c.Assert(err, NotNil, Commentf(TestErrResultFmt, testName))
+		} else {
+			c.Assert(err, IsNil, Commentf(TestErrErrorFmt, testName, err))
+		}
+	}
+}
+
+func (s *TestSuite) TestParseUpgradeCheckerLocalManifest(c *C) {
+	type testCase struct {
+		value string
+
+		expectedConfigMapName string
+		expectedFilePath      string
+		expectError           bool
+	}
+	testCases := map[string]testCase{
+		"ConfigMap": {
+			value:                 UpgradeCheckerLocalManifestConfigMapPrefix + "longhorn-upgrade-manifest",
+			expectedConfigMapName: "longhorn-upgrade-manifest",
+		},
+		"invalid ConfigMap name": {
+			value:       UpgradeCheckerLocalManifestConfigMapPrefix + "Invalid_Name",
+			expectError: true,
+		},
+		"file": {
+			value:            "/etc/longhorn/../longhorn/manifest.json",
+			expectedFilePath: "/etc/longhorn/manifest.json",
+		},
+		"relative file path": {
+			value:       "manifest.json",
+			expectError: true,
+		},
+	}
+
+	for testName, testCase := range testCases {
+		fmt.Printf("testing %v\n", testName)
+
+		configMapName, filePath, err := ParseUpgradeCheckerLocalManifest(testCase.value)
+		if testCase.expectError {
+			c.Assert(err, NotNil, Commentf(TestErrResultFmt, testName))
+			continue
+		}
+		c.Assert(err, IsNil, Commentf(TestErrErrorFmt, testName, err))
+		c.Assert(configMapName, Equals, testCase.expectedConfigMapName)
+		c.Assert(filePath, Equals, testCase.expectedFilePath)
+	}
+}
+
+func (s *TestSuite) TestValidateVolumeIOQoS(c *C) {
+	type testCase struct {
+		ioQoS longhorn.VolumeIOQoS
+
+		expectError bool
+	}
+	testCases := map[string]testCase{
+		"unlimited": {
+			ioQoS: longhorn.VolumeIOQoS{},
+		},
+		"limited with burst": {
+			ioQoS: longhorn.VolumeIOQoS{MaxReadIOPS: 1000, MaxWriteBandwidth: 100 * 1024 * 1024, BurstSeconds: 10},
+		},
+		"negative limit": {
+			ioQoS:       longhorn.VolumeIOQoS{MaxWriteIOPS: -1},
+			expectError: true,
+		},
+		"negative burst": {
+			ioQoS:       longhorn.VolumeIOQoS{MaxReadIOPS: 1000, BurstSeconds: -1},
+			expectError: true,
+		},
+		"burst without limit": {
+			ioQoS:       longhorn.VolumeIOQoS{BurstSeconds: 10},
+			expectError: true,
+		},
+	}
+
+	for testName, testCase := range testCases {
+		fmt.Printf("testing %v\n", testName)
+
+		err := ValidateVolumeIOQoS(testCase.ioQoS)
+		if testCase.expectError {
+			c.Assert(err, NotNil, Commentf(TestErrResultFmt, testName))
+			continue
+		}
+		c.Assert(err, IsNil, Commentf(TestErrErrorFmt, testName, err))
+	}
+}
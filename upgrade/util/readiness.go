@@ -0,0 +1,288 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"golang.org/x/mod/semver"
+
+	"github.com/pkg/errors"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	emeta "github.com/longhorn/longhorn-engine/pkg/meta"
+
+	"github.com/longhorn/longhorn-manager/types"
+
+	longhornapis "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn"
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	lhclientset "github.com/longhorn/longhorn-manager/k8s/pkg/client/clientset/versioned"
+)
+
+const (
+	// The Longhorn version removing the v1beta1 API. The resources stored in v1beta1 are migrated to v1beta2 during
+	// the upgrade to v1.9.x.
+	longhornV1beta1RemovedVersion = "v1.10.0"
+)
+
+// UpgradeReadinessTarget is the version the upgrade readiness is checked against.
+type UpgradeReadinessTarget struct {
+	Version string
+	// The engine API versions of the default engine image of the target version. The engine images are not checked if
+	// it is unknown.
+	EngineVersion *emeta.VersionOutput
+}
+
+// UpgradeReadinessIssue is a blocker or a warning found by the upgrade readiness check.
+type UpgradeReadinessIssue struct {
+	Kind    string `json:"kind"`
+	Name    string `json:"name"`
+	Message string `json:"message"`
+}
+
+// UpgradeReadinessReport reports if it is safe to upgrade to the target version. The upgrade should not be started
+// if there is any blocker.
+type UpgradeReadinessReport struct {
+	CurrentVersion string                  `json:"currentVersion"`
+	TargetVersion  string                  `json:"targetVersion"`
+	Ready          bool                    `json:"ready"`
+	Blockers       []UpgradeReadinessIssue `json:"blockers"`
+	Warnings       []UpgradeReadinessIssue `json:"warnings"`
+}
+
+// UpgradeReadinessResources are the existing resources inspected by the upgrade readiness check.
+type UpgradeReadinessResources struct {
+	CurrentVersion string
+	Volumes        []*longhorn.Volume
+	EngineImages   []*longhorn.EngineImage
+	BackingImages  []*longhorn.BackingImage
+	Settings       []*longhorn.Setting
+	CRDs           []*apiextensionsv1.CustomResourceDefinition
+}
+
+// ListUpgradeReadinessResources lists the resources inspected by the upgrade readiness check from the API server.
+func ListUpgradeReadinessResources(namespace string, lhClient lhclientset.Interface, extensionsClient apiextensionsclientset.Interface) (*UpgradeReadinessResources, error) {
+	currentVersion, err := GetCurrentLonghornVersion(namespace, lhClient)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get current Longhorn version")
+	}
+	resources := &UpgradeReadinessResources{
+		CurrentVersion: currentVersion,
+	}
+
+	volumes, err := lhClient.LonghornV1beta2().Volumes(namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list volumes")
+	}
+	for i := range volumes.Items {
+		resources.Volumes = append(resources.Volumes, &volumes.Items[i])
+	}
+
+	engineImages, err := lhClient.LonghornV1beta2().EngineImages(namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list engine images")
+	}
+	for i := range engineImages.Items {
+		resources.EngineImages = append(resources.EngineImages, &engineImages.Items[i])
+	}
+
+	backingImages, err := lhClient.LonghornV1beta2().BackingImages(namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list backing images")
+	}
+	for i := range backingImages.Items {
+		resources.BackingImages = append(resources.BackingImages, &backingImages.Items[i])
+	}
+
+	settings, err := lhClient.LonghornV1beta2().Settings(namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list settings")
+	}
+	for i := range settings.Items {
+		resources.Settings = append(resources.Settings, &settings.Items[i])
+	}
+
+	crds, err := extensionsClient.ApiextensionsV1().CustomResourceDefinitions().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list CustomResourceDefinitions")
+	}
+	for i := range crds.Items {
+		if crds.Items[i].Spec.Group != longhornapis.GroupName {
+			continue
+		}
+		resources.CRDs = append(resources.CRDs, &crds.Items[i])
+	}
+
+	return resources, nil
+}
+
+// CheckUpgradeReadiness inspects the resources against the requirements of the target version and reports the
+// blockers and the warnings of the upgrade.
+func CheckUpgradeReadiness(resources *UpgradeReadinessResources, target UpgradeReadinessTarget) *UpgradeReadinessReport {
+	r := &UpgradeReadinessReport{
+		CurrentVersion: resources.CurrentVersion,
+		TargetVersion:  target.Version,
+		Blockers:       []UpgradeReadinessIssue{},
+		Warnings:       []UpgradeReadinessIssue{},
+	}
+
+	if resources.CurrentVersion != "" {
+		if err := checkLHUpgradePathTo(resources.CurrentVersion, target.Version); err != nil {
+			r.addBlocker(types.LonghornKindSetting, string(types.SettingNameCurrentLonghornVersion), err.Error())
+		}
+	}
+
+	r.checkVolumes(resources.Volumes)
+	r.checkEngineImages(resources.EngineImages, target)
+	r.checkBackingImages(resources.BackingImages)
+	r.checkSettings(resources.Settings)
+	r.checkCRDs(resources.CRDs, target)
+
+	r.Ready = len(r.Blockers) == 0
+	return r
+}
+
+func (r *UpgradeReadinessReport) addBlocker(kind, name, message string) {
+	r.Blockers = append(r.Blockers, UpgradeReadinessIssue{Kind: kind, Name: name, Message: message})
+}
+
+func (r *UpgradeReadinessReport) addWarning(kind, name, message string) {
+	r.Warnings = append(r.Warnings, UpgradeReadinessIssue{Kind: kind, Name: name, Message: message})
+}
+
+func (r *UpgradeReadinessReport) checkVolumes(volumes []*longhorn.Volume) {
+	sort.Slice(volumes, func(i, j int) bool { return volumes[i].Name < volumes[j].Name })
+
+	for _, v := range volumes {
+		switch v.Status.Robustness {
+		case longhorn.VolumeRobustnessFaulted:
+			r.addBlocker(types.LonghornKindVolume, v.Name, "volume is faulted, salvage or delete it before upgrading")
+		case longhorn.VolumeRobustnessDegraded:
+			r.addWarning(types.LonghornKindVolume, v.Name, "volume is degraded, the replicas may be rebuilt during the upgrade")
+		}
+
+		switch v.Status.State {
+		case longhorn.VolumeStateAttaching, longhorn.VolumeStateDetaching:
+			r.addWarning(types.LonghornKindVolume, v.Name, fmt.Sprintf("volume is %v", v.Status.State))
+		}
+
+		if v.Status.CurrentImage != "" && v.Spec.Image != v.Status.CurrentImage {
+			r.addWarning(types.LonghornKindVolume, v.Name, fmt.Sprintf("volume engine upgrade from %v to %v is in progress", v.Status.CurrentImage, v.Spec.Image))
+		}
+
+		if v.Status.RestoreRequired {
+			r.addWarning(types.LonghornKindVolume, v.Name, "volume is being restored")
+		}
+	}
+}
+
+func (r *UpgradeReadinessReport) checkEngineImages(engineImages []*longhorn.EngineImage, target UpgradeReadinessTarget) {
+	sort.Slice(engineImages, func(i, j int) bool { return engineImages[i].Name < engineImages[j].Name })
+
+	if target.EngineVersion == nil {
+		r.addWarning(types.LonghornKindEngineImage, "", fmt.Sprintf("engine API versions of %v are unknown, engine images are not checked", target.Version))
+	}
+
+	for _, ei := range engineImages {
+		if ei.Status.RefCount > 0 && ei.Status.State != longhorn.EngineImageStateDeployed {
+			r.addWarning(types.LonghornKindEngineImage, ei.Name, fmt.Sprintf("engine image %v used by %v volumes is %v", ei.Spec.Image, ei.Status.RefCount, ei.Status.State))
+		}
+
+		if target.EngineVersion == nil {
+			continue
+		}
+
+		err := checkEngineImageUpgradePath(ei.Name, emeta.VersionOutput{
+			ControllerAPIVersion: ei.Status.ControllerAPIVersion,
+			CLIAPIVersion:        ei.Status.CLIAPIVersion,
+		}, *target.EngineVersion)
+		if err == nil {
+			continue
+		}
+		if ei.Status.RefCount > 0 {
+			r.addBlocker(types.LonghornKindEngineImage, ei.Name, fmt.Sprintf("%v, upgrade the engine of the %v volumes using %v first", err, ei.Status.RefCount, ei.Spec.Image))
+		} else {
+			r.addWarning(types.LonghornKindEngineImage, ei.Name, fmt.Sprintf("%v, the unused engine image can be deleted", err))
+		}
+	}
+}
+
+func (r *UpgradeReadinessReport) checkBackingImages(backingImages []*longhorn.BackingImage) {
+	sort.Slice(backingImages, func(i, j int) bool { return backingImages[i].Name < backingImages[j].Name })
+
+	for _, bi := range backingImages {
+		if len(bi.Status.DiskFileStatusMap) == 0 {
+			continue
+		}
+
+		failedDisks := []string{}
+		for diskUUID, status := range bi.Status.DiskFileStatusMap {
+			if status == nil {
+				continue
+			}
+			if status.State == longhorn.BackingImageStateFailed || status.State == longhorn.BackingImageStateFailedAndCleanUp {
+				failedDisks = append(failedDisks, diskUUID)
+			}
+		}
+		if len(failedDisks) == 0 {
+			continue
+		}
+
+		sort.Strings(failedDisks)
+		if len(failedDisks) == len(bi.Status.DiskFileStatusMap) {
+			r.addBlocker(types.LonghornKindBackingImage, bi.Name, "all backing image files are failed")
+		} else {
+			r.addWarning(types.LonghornKindBackingImage, bi.Name, fmt.Sprintf("backing image files in disks %v are failed", failedDisks))
+		}
+	}
+}
+
+func (r *UpgradeReadinessReport) checkSettings(settings []*longhorn.Setting) {
+	sort.Slice(settings, func(i, j int) bool { return settings[i].Name < settings[j].Name })
+
+	values := map[types.SettingName]string{}
+	for _, setting := range settings {
+		name := types.SettingName(setting.Name)
+		if !slices.Contains(types.SettingNameList, name) {
+			r.addWarning(types.LonghornKindSetting, setting.Name, "setting is removed and will be deleted during the upgrade")
+			continue
+		}
+		definition, ok := types.GetSettingDefinition(name)
+		if !ok || definition.Type == types.SettingTypeDeprecated {
+			r.addWarning(types.LonghornKindSetting, setting.Name, "setting is deprecated")
+			continue
+		}
+		if err := types.ValidateSetting(setting.Name, setting.Value); err != nil {
+			r.addBlocker(types.LonghornKindSetting, setting.Name, err.Error())
+			continue
+		}
+		values[name] = setting.Value
+	}
+
+	if err := types.ValidateSettingsConsistency(values); err != nil {
+		r.addBlocker(types.LonghornKindSetting, "", err.Error())
+	}
+}
+
+func (r *UpgradeReadinessReport) checkCRDs(crds []*apiextensionsv1.CustomResourceDefinition, target UpgradeReadinessTarget) {
+	sort.Slice(crds, func(i, j int) bool { return crds[i].Name < crds[j].Name })
+
+	v1beta1Removed := semver.IsValid(target.Version) && semver.Compare(target.Version, longhornV1beta1RemovedVersion) >= 0
+	for _, crd := range crds {
+		for _, storedVersion := range crd.Status.StoredVersions {
+			if storedVersion != "v1beta1" {
+				continue
+			}
+			if v1beta1Removed {
+				r.addBlocker(types.APIExtensionsKindCustomResourceDefinition, crd.Name,
+					fmt.Sprintf("resources are stored in v1beta1 which is removed in %v, upgrade to v1.9.x first to migrate them to v1beta2", longhornV1beta1RemovedVersion))
+			} else {
+				r.addWarning(types.APIExtensionsKindCustomResourceDefinition, crd.Name, "resources stored in v1beta1 will be migrated to v1beta2 during the upgrade")
+			}
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package util
+
+import (
+	"fmt"
+
+	. "gopkg.in/check.v1"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	emeta "github.com/longhorn/longhorn-engine/pkg/meta"
+
+	"github.com/longhorn/longhorn-manager/types"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+func (s *TestSuite) TestCheckUpgradeReadiness(c *C) {
+	targetEngineVersion := &emeta.VersionOutput{
+		ControllerAPIVersion:    emeta.ControllerAPIVersion,
+		ControllerAPIMinVersion: emeta.ControllerAPIMinVersion,
+		CLIAPIVersion:           emeta.CLIAPIVersion,
+		CLIAPIMinVersion:        emeta.CLIAPIMinVersion,
+	}
+
+	newVolume := func(name string, robustness longhorn.VolumeRobustness) *longhorn.Volume {
+		return &longhorn.Volume{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: longhorn.VolumeStatus{
+				State:      longhorn.VolumeStateAttached,
+				Robustness: robustness,
+			},
+		}
+	}
+	newEngineImage := func(name string, refCount, cliAPIVersion int) *longhorn.EngineImage {
+		return &longhorn.EngineImage{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       longhorn.EngineImageSpec{Image: name},
+			Status: longhorn.EngineImageStatus{
+				State:    longhorn.EngineImageStateDeployed,
+				RefCount: refCount,
+				EngineVersionDetails: longhorn.EngineVersionDetails{
+					ControllerAPIVersion: emeta.ControllerAPIVersion,
+					CLIAPIVersion:        cliAPIVersion,
+				},
+			},
+		}
+	}
+	newSetting := func(name types.SettingName, value string) *longhorn.Setting {
+		return &longhorn.Setting{
+			ObjectMeta: metav1.ObjectMeta{Name: string(name)},
+			Value:      value,
+		}
+	}
+
+	type testCase struct {
+		resources *UpgradeReadinessResources
+		target    UpgradeReadinessTarget
+
+		expectedBlockers []string
+		expectedWarnings []string
+	}
+	testCases := map[string]testCase{
+		"ready": {
+			resources: &UpgradeReadinessResources{
+				CurrentVersion: "v1.8.1",
+				Volumes:        []*longhorn.Volume{newVolume("vol", longhorn.VolumeRobustnessHealthy)},
+				EngineImages:   []*longhorn.EngineImage{newEngineImage("ei", 1, emeta.CLIAPIVersion)},
+				Settings:       []*longhorn.Setting{newSetting(types.SettingNameSnapshotMaxCount, "250")},
+			},
+			target:           UpgradeReadinessTarget{Version: "v1.9.0", EngineVersion: targetEngineVersion},
+			expectedBlockers: []string{},
+			expectedWarnings: []string{},
+		},
+		"unsupported upgrade path": {
+			resources: &UpgradeReadinessResources{
+				CurrentVersion: "v1.7.0",
+			},
+			target:           UpgradeReadinessTarget{Version: "v1.9.0", EngineVersion: targetEngineVersion},
+			expectedBlockers: []string{types.LonghornKindSetting + "/" + string(types.SettingNameCurrentLonghornVersion)},
+			expectedWarnings: []string{},
+		},
+		"unhealthy volumes": {
+			resources: &UpgradeReadinessResources{
+				CurrentVersion: "v1.8.1",
+				Volumes: []*longhorn.Volume{
+					newVolume("faulted", longhorn.VolumeRobustnessFaulted),
+					newVolume("degraded", longhorn.VolumeRobustnessDegraded),
+				},
+			},
+			target:           UpgradeReadinessTarget{Version: "v1.9.0", EngineVersion: targetEngineVersion},
+			expectedBlockers: []string{types.LonghornKindVolume + "/faulted"},
+			expectedWarnings: []string{types.LonghornKindVolume + "/degraded"},
+		},
+		"incompatible engine images": {
+			resources: &UpgradeReadinessResources{
+				CurrentVersion: "v1.8.1",
+				EngineImages: []*longhorn.EngineImage{
+					newEngineImage("in-use", 2, emeta.CLIAPIMinVersion-1),
+					newEngineImage("unused", 0, emeta.CLIAPIMinVersion-1),
+				},
+			},
+			target:           UpgradeReadinessTarget{Version: "v1.9.0", EngineVersion: targetEngineVersion},
+			expectedBlockers: []string{types.LonghornKindEngineImage + "/in-use"},
+			expectedWarnings: []string{types.LonghornKindEngineImage + "/unused"},
+		},
+		"unknown engine versions": {
+			resources: &UpgradeReadinessResources{
+				CurrentVersion: "v1.8.1",
+				EngineImages:   []*longhorn.EngineImage{newEngineImage("in-use", 2, emeta.CLIAPIMinVersion-1)},
+			},
+			target:           UpgradeReadinessTarget{Version: "v1.9.0"},
+			expectedBlockers: []string{},
+			expectedWarnings: []string{types.LonghornKindEngineImage + "/"},
+		},
+		"failed backing images": {
+			resources: &UpgradeReadinessResources{
+				CurrentVersion: "v1.8.1",
+				BackingImages: []*longhorn.BackingImage{
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "all-failed"},
+						Status: longhorn.BackingImageStatus{
+							DiskFileStatusMap: map[string]*longhorn.BackingImageDiskFileStatus{
+								"disk-1": {State: longhorn.BackingImageStateFailed},
+							},
+						},
+					},
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "partially-failed"},
+						Status: longhorn.BackingImageStatus{
+							DiskFileStatusMap: map[string]*longhorn.BackingImageDiskFileStatus{
+								"disk-1": {State: longhorn.BackingImageStateFailed},
+								"disk-2": {State: longhorn.BackingImageStateReady},
+							},
+						},
+					},
+				},
+			},
+			target:           UpgradeReadinessTarget{Version: "v1.9.0", EngineVersion: targetEngineVersion},
+			expectedBlockers: []string{types.LonghornKindBackingImage + "/all-failed"},
+			expectedWarnings: []string{types.LonghornKindBackingImage + "/partially-failed"},
+		},
+		"invalid and removed settings": {
+			resources: &UpgradeReadinessResources{
+				CurrentVersion: "v1.8.1",
+				Settings: []*longhorn.Setting{
+					newSetting(types.SettingNameSnapshotMaxCount, "invalid"),
+					newSetting("removed-setting", "value"),
+				},
+			},
+			target:           UpgradeReadinessTarget{Version: "v1.9.0", EngineVersion: targetEngineVersion},
+			expectedBlockers: []string{types.LonghornKindSetting + "/" + string(types.SettingNameSnapshotMaxCount)},
+			expectedWarnings: []string{types.LonghornKindSetting + "/removed-setting"},
+		},
+		"inconsistent settings": {
+			resources: &UpgradeReadinessResources{
+				CurrentVersion: "v1.8.1",
+				Settings: []*longhorn.Setting{
+					newSetting(types.SettingNameAutoCleanupSystemGeneratedSnapshot, "true"),
+					newSetting(types.SettingNameDisableSnapshotPurge, "true"),
+				},
+			},
+			target:           UpgradeReadinessTarget{Version: "v1.9.0", EngineVersion: targetEngineVersion},
+			expectedBlockers: []string{types.LonghornKindSetting + "/"},
+			expectedWarnings: []string{},
+		},
+		"v1beta1 stored versions": {
+			resources: &UpgradeReadinessResources{
+				CurrentVersion: "v1.9.0",
+				CRDs: []*apiextensionsv1.CustomResourceDefinition{
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "volumes.longhorn.io"},
+						Status: apiextensionsv1.CustomResourceDefinitionStatus{
+							StoredVersions: []string{"v1beta1", "v1beta2"},
+						},
+					},
+				},
+			},
+			target:           UpgradeReadinessTarget{Version: "v1.10.0", EngineVersion: targetEngineVersion},
+			expectedBlockers: []string{types.APIExtensionsKindCustomResourceDefinition + "/volumes.longhorn.io"},
+			expectedWarnings: []string{},
+		},
+	}
+
+	issueKeys := func(issues []UpgradeReadinessIssue) []string {
+		keys := []string{}
+		for _, issue := range issues {
+			keys = append(keys, issue.Kind+"/"+issue.Name)
+		}
+		return keys
+	}
+
+	for testName, testCase := range testCases {
+		fmt.Printf("testing %v\n", testName)
+
+		report := CheckUpgradeReadiness(testCase.resources, testCase.target)
+		c.Assert(issueKeys(report.Blockers), DeepEquals, testCase.expectedBlockers, Commentf("%v: %+v", testName, report.Blockers))
+		c.Assert(issueKeys(report.Warnings), DeepEquals, testCase.expectedWarnings, Commentf("%v: %+v", testName, report.Warnings))
+		c.Assert(report.Ready, Equals, len(testCase.expectedBlockers) == 0)
+	}
+}
@@ -237,30 +237,35 @@ func checkLHUpgradePath(namespace string, lhClient lhclientset.Interface) error
 
 	logrus.Infof("Checking if the upgrade path from %v to %v is supported", lhCurrentVersion, meta.Version)
 
-	if !semver.IsValid(meta.Version) {
-		return fmt.Errorf("failed to upgrade since upgrading version %v is not valid", meta.Version)
+	return checkLHUpgradePathTo(lhCurrentVersion, meta.Version)
+}
+
+// checkLHUpgradePathTo returns if the upgrade path from lhCurrentVersion to lhNewVersion is supported.
+func checkLHUpgradePathTo(lhCurrentVersion, lhNewVersion string) error {
+	if !semver.IsValid(lhNewVersion) {
+		return fmt.Errorf("failed to upgrade since upgrading version %v is not valid", lhNewVersion)
 	}
 
-	lhNewMajorVersion := semver.Major(meta.Version)
+	lhNewMajorVersion := semver.Major(lhNewVersion)
 	lhCurrentMajorVersion := semver.Major(lhCurrentVersion)
 
-	lhNewMajorVersionNum, lhNewMinorVersionNum, err := getMajorMinorInt(meta.Version)
+	lhNewMajorVersionNum, lhNewMinorVersionNum, err := getMajorMinorInt(lhNewVersion)
 	if err != nil {
-		return errors.Wrapf(err, "failed to parse upgrading %v major/minor version", meta.Version)
+		return errors.Wrapf(err, "failed to parse upgrading %v major/minor version", lhNewVersion)
 	}
 
 	lhCurrentMajorVersionNum, lhCurrentMinorVersionNum, err := getMajorMinorInt(lhCurrentVersion)
 	if err != nil {
-		return errors.Wrapf(err, "failed to parse current %v major/minor version", meta.Version)
+		return errors.Wrapf(err, "failed to parse current %v major/minor version", lhCurrentVersion)
 	}
 
 	if semver.Compare(lhCurrentMajorVersion, lhNewMajorVersion) > 0 {
-		return fmt.Errorf("failed to upgrade since downgrading from %v to %v for major version is not supported", lhCurrentVersion, meta.Version)
+		return fmt.Errorf("failed to upgrade since downgrading from %v to %v for major version is not supported", lhCurrentVersion, lhNewVersion)
 	}
 
 	if semver.Compare(lhCurrentMajorVersion, lhNewMajorVersion) < 0 {
 		if (lhNewMajorVersionNum - lhCurrentMajorVersionNum) > 1 {
-			return fmt.Errorf("failed to upgrade since upgrading from %v to %v for major version is not supported", lhCurrentVersion, meta.Version)
+			return fmt.Errorf("failed to upgrade since upgrading from %v to %v for major version is not supported", lhCurrentVersion, lhNewVersion)
 		}
 		if lhCurrentMinorVersionNum < LonghornV1ToV2MinorVersionNum {
 			return fmt.Errorf("failed to upgrade since upgrading major version with minor version under %v is not supported", LonghornV1ToV2MinorVersionNum)
@@ -269,15 +274,15 @@ func checkLHUpgradePath(namespace string, lhClient lhclientset.Interface) error
 	}
 
 	if (lhNewMinorVersionNum - lhCurrentMinorVersionNum) > 1 {
-		return fmt.Errorf("failed to upgrade since upgrading from %v to %v for minor version is not supported", lhCurrentVersion, meta.Version)
+		return fmt.Errorf("failed to upgrade since upgrading from %v to %v for minor version is not supported", lhCurrentVersion, lhNewVersion)
 	}
 
 	if (lhNewMinorVersionNum - lhCurrentMinorVersionNum) == 1 {
 		return nil
 	}
 
-	if semver.Compare(lhCurrentVersion, meta.Version) > 0 {
-		return fmt.Errorf("failed to upgrade since downgrading from %v to %v is not supported", lhCurrentVersion, meta.Version)
+	if semver.Compare(lhCurrentVersion, lhNewVersion) > 0 {
+		return fmt.Errorf("failed to upgrade since downgrading from %v to %v is not supported", lhCurrentVersion, lhNewVersion)
 	}
 
 	return nil
@@ -306,6 +311,16 @@ func checkEngineUpgradePath(namespace string, lhClient lhclientset.Interface, ne
 		"newEngineClientAPIMinVersion":     newEngineVersion.CLIAPIMinVersion,
 	}).Infof("Checking if the engine upgrade path from %+v is supported", engineImageVersions)
 
+	for name, engineImageVersion := range engineImageVersions {
+		if err = checkEngineImageUpgradePath(name, engineImageVersion, newEngineVersion); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkEngineImageUpgradePath returns error if the engine image API versions are not supported by newEngineVersion.
+func checkEngineImageUpgradePath(name string, engineImageVersion, newEngineVersion emeta.VersionOutput) error {
 	checkSupportVersion := func(currentVersion, newVersion, newMinVersion int) error {
 		if currentVersion > newVersion {
 			return fmt.Errorf("downgrading from %v to %v is not supported", currentVersion, newVersion)
@@ -318,16 +333,12 @@ func checkEngineUpgradePath(namespace string, lhClient lhclientset.Interface, ne
 		return nil
 	}
 
-	for name, engineImageVersion := range engineImageVersions {
-		err = checkSupportVersion(engineImageVersion.ControllerAPIVersion, newEngineVersion.ControllerAPIVersion, newEngineVersion.ControllerAPIMinVersion)
-		if err != nil {
-			return errors.Wrapf(err, "incompatible Engine %v controller API version", name)
-		}
+	if err := checkSupportVersion(engineImageVersion.ControllerAPIVersion, newEngineVersion.ControllerAPIVersion, newEngineVersion.ControllerAPIMinVersion); err != nil {
+		return errors.Wrapf(err, "incompatible Engine %v controller API version", name)
+	}
 
-		err = checkSupportVersion(engineImageVersion.CLIAPIVersion, newEngineVersion.CLIAPIVersion, newEngineVersion.CLIAPIMinVersion)
-		if err != nil {
-			return errors.Wrapf(err, "incompatible Engine %v client API version", name)
-		}
+	if err := checkSupportVersion(engineImageVersion.CLIAPIVersion, newEngineVersion.CLIAPIVersion, newEngineVersion.CLIAPIMinVersion); err != nil {
+		return errors.Wrapf(err, "incompatible Engine %v client API version", name)
 	}
 	return nil
 }
@@ -0,0 +1,387 @@
+package backupstore
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/longhorn/backupstore"
+	"github.com/longhorn/backupstore/util"
+)
+
+// A backup is copied between backupstores as a tar stream, which allows the source and destination to run in
+// different processes holding the credentials of the corresponding backup target:
+//
+//	volume.cfg             the config of the source backup volume
+//	backup.cfg             the config of the source backup
+//	blocks.json            the checksums of the blocks in the stream
+//	blocks/<checksum>.blk  the compressed blocks missing in the destination
+const (
+	copyEntryVolumeConfig = "volume.cfg"
+	copyEntryBackupConfig = "backup.cfg"
+	copyEntryBlockList    = "blocks.json"
+	copyEntryBlocksDir    = "blocks"
+)
+
+// The hidden manager command running the backupstore operations in a process with the credential of the backup
+// target as the environment variables, which are read by the backupstore drivers.
+const (
+	CommandName       = "backupstore"
+	CommandCopyExport = "copy-export"
+	CommandCopyImport = "copy-import"
+
+	CommandSystemBackupUpload   = "system-backup-upload"
+	CommandSystemBackupDownload = "system-backup-download"
+
+	FlagVolume       = "volume"
+	FlagBackupName   = "backup-name"
+	FlagLabel        = "label"
+	FlagGitCommit    = "git-commit"
+	FlagManagerImage = "manager-image"
+	FlagEngineImage  = "engine-image"
+)
+
+// ExportBackup writes the backup of the volume as a copy stream to w. The blocks in skipBlocks already exist in
+// the destination and are not transferred. The source volume is locked against the deletion during the export.
+func ExportBackup(driver backupstore.BackupStoreDriver, backupName, volumeName string, skipBlocks []string, w io.Writer) (err error) {
+	lock, err := backupstore.New(driver, volumeName, backupstore.RESTORE_LOCK)
+	if err != nil {
+		return err
+	}
+	if err := lock.Lock(); err != nil {
+		return errors.Wrapf(err, "failed to lock backup volume %v", volumeName)
+	}
+	defer func() {
+		if unlockErr := lock.Unlock(); unlockErr != nil {
+			logrus.WithError(unlockErr).Warnf("Failed to unlock backup volume %v", volumeName)
+		}
+	}()
+
+	volume, err := loadVolume(driver, volumeName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to load backup volume %v", volumeName)
+	}
+	backup, err := loadBackup(driver, backupName, volumeName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to load backup %v", backupName)
+	}
+	if isBackupInProgress(backup) {
+		return fmt.Errorf("backup %v is in progress", backupName)
+	}
+	if backup.SingleFile.FilePath != "" {
+		return fmt.Errorf("backup %v is not a block backup", backupName)
+	}
+
+	skip := map[string]struct{}{}
+	for _, checksum := range skipBlocks {
+		skip[checksum] = struct{}{}
+	}
+	blocks := []string{}
+	for _, checksum := range getBackupBlockChecksums(backup) {
+		if _, ok := skip[checksum]; !ok {
+			blocks = append(blocks, checksum)
+		}
+	}
+
+	tw := tar.NewWriter(w)
+	if err := writeCopyEntryJSON(tw, copyEntryVolumeConfig, volume); err != nil {
+		return err
+	}
+	if err := writeCopyEntryJSON(tw, copyEntryBackupConfig, backup); err != nil {
+		return err
+	}
+	if err := writeCopyEntryJSON(tw, copyEntryBlockList, blocks); err != nil {
+		return err
+	}
+	for _, checksum := range blocks {
+		data, err := readFile(driver, blockFilePath(volumeName, checksum))
+		if err != nil {
+			return errors.Wrapf(err, "failed to read block %v", checksum)
+		}
+		if err := writeCopyEntry(tw, path.Join(copyEntryBlocksDir, checksum+backupstore.BLK_SUFFIX), data); err != nil {
+			return err
+		}
+	}
+	return tw.Close()
+}
+
+// BackupImporter writes a copy stream into the backupstore as a new backup of the volume.
+type BackupImporter struct {
+	driver     backupstore.BackupStoreDriver
+	volumeName string
+
+	lock           *backupstore.FileLock
+	existingBlocks map[string]struct{}
+}
+
+func NewBackupImporter(driver backupstore.BackupStoreDriver, volumeName string) *BackupImporter {
+	return &BackupImporter{
+		driver:     driver,
+		volumeName: volumeName,
+	}
+}
+
+// Prepare locks the destination volume as a backup creation, then returns the blocks already in the backupstore.
+// The blocks cannot be removed by a deletion or a garbage collection until the importer is closed, hence they can
+// be skipped by the exporter.
+func (i *BackupImporter) Prepare() ([]string, error) {
+	lock, err := backupstore.New(i.driver, i.volumeName, backupstore.BACKUP_LOCK)
+	if err != nil {
+		return nil, err
+	}
+	if err := lock.Lock(); err != nil {
+		return nil, errors.Wrapf(err, "failed to lock backup volume %v", i.volumeName)
+	}
+	i.lock = lock
+
+	blocks, err := listBlocks(i.driver, i.volumeName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list blocks of backup volume %v", i.volumeName)
+	}
+	i.existingBlocks = map[string]struct{}{}
+	for _, checksum := range blocks {
+		i.existingBlocks[checksum] = struct{}{}
+	}
+	return blocks, nil
+}
+
+func (i *BackupImporter) Close() {
+	if i.lock == nil {
+		return
+	}
+	if err := i.lock.Unlock(); err != nil {
+		logrus.WithError(err).Warnf("Failed to unlock backup volume %v", i.volumeName)
+	}
+	i.lock = nil
+}
+
+// Import reads the copy stream from r and creates the backup with the name and the extra labels. The backup config
+// is marked as in progress until all blocks are written, so that it protects the new blocks from the deletions of
+// the other backups. The progress in percentage is reported via the callback.
+func (i *BackupImporter) Import(backupName string, labels map[string]string, r io.Reader, progress func(int)) error {
+	if i.lock == nil {
+		return fmt.Errorf("BUG: importer of backup volume %v is not prepared", i.volumeName)
+	}
+
+	tr := tar.NewReader(r)
+	sourceVolume := &backupstore.Volume{}
+	if err := readCopyEntryJSON(tr, copyEntryVolumeConfig, sourceVolume); err != nil {
+		return err
+	}
+	sourceBackup := &backupstore.Backup{}
+	if err := readCopyEntryJSON(tr, copyEntryBackupConfig, sourceBackup); err != nil {
+		return err
+	}
+	blocks := []string{}
+	if err := readCopyEntryJSON(tr, copyEntryBlockList, &blocks); err != nil {
+		return err
+	}
+	if sourceVolume.Name != i.volumeName || sourceBackup.VolumeName != i.volumeName {
+		return fmt.Errorf("cannot import backup %v of volume %v into backup volume %v", sourceBackup.Name, sourceBackup.VolumeName, i.volumeName)
+	}
+
+	volume, err := i.getOrCreateVolume(sourceVolume)
+	if err != nil {
+		return err
+	}
+
+	backup := &backupstore.Backup{
+		Name:              backupName,
+		VolumeName:        i.volumeName,
+		SnapshotName:      sourceBackup.SnapshotName,
+		SnapshotCreatedAt: sourceBackup.SnapshotCreatedAt,
+		Size:              sourceBackup.Size,
+		Labels:            map[string]string{},
+		Parameters:        sourceBackup.Parameters,
+		IsIncremental:     len(blocks) < len(getBackupBlockChecksums(sourceBackup)),
+		CompressionMethod: sourceBackup.CompressionMethod,
+		Blocks:            sourceBackup.Blocks,
+	}
+	for k, v := range sourceBackup.Labels {
+		backup.Labels[k] = v
+	}
+	for k, v := range labels {
+		backup.Labels[k] = v
+	}
+	if err := saveBackup(i.driver, backup); err != nil {
+		return errors.Wrapf(err, "failed to save in progress backup %v", backupName)
+	}
+
+	total := len(getBackupBlockChecksums(sourceBackup))
+	processed := total - len(blocks)
+	reportProgress := func() {
+		if progress != nil && total > 0 {
+			progress(processed * 100 / total)
+		}
+	}
+	reportProgress()
+
+	transferred := map[string]struct{}{}
+	newBlockCount := int64(0)
+	for range blocks {
+		header, err := tr.Next()
+		if err != nil {
+			return errors.Wrap(err, "failed to read block from the copy stream")
+		}
+		checksum := strings.TrimSuffix(path.Base(header.Name), backupstore.BLK_SUFFIX)
+		if path.Dir(header.Name) != copyEntryBlocksDir || len(checksum) <= backupstore.BLOCK_SEPARATE_LAYER2 {
+			return fmt.Errorf("unexpected entry %v in the copy stream", header.Name)
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return errors.Wrapf(err, "failed to read block %v from the copy stream", checksum)
+		}
+		blockPath := blockFilePath(i.volumeName, checksum)
+		if !i.driver.FileExists(blockPath) {
+			if err := i.driver.Write(blockPath, bytes.NewReader(data)); err != nil {
+				return errors.Wrapf(err, "failed to write block %v", checksum)
+			}
+			newBlockCount++
+			backup.NewlyUploadedDataSize += int64(len(data))
+		}
+		transferred[checksum] = struct{}{}
+		processed++
+		reportProgress()
+	}
+
+	for _, checksum := range getBackupBlockChecksums(sourceBackup) {
+		_, isTransferred := transferred[checksum]
+		_, isExisting := i.existingBlocks[checksum]
+		if !isTransferred && !isExisting {
+			return fmt.Errorf("block %v of backup %v is neither in the copy stream nor in the backupstore", checksum, sourceBackup.Name)
+		}
+	}
+
+	volume.BlockCount += newBlockCount
+	isLastBackup, err := isLaterThan(backup.SnapshotCreatedAt, volume.LastBackupAt)
+	if err != nil {
+		return err
+	}
+	if isLastBackup {
+		volume.LastBackupName = backup.Name
+		volume.LastBackupAt = backup.SnapshotCreatedAt
+	}
+	if err := saveVolume(i.driver, volume); err != nil {
+		return errors.Wrapf(err, "failed to save backup volume %v", i.volumeName)
+	}
+
+	backup.CreatedTime = util.Now()
+	if err := saveBackup(i.driver, backup); err != nil {
+		return errors.Wrapf(err, "failed to save backup %v", backupName)
+	}
+	return nil
+}
+
+func (i *BackupImporter) getOrCreateVolume(sourceVolume *backupstore.Volume) (*backupstore.Volume, error) {
+	if i.driver.FileExists(volumeConfigPath(i.volumeName)) {
+		volume, err := loadVolume(i.driver, i.volumeName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load backup volume %v", i.volumeName)
+		}
+		return volume, nil
+	}
+
+	volume := &backupstore.Volume{
+		Name:                 sourceVolume.Name,
+		Size:                 sourceVolume.Size,
+		Labels:               sourceVolume.Labels,
+		CreatedTime:          util.Now(),
+		BackingImageName:     sourceVolume.BackingImageName,
+		BackingImageChecksum: sourceVolume.BackingImageChecksum,
+		CompressionMethod:    sourceVolume.CompressionMethod,
+		StorageClassName:     sourceVolume.StorageClassName,
+		DataEngine:           sourceVolume.DataEngine,
+	}
+	if err := saveVolume(i.driver, volume); err != nil {
+		return nil, errors.Wrapf(err, "failed to create backup volume %v", i.volumeName)
+	}
+	return volume, nil
+}
+
+// getBackupBlockChecksums returns the sorted unique checksums of the blocks referenced by the backup.
+func getBackupBlockChecksums(backup *backupstore.Backup) []string {
+	checksums := map[string]struct{}{}
+	for _, block := range backup.Blocks {
+		checksums[block.BlockChecksum] = struct{}{}
+	}
+	result := make([]string, 0, len(checksums))
+	for checksum := range checksums {
+		result = append(result, checksum)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func isLaterThan(timestamp, last string) (bool, error) {
+	if last == "" {
+		return true, nil
+	}
+	t, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to parse time %v", timestamp)
+	}
+	lastTime, err := time.Parse(time.RFC3339, last)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to parse time %v", last)
+	}
+	return t.After(lastTime), nil
+}
+
+func readFile(driver backupstore.BackupStoreDriver, filePath string) ([]byte, error) {
+	rc, err := driver.Read(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
+
+func writeCopyEntry(tw *tar.Writer, name string, data []byte) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Name: name,
+		Mode: 0644,
+		Size: int64(len(data)),
+	}); err != nil {
+		return errors.Wrapf(err, "failed to write header of %v to the copy stream", name)
+	}
+	if _, err := tw.Write(data); err != nil {
+		return errors.Wrapf(err, "failed to write %v to the copy stream", name)
+	}
+	return nil
+}
+
+func writeCopyEntryJSON(tw *tar.Writer, name string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal %v", name)
+	}
+	return writeCopyEntry(tw, name, data)
+}
+
+func readCopyEntryJSON(tr *tar.Reader, name string, v interface{}) error {
+	header, err := tr.Next()
+	if err != nil {
+		return errors.Wrapf(err, "failed to read %v from the copy stream", name)
+	}
+	if header.Name != name {
+		return fmt.Errorf("unexpected entry %v in the copy stream, expecting %v", header.Name, name)
+	}
+	if err := json.NewDecoder(tr).Decode(v); err != nil {
+		return errors.Wrapf(err, "failed to decode %v from the copy stream", name)
+	}
+	return nil
+}
+
+// CopyProgress is printed by the copy importer command as a JSON line for each progress update.
+type CopyProgress struct {
+	Progress int `json:"progress"`
+}
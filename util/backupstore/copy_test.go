@@ -0,0 +1,178 @@
+package backupstore
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/longhorn/backupstore"
+	"github.com/longhorn/backupstore/util"
+
+	_ "github.com/longhorn/backupstore/vfs"
+)
+
+const (
+	testVolumeName = "test-volume"
+)
+
+func newTestBackupStore(t *testing.T) backupstore.BackupStoreDriver {
+	driver, err := backupstore.GetBackupStoreDriver("vfs://" + t.TempDir())
+	require.NoError(t, err)
+	return driver
+}
+
+// fakeBackup creates the config of a completed backup referencing the given block data in the backupstore.
+func fakeBackup(t *testing.T, driver backupstore.BackupStoreDriver, backupName, snapshotCreatedAt string, data ...string) []string {
+	if !driver.FileExists(volumeConfigPath(testVolumeName)) {
+		require.NoError(t, saveVolume(driver, &backupstore.Volume{
+			Name:              testVolumeName,
+			Size:              int64(len(data)) * backupstore.DEFAULT_BLOCK_SIZE,
+			CreatedTime:       util.Now(),
+			CompressionMethod: "lz4",
+		}))
+	}
+
+	checksums := []string{}
+	backup := &backupstore.Backup{
+		Name:              backupName,
+		VolumeName:        testVolumeName,
+		SnapshotName:      "snapshot-" + backupName,
+		SnapshotCreatedAt: snapshotCreatedAt,
+		CreatedTime:       util.Now(),
+		Labels:            map[string]string{"source": "true"},
+		CompressionMethod: "lz4",
+	}
+	for i, d := range data {
+		checksum := util.GetChecksum([]byte(d))
+		blockPath := blockFilePath(testVolumeName, checksum)
+		if !driver.FileExists(blockPath) {
+			require.NoError(t, driver.Write(blockPath, bytes.NewReader([]byte(d))))
+		}
+		backup.Blocks = append(backup.Blocks, backupstore.BlockMapping{
+			Offset:        int64(i) * backupstore.DEFAULT_BLOCK_SIZE,
+			BlockChecksum: checksum,
+		})
+		checksums = append(checksums, checksum)
+	}
+	require.NoError(t, saveBackup(driver, backup))
+	return checksums
+}
+
+func copyBackup(t *testing.T, source, dest backupstore.BackupStoreDriver, sourceBackupName, backupName string) (int, []int, error) {
+	importer := NewBackupImporter(dest, testVolumeName)
+	defer importer.Close()
+	existingBlocks, err := importer.Prepare()
+	require.NoError(t, err)
+
+	stream := &bytes.Buffer{}
+	if err := ExportBackup(source, sourceBackupName, testVolumeName, existingBlocks, stream); err != nil {
+		return len(existingBlocks), nil, err
+	}
+	progress := []int{}
+	err = importer.Import(backupName, map[string]string{"copy": "true"}, stream, func(p int) {
+		progress = append(progress, p)
+	})
+	return len(existingBlocks), progress, err
+}
+
+func TestCopyBackup(t *testing.T) {
+	assert := require.New(t)
+
+	source := newTestBackupStore(t)
+	dest := newTestBackupStore(t)
+
+	blocks1 := fakeBackup(t, source, "backup-1", "2025-01-01T00:00:00Z", "a", "b", "b")
+	blocks2 := fakeBackup(t, source, "backup-2", "2025-01-02T00:00:00Z", "b", "c")
+
+	// The first copy transfers all blocks to the empty backupstore
+	existing, progress, err := copyBackup(t, source, dest, "backup-1", "copy-1")
+	assert.NoError(err)
+	assert.Equal(0, existing)
+	assert.Equal([]int{0, 50, 100}, progress)
+
+	backup, err := loadBackup(dest, "copy-1", testVolumeName)
+	assert.NoError(err)
+	assert.False(isBackupInProgress(backup))
+	assert.Equal(map[string]string{"source": "true", "copy": "true"}, backup.Labels)
+	assert.Len(backup.Blocks, 3)
+	assert.Equal(int64(2), backup.NewlyUploadedDataSize)
+	assert.False(backup.IsIncremental)
+
+	volume, err := loadVolume(dest, testVolumeName)
+	assert.NoError(err)
+	assert.Equal("copy-1", volume.LastBackupName)
+	assert.Equal(int64(2), volume.BlockCount)
+	assert.Equal("lz4", volume.CompressionMethod)
+
+	// The second copy skips the blocks shared with the first backup
+	existing, progress, err = copyBackup(t, source, dest, "backup-2", "copy-2")
+	assert.NoError(err)
+	assert.Equal(2, existing)
+	assert.Equal([]int{50, 100}, progress)
+
+	backup, err = loadBackup(dest, "copy-2", testVolumeName)
+	assert.NoError(err)
+	assert.True(backup.IsIncremental)
+	assert.Equal(int64(1), backup.NewlyUploadedDataSize)
+
+	volume, err = loadVolume(dest, testVolumeName)
+	assert.NoError(err)
+	assert.Equal("copy-2", volume.LastBackupName)
+	assert.Equal(int64(3), volume.BlockCount)
+
+	blocks, err := listBlocks(dest, testVolumeName)
+	assert.NoError(err)
+	assert.ElementsMatch(getBackupBlockChecksums(&backupstore.Backup{Blocks: []backupstore.BlockMapping{
+		{BlockChecksum: blocks1[0]}, {BlockChecksum: blocks1[1]}, {BlockChecksum: blocks2[1]},
+	}}), blocks)
+
+	// Copying an older backup doesn't change the last backup
+	_, _, err = copyBackup(t, source, dest, "backup-1", "copy-3")
+	assert.NoError(err)
+	volume, err = loadVolume(dest, testVolumeName)
+	assert.NoError(err)
+	assert.Equal("copy-2", volume.LastBackupName)
+}
+
+func TestCopyBackupInProgress(t *testing.T) {
+	assert := require.New(t)
+
+	source := newTestBackupStore(t)
+	dest := newTestBackupStore(t)
+
+	fakeBackup(t, source, "backup-1", "2025-01-01T00:00:00Z", "a")
+	backup, err := loadBackup(source, "backup-1", testVolumeName)
+	assert.NoError(err)
+	backup.CreatedTime = ""
+	assert.NoError(saveBackup(source, backup))
+
+	_, _, err = copyBackup(t, source, dest, "backup-1", "copy-1")
+	assert.ErrorContains(err, "in progress")
+	assert.False(dest.FileExists(backupConfigPath("copy-1", testVolumeName)))
+}
+
+func TestCopyBackupMissingBlock(t *testing.T) {
+	assert := require.New(t)
+
+	source := newTestBackupStore(t)
+	dest := newTestBackupStore(t)
+
+	blocks := fakeBackup(t, source, "backup-1", "2025-01-01T00:00:00Z", "a", "b")
+
+	importer := NewBackupImporter(dest, testVolumeName)
+	defer importer.Close()
+	_, err := importer.Prepare()
+	assert.NoError(err)
+
+	// The exporter skips a block which is not in the destination
+	stream := &bytes.Buffer{}
+	assert.NoError(ExportBackup(source, "backup-1", testVolumeName, blocks[:1], stream))
+	err = importer.Import("copy-1", nil, stream, nil)
+	assert.ErrorContains(err, "neither in the copy stream nor in the backupstore")
+
+	// The incomplete backup stays in progress
+	backup, err := loadBackup(dest, "copy-1", testVolumeName)
+	assert.NoError(err)
+	assert.True(isBackupInProgress(backup))
+}
@@ -0,0 +1,134 @@
+package backupstore
+
+import (
+	"path/filepath"
+
+	"github.com/longhorn/backupstore"
+	"github.com/longhorn/backupstore/util"
+)
+
+// The helpers below mirror the unexported path layout of the backupstore library:
+//
+//	backupstore/volumes/<checksum[0:2]>/<checksum[2:4]>/<volume>/volume.cfg
+//	backupstore/volumes/<checksum[0:2]>/<checksum[2:4]>/<volume>/backups/backup_<backup>.cfg
+//	backupstore/volumes/<checksum[0:2]>/<checksum[2:4]>/<volume>/blocks/<block[0:2]>/<block[2:4]>/<block>.blk
+
+const (
+	volumeSeparateLayer1 = 2
+	volumeSeparateLayer2 = 4
+)
+
+func volumesPath() string {
+	return filepath.Join(backupstore.GetBackupstoreBase(), backupstore.VOLUME_DIRECTORY) + "/"
+}
+
+func volumePath(volumeName string) string {
+	checksum := util.GetChecksum([]byte(volumeName))
+	return filepath.Join(volumesPath(), checksum[0:volumeSeparateLayer1], checksum[volumeSeparateLayer1:volumeSeparateLayer2], volumeName) + "/"
+}
+
+func volumeConfigPath(volumeName string) string {
+	return filepath.Join(volumePath(volumeName), backupstore.VOLUME_CONFIG_FILE)
+}
+
+func backupsPath(volumeName string) string {
+	return filepath.Join(volumePath(volumeName), backupstore.BACKUP_DIRECTORY) + "/"
+}
+
+func backupConfigPath(backupName, volumeName string) string {
+	return filepath.Join(backupsPath(volumeName), backupstore.BACKUP_CONFIG_PREFIX+backupName+backupstore.CFG_SUFFIX)
+}
+
+func blocksPath(volumeName string) string {
+	return filepath.Join(volumePath(volumeName), backupstore.BLOCKS_DIRECTORY) + "/"
+}
+
+func blockFilePath(volumeName, checksum string) string {
+	return filepath.Join(blocksPath(volumeName), checksum[0:backupstore.BLOCK_SEPARATE_LAYER1],
+		checksum[backupstore.BLOCK_SEPARATE_LAYER1:backupstore.BLOCK_SEPARATE_LAYER2], checksum+backupstore.BLK_SUFFIX)
+}
+
+// listVolumeNames returns the names of the volumes having a folder in the backupstore.
+func listVolumeNames(driver backupstore.BackupStoreDriver) ([]string, error) {
+	names := []string{}
+	lv1Dirs, err := driver.List(volumesPath())
+	if err != nil {
+		// The folder doesn't exist in an empty backupstore
+		return names, nil
+	}
+	for _, lv1 := range lv1Dirs {
+		lv2Dirs, err := driver.List(filepath.Join(volumesPath(), lv1))
+		if err != nil {
+			return nil, err
+		}
+		for _, lv2 := range lv2Dirs {
+			volumeNames, err := driver.List(filepath.Join(volumesPath(), lv1, lv2))
+			if err != nil {
+				return nil, err
+			}
+			names = append(names, volumeNames...)
+		}
+	}
+	return names, nil
+}
+
+// listBackupNames returns the names of the backups having a config file of the volume in the backupstore.
+func listBackupNames(driver backupstore.BackupStoreDriver, volumeName string) ([]string, error) {
+	files, err := driver.List(backupsPath(volumeName))
+	if err != nil {
+		return []string{}, nil
+	}
+	return util.ExtractNames(files, backupstore.BACKUP_CONFIG_PREFIX, backupstore.CFG_SUFFIX), nil
+}
+
+// listBlocks returns the checksums of the blocks of the volume in the backupstore.
+func listBlocks(driver backupstore.BackupStoreDriver, volumeName string) ([]string, error) {
+	names := []string{}
+	lv1Dirs, err := driver.List(blocksPath(volumeName))
+	if err != nil {
+		return names, nil
+	}
+	for _, lv1 := range lv1Dirs {
+		lv2Dirs, err := driver.List(filepath.Join(blocksPath(volumeName), lv1))
+		if err != nil {
+			return nil, err
+		}
+		for _, lv2 := range lv2Dirs {
+			blocks, err := driver.List(filepath.Join(blocksPath(volumeName), lv1, lv2))
+			if err != nil {
+				return nil, err
+			}
+			names = append(names, blocks...)
+		}
+	}
+	return util.ExtractNames(names, "", backupstore.BLK_SUFFIX), nil
+}
+
+func loadVolume(driver backupstore.BackupStoreDriver, volumeName string) (*backupstore.Volume, error) {
+	volume := &backupstore.Volume{}
+	if err := backupstore.LoadConfigInBackupStore(driver, volumeConfigPath(volumeName), volume); err != nil {
+		return nil, err
+	}
+	return volume, nil
+}
+
+func saveVolume(driver backupstore.BackupStoreDriver, volume *backupstore.Volume) error {
+	return backupstore.SaveConfigInBackupStore(driver, volumeConfigPath(volume.Name), volume)
+}
+
+func loadBackup(driver backupstore.BackupStoreDriver, backupName, volumeName string) (*backupstore.Backup, error) {
+	backup := &backupstore.Backup{}
+	if err := backupstore.LoadConfigInBackupStore(driver, backupConfigPath(backupName, volumeName), backup); err != nil {
+		return nil, err
+	}
+	return backup, nil
+}
+
+func saveBackup(driver backupstore.BackupStoreDriver, backup *backupstore.Backup) error {
+	return backupstore.SaveConfigInBackupStore(driver, backupConfigPath(backup.Name, backup.VolumeName), backup)
+}
+
+// isBackupInProgress follows the backupstore library, the creation time is filled when the backup completes.
+func isBackupInProgress(backup *backupstore.Backup) bool {
+	return backup.CreatedTime == ""
+}
@@ -0,0 +1,115 @@
+package backupstore
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/longhorn/backupstore"
+	"github.com/longhorn/backupstore/systembackup"
+	"github.com/longhorn/backupstore/util"
+)
+
+// The helpers below mirror the unexported system backup layout of the backupstore library:
+//
+//	backupstore/system-backups/<version>/<name>/system-backup.cfg
+//	backupstore/system-backups/<version>/<name>/system-backup.zip
+
+func systemBackupPath(name, longhornVersion string) string {
+	return filepath.Join(backupstore.GetBackupstoreBase(), systembackup.SubDirectory, longhornVersion, name) + "/"
+}
+
+func systemBackupConfigPath(name, longhornVersion string) string {
+	return filepath.Join(systemBackupPath(name, longhornVersion), systembackup.ConfigFile)
+}
+
+func systemBackupArchivePath(name, longhornVersion string) string {
+	return filepath.Join(systemBackupPath(name, longhornVersion), systembackup.ZipFile)
+}
+
+// UploadSystemBackup uploads the local system backup archive and then its config to the backupstore.
+// The upload rate is capped by the limit in bytes per second.
+func UploadSystemBackup(driver backupstore.BackupStoreDriver, localFilePath string, cfg *systembackup.Config, limit func() int64) error {
+	checksum, err := util.GetFileChecksum(localFilePath)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get %v checksum", localFilePath)
+	}
+	cfg.Checksum = checksum
+
+	archivePath := systemBackupArchivePath(cfg.Name, cfg.LonghornVersion)
+	if driver.FileExists(archivePath) {
+		return fmt.Errorf("%v already exists", archivePath)
+	}
+
+	f, err := os.Open(localFilePath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if errClose := f.Close(); errClose != nil {
+			logrus.WithError(errClose).Warnf("Failed to close %v", localFilePath)
+		}
+	}()
+
+	if err := driver.Write(archivePath, NewThrottledReadSeeker(f, limit)); err != nil {
+		return errors.Wrapf(err, "failed to upload system backup %v", cfg.Name)
+	}
+	if err := backupstore.SaveConfigInBackupStore(driver, systemBackupConfigPath(cfg.Name, cfg.LonghornVersion), cfg); err != nil {
+		return errors.Wrapf(err, "failed to upload system backup config %v", cfg.Name)
+	}
+	return nil
+}
+
+// DownloadSystemBackup downloads the system backup archive to the local file and verifies its checksum.
+// The download rate is capped by the limit in bytes per second.
+func DownloadSystemBackup(driver backupstore.BackupStoreDriver, cfg *systembackup.Config, localFilePath string, limit func() int64) (err error) {
+	archivePath := systemBackupArchivePath(cfg.Name, cfg.LonghornVersion)
+	if !driver.FileExists(archivePath) {
+		return fmt.Errorf("system backup %v doesn't exist", archivePath)
+	}
+
+	rc, err := driver.Read(archivePath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if errClose := rc.Close(); errClose != nil {
+			logrus.WithError(errClose).Warnf("Failed to close %v", archivePath)
+		}
+	}()
+
+	if err := os.MkdirAll(filepath.Dir(localFilePath), 0700); err != nil {
+		return err
+	}
+	f, err := os.Create(localFilePath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if errClose := f.Close(); errClose != nil && err == nil {
+			err = errClose
+		}
+		if err != nil {
+			if errRemove := os.Remove(localFilePath); errRemove != nil && !os.IsNotExist(errRemove) {
+				logrus.WithError(errRemove).Warnf("Failed to clean up %v", localFilePath)
+			}
+		}
+	}()
+
+	if _, err := io.Copy(f, NewThrottledReader(rc, limit)); err != nil {
+		return errors.Wrapf(err, "failed to download system backup %v", cfg.Name)
+	}
+
+	checksum, err := util.GetFileChecksum(localFilePath)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get %v checksum", localFilePath)
+	}
+	if checksum != cfg.Checksum {
+		return fmt.Errorf("downloaded %v checksum mismatched: got %v: expect %v", localFilePath, checksum, cfg.Checksum)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package backupstore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/longhorn/backupstore/systembackup"
+)
+
+func TestSystemBackupUploadDownload(t *testing.T) {
+	assert := require.New(t)
+
+	driver := newTestBackupStore(t)
+	dir := t.TempDir()
+	localFile := filepath.Join(dir, "system-backup.zip")
+	assert.NoError(os.WriteFile(localFile, []byte("system backup"), 0600))
+
+	cfg := &systembackup.Config{
+		Name:            "system-backup",
+		LonghornVersion: "v1.9.0",
+		ManagerImage:    "longhornio/longhorn-manager:v1.9.0",
+	}
+	unlimited := func() int64 { return 0 }
+	assert.NoError(UploadSystemBackup(driver, localFile, cfg, unlimited))
+	assert.NotEmpty(cfg.Checksum)
+	assert.ErrorContains(UploadSystemBackup(driver, localFile, cfg, unlimited), "already exists")
+
+	downloadedFile := filepath.Join(dir, "download", "system-backup.zip")
+	assert.NoError(DownloadSystemBackup(driver, cfg, downloadedFile, unlimited))
+	data, err := os.ReadFile(downloadedFile)
+	assert.NoError(err)
+	assert.Equal("system backup", string(data))
+
+	// The downloaded file is removed if the checksum doesn't match the config
+	cfg.Checksum = "mismatched"
+	assert.ErrorContains(DownloadSystemBackup(driver, cfg, downloadedFile, unlimited), "checksum mismatched")
+	_, err = os.Stat(downloadedFile)
+	assert.True(os.IsNotExist(err))
+}
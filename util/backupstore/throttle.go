@@ -0,0 +1,158 @@
+package backupstore
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/longhorn/longhorn-manager/types"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// bandwidthLimitInterval is the period of the transfer budget. The limit is re-evaluated at the beginning of each
+// period, so that the bandwidth limit windows starting or ending during a long transfer are applied immediately.
+const bandwidthLimitInterval = time.Second
+
+// bandwidthLimiter hands out the transfer budget of the current period according to the limit in bytes per second,
+// where 0 means unlimited.
+type bandwidthLimiter struct {
+	limit func() int64
+
+	now   func() time.Time
+	sleep func(time.Duration)
+
+	periodStart time.Time
+	periodLimit int64
+	periodBytes int64
+}
+
+func newBandwidthLimiter(limit func() int64) *bandwidthLimiter {
+	return &bandwidthLimiter{
+		limit: limit,
+		now:   time.Now,
+		sleep: time.Sleep,
+	}
+}
+
+// reserve blocks until some budget is available in the current period and returns the number of bytes up to n
+// which can be transferred.
+func (l *bandwidthLimiter) reserve(n int) int {
+	for {
+		now := l.now()
+		if l.periodStart.IsZero() || now.Sub(l.periodStart) >= bandwidthLimitInterval {
+			l.periodStart = now
+			l.periodLimit = l.limit()
+			l.periodBytes = 0
+		}
+		if l.periodLimit <= 0 {
+			return n
+		}
+		if remaining := l.periodLimit - l.periodBytes; remaining > 0 {
+			if int64(n) > remaining {
+				n = int(remaining)
+			}
+			l.periodBytes += int64(n)
+			return n
+		}
+		l.sleep(l.periodStart.Add(bandwidthLimitInterval).Sub(now))
+	}
+}
+
+// release returns the unused budget of the last reservation
+func (l *bandwidthLimiter) release(n int) {
+	l.periodBytes -= int64(n)
+}
+
+type throttledReader struct {
+	r       io.Reader
+	limiter *bandwidthLimiter
+}
+
+// NewThrottledReader returns a reader capping the read rate of r to the limit in bytes per second.
+// The limit is re-evaluated every second, and 0 means unlimited.
+func NewThrottledReader(r io.Reader, limit func() int64) io.Reader {
+	return &throttledReader{r: r, limiter: newBandwidthLimiter(limit)}
+}
+
+func (t *throttledReader) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return t.r.Read(p)
+	}
+	reserved := t.limiter.reserve(len(p))
+	n, err := t.r.Read(p[:reserved])
+	t.limiter.release(reserved - n)
+	return n, err
+}
+
+type throttledReadSeeker struct {
+	throttledReader
+	s io.Seeker
+}
+
+// NewThrottledReadSeeker is NewThrottledReader for the drivers requiring a seekable source
+func NewThrottledReadSeeker(rs io.ReadSeeker, limit func() int64) io.ReadSeeker {
+	return &throttledReadSeeker{
+		throttledReader: throttledReader{r: rs, limiter: newBandwidthLimiter(limit)},
+		s:               rs,
+	}
+}
+
+func (t *throttledReadSeeker) Seek(offset int64, whence int) (int64, error) {
+	return t.s.Seek(offset, whence)
+}
+
+type throttledWriter struct {
+	w       io.Writer
+	limiter *bandwidthLimiter
+}
+
+// NewThrottledWriter returns a writer capping the write rate to w to the limit in bytes per second.
+// The limit is re-evaluated every second, and 0 means unlimited.
+func NewThrottledWriter(w io.Writer, limit func() int64) io.Writer {
+	return &throttledWriter{w: w, limiter: newBandwidthLimiter(limit)}
+}
+
+func (t *throttledWriter) Write(p []byte) (int, error) {
+	written := 0
+	for written < len(p) {
+		reserved := t.limiter.reserve(len(p) - written)
+		n, err := t.w.Write(p[written : written+reserved])
+		t.limiter.release(reserved - n)
+		written += n
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+// ParseBandwidthLimits decodes the bandwidth limits passed in the environment variable of the backupstore command
+func ParseBandwidthLimits(value string) ([]longhorn.BandwidthLimit, error) {
+	limits := []longhorn.BandwidthLimit{}
+	if value == "" {
+		return limits, nil
+	}
+	if err := json.Unmarshal([]byte(value), &limits); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse bandwidth limits %v", value)
+	}
+	return limits, nil
+}
+
+// GetBackupBandwidthLimitFunc returns the function evaluating the backup upload cap of the limits at the time
+func GetBackupBandwidthLimitFunc(limits []longhorn.BandwidthLimit) func() int64 {
+	return func() int64 {
+		backupBytesPerSecond, _ := types.GetEffectiveBandwidthLimit(time.Now(), limits...)
+		return backupBytesPerSecond
+	}
+}
+
+// GetRestoreBandwidthLimitFunc returns the function evaluating the restore download cap of the limits at the time
+func GetRestoreBandwidthLimitFunc(limits []longhorn.BandwidthLimit) func() int64 {
+	return func() int64 {
+		_, restoreBytesPerSecond := types.GetEffectiveBandwidthLimit(time.Now(), limits...)
+		return restoreBytesPerSecond
+	}
+}
@@ -0,0 +1,96 @@
+package backupstore
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClock struct {
+	now   time.Time
+	slept time.Duration
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Sleep(d time.Duration) {
+	c.now = c.now.Add(d)
+	c.slept += d
+}
+
+func newTestLimiter(limiter *bandwidthLimiter, clock *fakeClock) {
+	limiter.now = clock.Now
+	limiter.sleep = clock.Sleep
+}
+
+func TestThrottledReader(t *testing.T) {
+	assert := require.New(t)
+
+	data := bytes.Repeat([]byte("a"), 350)
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	r := NewThrottledReader(bytes.NewReader(data), func() int64 { return 100 }).(*throttledReader)
+	newTestLimiter(r.limiter, clock)
+
+	result, err := io.ReadAll(r)
+	assert.NoError(err)
+	assert.Equal(data, result)
+	// 100 bytes are read in each second
+	assert.Equal(3*time.Second, clock.slept)
+}
+
+func TestThrottledReaderUnlimited(t *testing.T) {
+	assert := require.New(t)
+
+	data := bytes.Repeat([]byte("a"), 350)
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	r := NewThrottledReader(bytes.NewReader(data), func() int64 { return 0 }).(*throttledReader)
+	newTestLimiter(r.limiter, clock)
+
+	result, err := io.ReadAll(r)
+	assert.NoError(err)
+	assert.Equal(data, result)
+	assert.Equal(time.Duration(0), clock.slept)
+}
+
+func TestThrottledReaderLimitChange(t *testing.T) {
+	assert := require.New(t)
+
+	data := bytes.Repeat([]byte("a"), 1000)
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := &fakeClock{now: start}
+	// The limit window ends 2 seconds after the transfer starts
+	limit := func() int64 {
+		if clock.Now().Sub(start) < 2*time.Second {
+			return 100
+		}
+		return 0
+	}
+	r := NewThrottledReader(bytes.NewReader(data), limit).(*throttledReader)
+	newTestLimiter(r.limiter, clock)
+
+	result, err := io.ReadAll(r)
+	assert.NoError(err)
+	assert.Equal(data, result)
+	assert.Equal(2*time.Second, clock.slept)
+}
+
+func TestThrottledWriter(t *testing.T) {
+	assert := require.New(t)
+
+	data := bytes.Repeat([]byte("a"), 250)
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	buf := &bytes.Buffer{}
+	w := NewThrottledWriter(buf, func() int64 { return 100 }).(*throttledWriter)
+	newTestLimiter(w.limiter, clock)
+
+	n, err := w.Write(data)
+	assert.NoError(err)
+	assert.Equal(len(data), n)
+	assert.Equal(data, buf.Bytes())
+	assert.Equal(2*time.Second, clock.slept)
+}
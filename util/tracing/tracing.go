@@ -0,0 +1,180 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+
+	"github.com/longhorn/longhorn-manager/types"
+)
+
+const (
+	TracerName = "github.com/longhorn/longhorn-manager"
+
+	// The trace context recorded in the resource annotations is ignored after this period, so that the
+	// reconciliations unrelated to the traced request are not added to its trace.
+	AnnotationTraceContextValidity = 10 * time.Minute
+)
+
+var (
+	enabled    = false
+	propagator = propagation.TraceContext{}
+)
+
+// Init sets up the global tracer provider exporting the spans via OTLP over gRPC to the collector at endpoint, for
+// example http://otel-collector:4317. Tracing stays disabled if endpoint is empty. The returned function flushes the
+// remaining spans and shuts down the exporter.
+func Init(ctx context.Context, endpoint, serviceName, nodeID string) (func(context.Context) error, error) {
+	if endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "http://" + endpoint
+	}
+	exporter, err := otlptracegrpc.New(ctx, otlptracegrpc.WithEndpointURL(endpoint))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create OTLP trace exporter for %v", endpoint)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(
+			semconv.ServiceName(serviceName),
+			semconv.ServiceInstanceID(nodeID),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagator)
+	enabled = true
+
+	return provider.Shutdown, nil
+}
+
+// Enabled returns true if the spans are exported.
+func Enabled() bool {
+	return enabled
+}
+
+func Tracer() trace.Tracer {
+	return otel.Tracer(TracerName)
+}
+
+// StartSpan starts a span as a child of the span in ctx, or a new trace if ctx carries no trace.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan ends the span and marks it failed if err is not nil.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// NewHTTPHandler wraps the handler to start a span for each request, which continues the trace in the request
+// headers if any. The websocket requests are not traced since they last as long as the connections.
+func NewHTTPHandler(handler http.Handler, operation string) http.Handler {
+	if !enabled {
+		return handler
+	}
+	return otelhttp.NewHandler(handler, operation,
+		otelhttp.WithFilter(func(r *http.Request) bool {
+			return !strings.Contains(r.URL.Path, "/ws/")
+		}),
+		otelhttp.WithSpanNameFormatter(func(operation string, r *http.Request) string {
+			return r.Method + " " + r.URL.Path
+		}),
+	)
+}
+
+// InjectIntoHTTPHeader records the trace context of ctx in the header, so that the receiver continues the trace.
+func InjectIntoHTTPHeader(ctx context.Context, header http.Header) {
+	if !enabled {
+		return
+	}
+	propagator.Inject(ctx, propagation.HeaderCarrier(header))
+}
+
+// InjectIntoAnnotations records the trace context of ctx in the annotations of the object, so that the controllers
+// reconciling the object continue the trace. It does nothing if ctx carries no trace.
+func InjectIntoAnnotations(ctx context.Context, obj metav1.Object) {
+	if !enabled || !trace.SpanContextFromContext(ctx).IsValid() {
+		return
+	}
+
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+	traceParent := carrier.Get("traceparent")
+	if traceParent == "" {
+		return
+	}
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[types.GetLonghornLabelKey(types.TraceParentAnnotationKeySuffix)] = traceParent
+	annotations[types.GetLonghornLabelKey(types.TraceRecordedAtAnnotationKeySuffix)] = time.Now().UTC().Format(time.RFC3339)
+	obj.SetAnnotations(annotations)
+}
+
+// ContextFromAnnotations returns a context carrying the trace context recorded in the annotations of the object. The
+// trace context is ignored once it is older than AnnotationTraceContextValidity.
+func ContextFromAnnotations(obj metav1.Object) context.Context {
+	ctx := context.Background()
+	if !enabled {
+		return ctx
+	}
+
+	annotations := obj.GetAnnotations()
+	traceParent := annotations[types.GetLonghornLabelKey(types.TraceParentAnnotationKeySuffix)]
+	if traceParent == "" {
+		return ctx
+	}
+	recordedAt, err := time.Parse(time.RFC3339, annotations[types.GetLonghornLabelKey(types.TraceRecordedAtAnnotationKeySuffix)])
+	if err != nil || time.Since(recordedAt) > AnnotationTraceContextValidity {
+		return ctx
+	}
+	return propagator.Extract(ctx, propagation.MapCarrier{"traceparent": traceParent})
+}
+
+// StartSpanFromAnnotations starts a span continuing the trace recorded in the annotations of the object. A
+// non-recording span is returned if there is no valid trace context, so that the reconciliations not caused by a
+// traced request do not start new traces.
+func StartSpanFromAnnotations(obj metav1.Object, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	ctx := ContextFromAnnotations(obj)
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return ctx, noop.Span{}
+	}
+	return StartSpan(ctx, name, attrs...)
+}
+
+// RecordSpan records a finished span from start to now as a child of the span in ctx. It does nothing if ctx
+// carries no trace.
+func RecordSpan(ctx context.Context, name string, start time.Time, attrs ...attribute.KeyValue) {
+	if ctx == nil || !trace.SpanContextFromContext(ctx).IsValid() {
+		return
+	}
+	_, span := Tracer().Start(ctx, name, trace.WithTimestamp(start), trace.WithAttributes(attrs...))
+	span.End()
+}
@@ -850,6 +850,19 @@ func EncodeToYAMLFile(obj interface{}, path string) (err error) {
 	return nil
 }
 
+func DecodeFromYAMLFile(path string, obj interface{}) (err error) {
+	defer func() {
+		err = errors.Wrapf(err, "failed to decode %v", path)
+	}()
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return
+	}
+
+	return yaml.Unmarshal(content, obj)
+}
+
 func VerifySnapshotLabels(labels map[string]string) error {
 	for k, v := range labels {
 		if strings.Contains(k, "=") || strings.Contains(v, "=") {
@@ -0,0 +1,84 @@
+package alertrule
+
+import (
+	"fmt"
+	"net/url"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	admissionregv1 "k8s.io/api/admissionregistration/v1"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/webhook/admission"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	werror "github.com/longhorn/longhorn-manager/webhook/error"
+)
+
+type alertRuleValidator struct {
+	admission.DefaultValidator
+	ds *datastore.DataStore
+}
+
+func NewValidator(ds *datastore.DataStore) admission.Validator {
+	return &alertRuleValidator{ds: ds}
+}
+
+func (v *alertRuleValidator) Resource() admission.Resource {
+	return admission.Resource{
+		Name:       "alertrules",
+		Scope:      admissionregv1.NamespacedScope,
+		APIGroup:   longhorn.SchemeGroupVersion.Group,
+		APIVersion: longhorn.SchemeGroupVersion.Version,
+		ObjectType: &longhorn.AlertRule{},
+		OperationTypes: []admissionregv1.OperationType{
+			admissionregv1.Create,
+			admissionregv1.Update,
+		},
+	}
+}
+
+func (v *alertRuleValidator) Create(request *admission.Request, newObj runtime.Object) error {
+	return v.validateAlertRule(newObj)
+}
+
+func (v *alertRuleValidator) Update(request *admission.Request, oldObj runtime.Object, newObj runtime.Object) error {
+	return v.validateAlertRule(newObj)
+}
+
+func (v *alertRuleValidator) validateAlertRule(newObj runtime.Object) error {
+	alertRule, ok := newObj.(*longhorn.AlertRule)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.AlertRule", newObj), "")
+	}
+
+	switch alertRule.Spec.Type {
+	case longhorn.AlertRuleTypeDiskUsage:
+		if alertRule.Spec.Threshold <= 0 || alertRule.Spec.Threshold >= 100 {
+			return werror.NewInvalidError(fmt.Sprintf("threshold %v of %v alert rule should be a percentage between 0 and 100", alertRule.Spec.Threshold, alertRule.Spec.Type), "spec.threshold")
+		}
+	case longhorn.AlertRuleTypeBackupAge:
+		if alertRule.Spec.Threshold <= 0 {
+			return werror.NewInvalidError(fmt.Sprintf("threshold of %v alert rule should be the maximum backup age in minutes", alertRule.Spec.Type), "spec.threshold")
+		}
+	case longhorn.AlertRuleTypeVolumeDegraded, longhorn.AlertRuleTypeNodeDown:
+	default:
+		return werror.NewInvalidError(fmt.Sprintf("unknown alert rule type %v", alertRule.Spec.Type), "spec.type")
+	}
+
+	if alertRule.Spec.ForMinutes < 0 {
+		return werror.NewInvalidError(fmt.Sprintf("forMinutes %v should not be negative", alertRule.Spec.ForMinutes), "spec.forMinutes")
+	}
+
+	for _, receiver := range alertRule.Spec.Receivers {
+		u, err := url.Parse(receiver.URL)
+		if err != nil {
+			return werror.NewInvalidError(fmt.Sprintf("invalid URL %v of receiver %v: %v", receiver.URL, receiver.Name, err), "spec.receivers")
+		}
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return werror.NewInvalidError(fmt.Sprintf("URL %v of receiver %v should be an absolute http or https URL", receiver.URL, receiver.Name), "spec.receivers")
+		}
+	}
+
+	return nil
+}
@@ -3,10 +3,13 @@ package backup
 import (
 	"fmt"
 
+	"github.com/pkg/errors"
+
 	admissionregv1 "k8s.io/api/admissionregistration/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
 	"github.com/longhorn/longhorn-manager/util"
 	"github.com/longhorn/longhorn-manager/webhook/admission"
 	werror "github.com/longhorn/longhorn-manager/webhook/error"
@@ -51,5 +54,33 @@ func (b *backupValidator) Create(request *admission.Request, newObj runtime.Obje
 		return werror.NewInvalidError(fmt.Sprintf("BackupMode %v is not a valid option", backup.Spec.BackupMode), "")
 	}
 
+	if backup.Spec.SourceBackupName != "" {
+		if err := b.validateBackupCopy(backup); err != nil {
+			return werror.NewInvalidError(err.Error(), "")
+		}
+	}
+
+	return nil
+}
+
+func (b *backupValidator) validateBackupCopy(backup *longhorn.Backup) error {
+	if backup.Spec.SnapshotName != "" {
+		return fmt.Errorf("cannot specify both the snapshot name %v and the source backup name %v", backup.Spec.SnapshotName, backup.Spec.SourceBackupName)
+	}
+
+	sourceBackup, err := b.ds.GetBackupRO(backup.Spec.SourceBackupName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get the source backup %v", backup.Spec.SourceBackupName)
+	}
+	if sourceBackup.Status.State != longhorn.BackupStateCompleted {
+		return fmt.Errorf("source backup %v is not completed", sourceBackup.Name)
+	}
+	if sourceBackup.Labels[types.LonghornLabelBackupVolume] != backup.Labels[types.LonghornLabelBackupVolume] {
+		return fmt.Errorf("source backup %v belongs to a different backup volume", sourceBackup.Name)
+	}
+	if sourceBackup.Labels[types.LonghornLabelBackupTarget] == backup.Labels[types.LonghornLabelBackupTarget] {
+		return fmt.Errorf("source backup %v is already in the backup target %v", sourceBackup.Name, backup.Labels[types.LonghornLabelBackupTarget])
+	}
+
 	return nil
 }
@@ -65,6 +65,10 @@ func (b *backupTargetValidator) Create(request *admission.Request, newObj runtim
 		return werror.NewInvalidError(err.Error(), "")
 	}
 
+	if err := types.ValidateBandwidthLimit(backupTarget.Spec.BandwidthLimit); err != nil {
+		return werror.NewInvalidError(err.Error(), "")
+	}
+
 	return nil
 }
 
@@ -117,6 +121,10 @@ func (b *backupTargetValidator) Update(request *admission.Request, oldObj runtim
 		}
 	}
 
+	if err := types.ValidateBandwidthLimit(newBackupTarget.Spec.BandwidthLimit); err != nil {
+		return werror.NewInvalidError(err.Error(), "")
+	}
+
 	return nil
 }
 
@@ -54,6 +54,14 @@ func (n *nodeValidator) Create(request *admission.Request, newObj runtime.Object
 		return werror.NewInvalidError("instanceManagerCPURequest should be greater than or equal to 0", "")
 	}
 
+	if err := types.ValidateBandwidthLimit(node.Spec.BackupBandwidthLimit); err != nil {
+		return werror.NewInvalidError(err.Error(), "")
+	}
+
+	if err := validateSettingOverrides(node); err != nil {
+		return werror.NewInvalidError(err.Error(), "")
+	}
+
 	v2DataEngineEnabled, err := n.ds.GetSettingAsBool(types.SettingNameV2DataEngine)
 	if err != nil {
 		err = errors.Wrapf(err, "failed to get spdk setting")
@@ -100,6 +108,14 @@ func (n *nodeValidator) Update(request *admission.Request, oldObj runtime.Object
 		return werror.NewInvalidError("instanceManagerCPURequest should be greater than or equal to 0", "")
 	}
 
+	if err := types.ValidateBandwidthLimit(newNode.Spec.BackupBandwidthLimit); err != nil {
+		return werror.NewInvalidError(err.Error(), "")
+	}
+
+	if err := validateSettingOverrides(newNode); err != nil {
+		return werror.NewInvalidError(err.Error(), "")
+	}
+
 	// Only scheduling disabled node can be evicted
 	// Can not enable scheduling on an evicting node
 	if newNode.Spec.EvictionRequested && newNode.Spec.AllowScheduling {
@@ -215,3 +231,15 @@ func isNodeDiskSpecAndStatusSynced(node *longhorn.Node) bool {
 
 	return true
 }
+
+func validateSettingOverrides(node *longhorn.Node) error {
+	if err := types.ValidateSettingOverrides(node.Spec.SettingOverrides, types.NodeOverridableSettings); err != nil {
+		return errors.Wrapf(err, "invalid setting overrides of node %v", node.Name)
+	}
+	for name, disk := range node.Spec.Disks {
+		if err := types.ValidateSettingOverrides(disk.SettingOverrides, types.DiskOverridableSettings); err != nil {
+			return errors.Wrapf(err, "invalid setting overrides of disk %v on node %v", name, node.Name)
+		}
+	}
+	return nil
+}
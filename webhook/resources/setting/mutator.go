@@ -0,0 +1,88 @@
+package setting
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	admissionregv1 "k8s.io/api/admissionregistration/v1"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/webhook/admission"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	werror "github.com/longhorn/longhorn-manager/webhook/error"
+)
+
+type settingMutator struct {
+	admission.DefaultMutator
+	ds *datastore.DataStore
+}
+
+func NewMutator(ds *datastore.DataStore) admission.Mutator {
+	return &settingMutator{ds: ds}
+}
+
+func (m *settingMutator) Resource() admission.Resource {
+	return admission.Resource{
+		Name:       "settings",
+		Scope:      admissionregv1.NamespacedScope,
+		APIGroup:   longhorn.SchemeGroupVersion.Group,
+		APIVersion: longhorn.SchemeGroupVersion.Version,
+		ObjectType: &longhorn.Setting{},
+		OperationTypes: []admissionregv1.OperationType{
+			admissionregv1.Update,
+		},
+	}
+}
+
+// Update notes the value change in the annotations, so the setting controller can record it in the setting history.
+func (m *settingMutator) Update(request *admission.Request, oldObj runtime.Object, newObj runtime.Object) (admission.PatchOps, error) {
+	oldSetting, ok := oldObj.(*longhorn.Setting)
+	if !ok {
+		return nil, werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.Setting", oldObj), "")
+	}
+	setting, ok := newObj.(*longhorn.Setting)
+	if !ok {
+		return nil, werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.Setting", newObj), "")
+	}
+
+	if oldSetting.Value == setting.Value {
+		return nil, nil
+	}
+
+	annotations := map[string]string{}
+	for k, v := range setting.Annotations {
+		annotations[k] = v
+	}
+
+	// Continue from the recorded history in case the annotation is removed or corrupted
+	revision, err := strconv.ParseInt(oldSetting.Annotations[types.GetLonghornLabelKey(types.SettingRevisionAnnotationKeySuffix)], 10, 64)
+	if err != nil {
+		revision = 0
+	}
+	if history := oldSetting.Status.History; len(history) > 0 && history[len(history)-1].Revision > revision {
+		revision = history[len(history)-1].Revision
+	}
+
+	annotations[types.GetLonghornLabelKey(types.SettingRevisionAnnotationKeySuffix)] = strconv.FormatInt(revision+1, 10)
+	annotations[types.GetLonghornLabelKey(types.SettingChangedByAnnotationKeySuffix)] = request.Username()
+	annotations[types.GetLonghornLabelKey(types.SettingChangedAtAnnotationKeySuffix)] = time.Now().UTC().Format(time.RFC3339)
+	annotations[types.GetLonghornLabelKey(types.SettingPreviousValueAnnotationKeySuffix)] = oldSetting.Value
+
+	bytes, err := json.Marshal(annotations)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to get JSON encoding for setting %v annotations", setting.Name)
+		return nil, werror.NewInvalidError(err.Error(), "")
+	}
+
+	return admission.PatchOps{
+		fmt.Sprintf(`{"op": "add", "path": "/metadata/annotations", "value": %v}`, string(bytes)),
+	}, nil
+}
@@ -0,0 +1,80 @@
+package settingsprofile
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	admissionregv1 "k8s.io/api/admissionregistration/v1"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/webhook/admission"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	werror "github.com/longhorn/longhorn-manager/webhook/error"
+)
+
+type settingsProfileValidator struct {
+	admission.DefaultValidator
+	ds *datastore.DataStore
+}
+
+func NewValidator(ds *datastore.DataStore) admission.Validator {
+	return &settingsProfileValidator{ds: ds}
+}
+
+func (v *settingsProfileValidator) Resource() admission.Resource {
+	return admission.Resource{
+		Name:       "settingsprofiles",
+		Scope:      admissionregv1.NamespacedScope,
+		APIGroup:   longhorn.SchemeGroupVersion.Group,
+		APIVersion: longhorn.SchemeGroupVersion.Version,
+		ObjectType: &longhorn.SettingsProfile{},
+		OperationTypes: []admissionregv1.OperationType{
+			admissionregv1.Create,
+			admissionregv1.Update,
+		},
+	}
+}
+
+func (v *settingsProfileValidator) Create(request *admission.Request, newObj runtime.Object) error {
+	return v.validateSettingsProfile(newObj)
+}
+
+func (v *settingsProfileValidator) Update(request *admission.Request, oldObj runtime.Object, newObj runtime.Object) error {
+	return v.validateSettingsProfile(newObj)
+}
+
+func (v *settingsProfileValidator) validateSettingsProfile(newObj runtime.Object) error {
+	settingsProfile, ok := newObj.(*longhorn.SettingsProfile)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.SettingsProfile", newObj), "")
+	}
+
+	values := map[types.SettingName]string{}
+	for name, value := range settingsProfile.Spec.Settings {
+		values[types.SettingName(name)] = value
+	}
+	if err := v.ds.ValidateSettings(values); err != nil {
+		return werror.NewInvalidError(err.Error(), "spec.settings")
+	}
+
+	// The profiles would keep overwriting each other
+	settingsProfiles, err := v.ds.ListSettingsProfiles()
+	if err != nil {
+		return werror.NewInternalError(err.Error())
+	}
+	for _, other := range settingsProfiles {
+		if other.Name == settingsProfile.Name {
+			continue
+		}
+		for name, value := range settingsProfile.Spec.Settings {
+			if otherValue, ok := other.Spec.Settings[name]; ok && otherValue != value {
+				return werror.NewInvalidError(fmt.Sprintf("setting %v is set to %v by settings profile %v", name, otherValue, other.Name), "spec.settings")
+			}
+		}
+	}
+
+	return nil
+}
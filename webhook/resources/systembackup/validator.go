@@ -2,6 +2,7 @@ package systembackup
 
 import (
 	"fmt"
+	"reflect"
 
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -39,6 +40,21 @@ func (v *systemBackupValidator) Resource() admission.Resource {
 }
 
 func (v *systemBackupValidator) Create(request *admission.Request, newObj runtime.Object) error {
+	systemBackup, ok := newObj.(*longhorn.SystemBackup)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.SystemBackup", newObj), "")
+	}
+
+	for _, namespace := range systemBackup.Spec.Scope.Namespaces {
+		if namespace == "" {
+			return werror.NewInvalidError("empty namespace in the system backup scope", "spec.scope.namespaces")
+		}
+	}
+
+	if err := v.validateBaseSystemBackup(systemBackup); err != nil {
+		return werror.NewInvalidError(err.Error(), "spec.baseSystemBackup")
+	}
+
 	backupTarget, err := v.ds.GetBackupTargetRO(types.DefaultBackupTargetName)
 	if err != nil {
 		return werror.NewBadRequest(err.Error())
@@ -57,3 +73,28 @@ func (v *systemBackupValidator) Create(request *admission.Request, newObj runtim
 
 	return nil
 }
+
+func (v *systemBackupValidator) validateBaseSystemBackup(systemBackup *longhorn.SystemBackup) error {
+	if systemBackup.Spec.BaseSystemBackup == "" {
+		return nil
+	}
+
+	if systemBackup.Spec.BaseSystemBackup == systemBackup.Name {
+		return fmt.Errorf("system backup cannot be the base of itself")
+	}
+
+	baseSystemBackup, err := v.ds.GetSystemBackupRO(systemBackup.Spec.BaseSystemBackup)
+	if err != nil {
+		return err
+	}
+
+	if baseSystemBackup.Status.State != longhorn.SystemBackupStateReady {
+		return fmt.Errorf("base system backup %v is in %v state, expecting %v", baseSystemBackup.Name, baseSystemBackup.Status.State, longhorn.SystemBackupStateReady)
+	}
+
+	// Resources out of the scope are missing in the base system backup
+	if !reflect.DeepEqual(baseSystemBackup.Spec.Scope, systemBackup.Spec.Scope) {
+		return fmt.Errorf("base system backup %v has a different scope", baseSystemBackup.Name)
+	}
+	return nil
+}
@@ -3,17 +3,44 @@ package systemrestore
 import (
 	"fmt"
 
+	"github.com/pkg/errors"
+
 	"k8s.io/apimachinery/pkg/runtime"
 
 	admissionregv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
 	"github.com/longhorn/longhorn-manager/webhook/admission"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 	werror "github.com/longhorn/longhorn-manager/webhook/error"
 )
 
+var restorableResourceKinds = []string{
+	types.APIExtensionsKindCustomResourceDefinition,
+	types.KubernetesKindClusterRole,
+	types.KubernetesKindClusterRoleBinding,
+	types.KubernetesKindConfigMap,
+	types.KubernetesKindDaemonSet,
+	types.KubernetesKindDeployment,
+	types.KubernetesKindPersistentVolume,
+	types.KubernetesKindPersistentVolumeClaim,
+	types.KubernetesKindRole,
+	types.KubernetesKindRoleBinding,
+	types.KubernetesKindService,
+	types.KubernetesKindServiceAccount,
+	types.KubernetesKindStorageClass,
+	types.LonghornKindBackingImage,
+	types.LonghornKindBackupTarget,
+	types.LonghornKindEngineImage,
+	types.LonghornKindRecurringJob,
+	types.LonghornKindSetting,
+	types.LonghornKindVolume,
+}
+
 type systemRestoreValidator struct {
 	admission.DefaultValidator
 	ds *datastore.DataStore
@@ -42,13 +69,27 @@ func (v *systemRestoreValidator) Create(request *admission.Request, newObj runti
 		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.SystemRestore", newObj), "")
 	}
 
-	areAllVolumesDetached, err := v.ds.AreAllVolumesDetachedState()
-	if err != nil {
-		return werror.NewInvalidError(err.Error(), "")
+	if err := validateResourceFilter(systemRestore.Spec.Include); err != nil {
+		return werror.NewInvalidError(err.Error(), "spec.include")
+	}
+	if err := validateResourceFilter(systemRestore.Spec.Exclude); err != nil {
+		return werror.NewInvalidError(err.Error(), "spec.exclude")
 	}
 
-	if !areAllVolumesDetached {
-		return werror.NewInvalidError("all volumes need to be detached before creating SystemRestore", "")
+	if err := validateRemap(systemRestore.Spec.Remap); err != nil {
+		return werror.NewInvalidError(err.Error(), "spec.remap")
+	}
+
+	// Nothing is applied in dry run
+	if !systemRestore.Spec.DryRun {
+		areAllVolumesDetached, err := v.ds.AreAllVolumesDetachedState()
+		if err != nil {
+			return werror.NewInvalidError(err.Error(), "")
+		}
+
+		if !areAllVolumesDetached {
+			return werror.NewInvalidError("all volumes need to be detached before creating SystemRestore", "")
+		}
 	}
 
 	systemRestores, err := v.ds.ListSystemRestoresInProgress()
@@ -68,3 +109,43 @@ func (v *systemRestoreValidator) Create(request *admission.Request, newObj runti
 
 	return nil
 }
+
+func validateResourceFilter(filter longhorn.SystemRestoreResourceFilter) error {
+	for _, kind := range filter.Kinds {
+		if !util.Contains(restorableResourceKinds, kind) {
+			return fmt.Errorf("invalid resource kind %v, should be one of %v", kind, restorableResourceKinds)
+		}
+	}
+
+	if filter.VolumeSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(filter.VolumeSelector); err != nil {
+			return errors.Wrap(err, "invalid volume selector")
+		}
+	}
+	return nil
+}
+
+func validateRemap(remap longhorn.SystemRestoreRemap) error {
+	nameMaps := map[string]map[string]string{
+		"node name":         remap.NodeNames,
+		"StorageClass name": remap.StorageClassNames,
+		"namespace":         remap.Namespaces,
+	}
+	for kind, nameMap := range nameMaps {
+		for from, to := range nameMap {
+			if from == "" || to == "" {
+				return fmt.Errorf("invalid %v remapping from %q to %q", kind, from, to)
+			}
+		}
+	}
+
+	for name, override := range remap.BackupTargets {
+		if name == "" {
+			return fmt.Errorf("empty backup target name in the backup target overrides")
+		}
+		if override.BackupTargetURL == "" && override.CredentialSecret == "" {
+			return fmt.Errorf("empty override of backup target %v", name)
+		}
+	}
+	return nil
+}
@@ -157,6 +157,10 @@ func (v *volumeValidator) Create(request *admission.Request, newObj runtime.Obje
 		return werror.NewInvalidError(err.Error(), "spec.snapshotMaxSize")
 	}
 
+	if err := types.ValidateVolumeIOQoS(volume.Spec.IOQoS); err != nil {
+		return werror.NewInvalidError(err.Error(), "spec.ioQoS")
+	}
+
 	if err := v.ds.CheckDataEngineImageCompatiblityByImage(volume.Spec.Image, volume.Spec.DataEngine); err != nil {
 		return werror.NewInvalidError(err.Error(), "volume.spec.image")
 	}
@@ -333,6 +337,10 @@ func (v *volumeValidator) Update(request *admission.Request, oldObj runtime.Obje
 		return werror.NewInvalidError(err.Error(), "spec.snapshotMaxSize")
 	}
 
+	if err := types.ValidateVolumeIOQoS(newVolume.Spec.IOQoS); err != nil {
+		return werror.NewInvalidError(err.Error(), "spec.ioQoS")
+	}
+
 	if err := v.validateBackupTarget(oldVolume.Spec.BackupTargetName, newVolume.Spec.BackupTargetName); err != nil {
 		return werror.NewInvalidError(err.Error(), "spec.backupTargetName")
 	}
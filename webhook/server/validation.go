@@ -9,6 +9,7 @@ import (
 	"github.com/longhorn/longhorn-manager/types"
 	"github.com/longhorn/longhorn-manager/util"
 	"github.com/longhorn/longhorn-manager/webhook/admission"
+	"github.com/longhorn/longhorn-manager/webhook/resources/alertrule"
 	"github.com/longhorn/longhorn-manager/webhook/resources/backingimage"
 	"github.com/longhorn/longhorn-manager/webhook/resources/backup"
 	"github.com/longhorn/longhorn-manager/webhook/resources/backupbackingimage"
@@ -21,6 +22,7 @@ import (
 	"github.com/longhorn/longhorn-manager/webhook/resources/recurringjob"
 	"github.com/longhorn/longhorn-manager/webhook/resources/replica"
 	"github.com/longhorn/longhorn-manager/webhook/resources/setting"
+	"github.com/longhorn/longhorn-manager/webhook/resources/settingsprofile"
 	"github.com/longhorn/longhorn-manager/webhook/resources/snapshot"
 	"github.com/longhorn/longhorn-manager/webhook/resources/supportbundle"
 	"github.com/longhorn/longhorn-manager/webhook/resources/systembackup"
@@ -39,6 +41,8 @@ func Validation(ds *datastore.DataStore) (http.Handler, []admission.Resource, er
 	validators := []admission.Validator{
 		node.NewValidator(ds),
 		setting.NewValidator(ds),
+		settingsprofile.NewValidator(ds),
+		alertrule.NewValidator(ds),
 		recurringjob.NewValidator(ds),
 		backingimage.NewValidator(ds),
 		backupbackingimage.NewValidator(ds),